@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/golobby/container/v3"
+	"github.com/leet-gaming/match-making-api/pkg/domain/ratings/usecases"
+)
+
+type LeaderboardController struct {
+	Container container.Container
+}
+
+func NewLeaderboardController(container container.Container) *LeaderboardController {
+	return &LeaderboardController{Container: container}
+}
+
+// Top retrieves a page of the leaderboard of a season. ?region restricts it to the players of a region (by slug);
+// ?limit and ?offset page through it.
+func (lc *LeaderboardController) Top(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		seasonID, ok := parseSeasonID(w, r)
+		if !ok {
+			return
+		}
+
+		limit := usecases.DefaultLeaderboardLimit
+		offset := 0
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+				limit = parsedLimit
+			}
+		}
+		if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+			if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+				offset = parsedOffset
+			}
+		}
+
+		var leaderboardUseCase *usecases.LeaderboardUseCase
+		if err := lc.Container.Resolve(&leaderboardUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve LeaderboardUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		leaderboard, err := leaderboardUseCase.Top(r.Context(), seasonID, r.URL.Query().Get("region"), offset, limit)
+		if err != nil {
+			writeSeasonError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(leaderboard)
+	}
+}
+
+// AroundPlayer retrieves the standing of a player in the leaderboard of a season, along with the players ranked
+// right above and below them. ?region restricts it to the players of a region (by slug); ?radius sets how many
+// players are shown on each side.
+func (lc *LeaderboardController) AroundPlayer(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		seasonID, ok := parseSeasonID(w, r)
+		if !ok {
+			return
+		}
+
+		playerID, ok := parsePlayerID(w, r)
+		if !ok {
+			return
+		}
+
+		radius := usecases.DefaultLeaderboardRadius
+		if radiusStr := r.URL.Query().Get("radius"); radiusStr != "" {
+			if parsedRadius, err := strconv.Atoi(radiusStr); err == nil && parsedRadius > 0 {
+				radius = parsedRadius
+			}
+		}
+
+		var leaderboardUseCase *usecases.LeaderboardUseCase
+		if err := lc.Container.Resolve(&leaderboardUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve LeaderboardUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		leaderboard, err := leaderboardUseCase.AroundPlayer(r.Context(), seasonID, r.URL.Query().Get("region"), playerID, radius)
+		if err != nil {
+			writeSeasonError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(leaderboard)
+	}
+}
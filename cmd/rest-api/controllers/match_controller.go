@@ -0,0 +1,300 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golobby/container/v3"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+)
+
+type MatchController struct {
+	Container container.Container
+}
+
+func NewMatchController(container container.Container) *MatchController {
+	return &MatchController{Container: container}
+}
+
+// CancelMatchRequest represents the request body for cancelling a match
+type CancelMatchRequest struct {
+	Reason string `json:"reason"` // why the match is cancelled, published with MATCH_CANCELLED
+}
+
+// Get retrieves a match by ID. Players can only see the matches they play in.
+func (mc *MatchController) Get(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		matchID, ok := parseMatchID(w, r)
+		if !ok {
+			return
+		}
+
+		var matchLifecycleUseCase *usecases.MatchLifecycleUseCase
+		if err := mc.Container.Resolve(&matchLifecycleUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve MatchLifecycleUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		match, err := matchLifecycleUseCase.Get(r.Context(), matchID)
+		if err != nil {
+			writeMatchError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(match)
+	}
+}
+
+// Start marks a match as started. Admin only.
+func (mc *MatchController) Start(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		matchID, ok := parseMatchID(w, r)
+		if !ok {
+			return
+		}
+
+		var matchLifecycleUseCase *usecases.MatchLifecycleUseCase
+		if err := mc.Container.Resolve(&matchLifecycleUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve MatchLifecycleUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		match, err := matchLifecycleUseCase.Start(r.Context(), matchID)
+		if err != nil {
+			writeMatchError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(match)
+	}
+}
+
+// Complete marks a started match as completed. Admin only.
+func (mc *MatchController) Complete(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		matchID, ok := parseMatchID(w, r)
+		if !ok {
+			return
+		}
+
+		var matchLifecycleUseCase *usecases.MatchLifecycleUseCase
+		if err := mc.Container.Resolve(&matchLifecycleUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve MatchLifecycleUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		match, err := matchLifecycleUseCase.Complete(r.Context(), matchID)
+		if err != nil {
+			writeMatchError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(match)
+	}
+}
+
+// Cancel cancels a match, started or not. Admin only.
+func (mc *MatchController) Cancel(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		matchID, ok := parseMatchID(w, r)
+		if !ok {
+			return
+		}
+
+		var req CancelMatchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			slog.ErrorContext(r.Context(), "failed to decode request body", "error", err)
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "invalid_request",
+				Message: fmt.Sprintf("invalid JSON: %v", err),
+			})
+			return
+		}
+
+		if strings.TrimSpace(req.Reason) == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "validation_error",
+				Message: "reason is required",
+			})
+			return
+		}
+
+		var matchLifecycleUseCase *usecases.MatchLifecycleUseCase
+		if err := mc.Container.Resolve(&matchLifecycleUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve MatchLifecycleUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		match, err := matchLifecycleUseCase.Cancel(r.Context(), matchID, req.Reason)
+		if err != nil {
+			writeMatchError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(match)
+	}
+}
+
+// Quality sums up the quality of the matches made. Admin only; ?game_mode_id= restricts the summary to a game mode,
+// ?since= and ?until= (RFC 3339) to the matches created in between.
+func (mc *MatchController) Quality(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var query usecases.MatchQualityQuery
+		if value := r.URL.Query().Get("game_mode_id"); value != "" {
+			gameModeID, err := uuid.Parse(value)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(ErrorResponse{
+					Error:   "bad_request",
+					Message: "invalid game_mode_id format",
+				})
+				return
+			}
+			query.GameModeID = &gameModeID
+		}
+
+		for param, bound := range map[string]*time.Time{"since": &query.Since, "until": &query.Until} {
+			value := r.URL.Query().Get(param)
+			if value == "" {
+				continue
+			}
+
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(ErrorResponse{
+					Error:   "bad_request",
+					Message: param + " must be an RFC 3339 timestamp",
+				})
+				return
+			}
+			*bound = parsed
+		}
+
+		var matchQualityUseCase *usecases.MatchQualityUseCase
+		if err := mc.Container.Resolve(&matchQualityUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve MatchQualityUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		summary, err := matchQualityUseCase.Summarize(r.Context(), query)
+		if err != nil {
+			writeMatchError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(summary)
+	}
+}
+
+// parseMatchID reads the id route variable, writing a bad request response when it is missing or invalid
+func parseMatchID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	matchIDStr, ok := mux.Vars(r)["id"]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "match ID is required",
+		})
+		return uuid.Nil, false
+	}
+
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "invalid_id",
+			Message: "invalid match ID format",
+		})
+		return uuid.Nil, false
+	}
+
+	return matchID, true
+}
+
+func writeMatchError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrMatchForbidden):
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "forbidden",
+			Message: usecases.ErrMatchForbidden.Error(),
+		})
+	case errors.Is(err, usecases.ErrMatchQualityForbidden):
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "forbidden",
+			Message: usecases.ErrMatchQualityForbidden.Error(),
+		})
+	case errors.Is(err, pairing_entities.ErrPairNotFound):
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: "match not found",
+		})
+	case errors.Is(err, pairing_entities.ErrInvalidTransition), errors.Is(err, pairing_entities.ErrMatchNotConfirmed):
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "conflict",
+			Message: err.Error(),
+		})
+	default:
+		slog.ErrorContext(r.Context(), "failed to process match request", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "internal_error",
+			Message: "failed to process request",
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/golobby/container/v3"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+)
+
+type PenaltyController struct {
+	Container container.Container
+}
+
+func NewPenaltyController(container container.Container) *PenaltyController {
+	return &PenaltyController{Container: container}
+}
+
+// List lists player penalties. Admin only; ?cooling_down=true restricts the list to players serving a cooldown.
+func (pc *PenaltyController) List(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		coolingDownOnly := false
+		if value := r.URL.Query().Get("cooling_down"); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(ErrorResponse{
+					Error:   "bad_request",
+					Message: "cooling_down must be a boolean",
+				})
+				return
+			}
+			coolingDownOnly = parsed
+		}
+
+		var penaltyUseCase *usecases.PenaltyUseCase
+		if err := pc.Container.Resolve(&penaltyUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve PenaltyUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		penalties, err := penaltyUseCase.List(r.Context(), coolingDownOnly)
+		if err != nil {
+			writePenaltyError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(penalties)
+	}
+}
+
+// Get retrieves the penalty of a player. Players can only see their own.
+func (pc *PenaltyController) Get(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		playerID, ok := parsePlayerID(w, r)
+		if !ok {
+			return
+		}
+
+		var penaltyUseCase *usecases.PenaltyUseCase
+		if err := pc.Container.Resolve(&penaltyUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve PenaltyUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		penalty, err := penaltyUseCase.Get(r.Context(), playerID)
+		if err != nil {
+			writePenaltyError(w, r, err)
+			return
+		}
+
+		if penalty == nil {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "not_found",
+				Message: "player has no penalty",
+			})
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(penalty)
+	}
+}
+
+// Clear lifts the cooldown of a player and forgets their offenses. Admin only.
+func (pc *PenaltyController) Clear(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		playerID, ok := parsePlayerID(w, r)
+		if !ok {
+			return
+		}
+
+		var penaltyUseCase *usecases.PenaltyUseCase
+		if err := pc.Container.Resolve(&penaltyUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve PenaltyUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		if err := penaltyUseCase.Clear(r.Context(), playerID); err != nil {
+			writePenaltyError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// parsePlayerID reads the player_id route variable, writing a bad request response when it is missing or invalid
+func parsePlayerID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	playerIDStr, ok := mux.Vars(r)["player_id"]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "player ID is required",
+		})
+		return uuid.Nil, false
+	}
+
+	playerID, err := uuid.Parse(playerIDStr)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "invalid_id",
+			Message: "invalid player ID format",
+		})
+		return uuid.Nil, false
+	}
+
+	return playerID, true
+}
+
+func writePenaltyError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, usecases.ErrPenaltyForbidden) {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "forbidden",
+			Message: usecases.ErrPenaltyForbidden.Error(),
+		})
+		return
+	}
+
+	slog.ErrorContext(r.Context(), "failed to process penalty request", "error", err)
+	w.WriteHeader(http.StatusInternalServerError)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "internal_error",
+		Message: "failed to process request",
+	})
+}
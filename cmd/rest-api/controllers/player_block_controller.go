@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/golobby/container/v3"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+)
+
+type PlayerBlockController struct {
+	Container container.Container
+}
+
+func NewPlayerBlockController(container container.Container) *PlayerBlockController {
+	return &PlayerBlockController{Container: container}
+}
+
+// BlockPlayerRequest represents the request body for adding a player to an avoid list
+type BlockPlayerRequest struct {
+	BlockedID uuid.UUID                   `json:"blocked_id"`
+	Scope     pairing_entities.BlockScope `json:"scope,omitempty"` // "teammate", "opponent" or "always" (default)
+}
+
+// List lists the avoid list of a player. Players can only see their own.
+func (bc *PlayerBlockController) List(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		playerID, ok := parsePlayerID(w, r)
+		if !ok {
+			return
+		}
+
+		var playerBlockUseCase *usecases.PlayerBlockUseCase
+		if err := bc.Container.Resolve(&playerBlockUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve PlayerBlockUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		blocks, err := playerBlockUseCase.List(r.Context(), playerID)
+		if err != nil {
+			writePlayerBlockError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(blocks)
+	}
+}
+
+// Block adds a player to the avoid list of a player, or changes the scope they are blocked with
+func (bc *PlayerBlockController) Block(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		playerID, ok := parsePlayerID(w, r)
+		if !ok {
+			return
+		}
+
+		var req BlockPlayerRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			slog.ErrorContext(r.Context(), "failed to decode request body", "error", err)
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "invalid_request",
+				Message: fmt.Sprintf("invalid JSON: %v", err),
+			})
+			return
+		}
+
+		if req.BlockedID == uuid.Nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "validation_error",
+				Message: "blocked_id is required",
+			})
+			return
+		}
+
+		var playerBlockUseCase *usecases.PlayerBlockUseCase
+		if err := bc.Container.Resolve(&playerBlockUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve PlayerBlockUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		block, err := playerBlockUseCase.Block(r.Context(), playerID, req.BlockedID, req.Scope)
+		if err != nil {
+			writePlayerBlockError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(block)
+	}
+}
+
+// Unblock removes a player from the avoid list of a player
+func (bc *PlayerBlockController) Unblock(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		playerID, ok := parsePlayerID(w, r)
+		if !ok {
+			return
+		}
+
+		blockedID, err := uuid.Parse(mux.Vars(r)["blocked_id"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "invalid_id",
+				Message: "invalid blocked player ID format",
+			})
+			return
+		}
+
+		var playerBlockUseCase *usecases.PlayerBlockUseCase
+		if err := bc.Container.Resolve(&playerBlockUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve PlayerBlockUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		if err := playerBlockUseCase.Unblock(r.Context(), playerID, blockedID); err != nil {
+			writePlayerBlockError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func writePlayerBlockError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrPlayerBlockForbidden):
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "forbidden",
+			Message: usecases.ErrPlayerBlockForbidden.Error(),
+		})
+	case errors.Is(err, pairing_entities.ErrPlayerBlockNotFound):
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: "player is not blocked",
+		})
+	case errors.Is(err, pairing_entities.ErrInvalidBlockScope), errors.Is(err, pairing_entities.ErrSelfBlock):
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	default:
+		slog.ErrorContext(r.Context(), "failed to process player block request", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "internal_error",
+			Message: "failed to process request",
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/golobby/container/v3"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+)
+
+type QueueController struct {
+	Container container.Container
+}
+
+func NewQueueController(container container.Container) *QueueController {
+	return &QueueController{Container: container}
+}
+
+// Explain tells a queued party where it stands in its pool and why it is not matched yet. Only its players and
+// administrators can see it.
+func (qc *QueueController) Explain(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		partyID, err := uuid.Parse(mux.Vars(r)["party_id"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "invalid_id",
+				Message: "invalid party ID format",
+			})
+			return
+		}
+
+		var queueExplanationUseCase *usecases.QueueExplanationUseCase
+		if err := qc.Container.Resolve(&queueExplanationUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve QueueExplanationUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		explanation, err := queueExplanationUseCase.Explain(r.Context(), partyID)
+		if err != nil {
+			writeQueueError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(explanation)
+	}
+}
+
+func writeQueueError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrQueueExplanationForbidden):
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "forbidden",
+			Message: usecases.ErrQueueExplanationForbidden.Error(),
+		})
+	case errors.Is(err, usecases.ErrPartyNotQueued):
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: "party is not queued",
+		})
+	default:
+		slog.ErrorContext(r.Context(), "failed to process queue request", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "internal_error",
+			Message: "failed to process request",
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/golobby/container/v3"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/ratings/usecases"
+)
+
+type SeasonController struct {
+	Container container.Container
+}
+
+func NewSeasonController(container container.Container) *SeasonController {
+	return &SeasonController{Container: container}
+}
+
+// List lists seasons, latest first. ?game_id and ?game_mode_id restrict the list to a game or game mode.
+func (sc *SeasonController) List(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		gameID, ok := parseQueryID(w, r, "game_id")
+		if !ok {
+			return
+		}
+
+		gameModeID, ok := parseQueryID(w, r, "game_mode_id")
+		if !ok {
+			return
+		}
+
+		var seasonUseCase *usecases.SeasonUseCase
+		if err := sc.Container.Resolve(&seasonUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve SeasonUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		seasons, err := seasonUseCase.List(r.Context(), gameID, gameModeID)
+		if err != nil {
+			writeSeasonError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(seasons)
+	}
+}
+
+// Create creates a new season. Admin only.
+func (sc *SeasonController) Create(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var season ratings_entities.Season
+		if err := json.NewDecoder(r.Body).Decode(&season); err != nil {
+			slog.ErrorContext(r.Context(), "failed to decode request body", "error", err)
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "invalid_request",
+				Message: fmt.Sprintf("invalid JSON: %v", err),
+			})
+			return
+		}
+
+		var seasonUseCase *usecases.SeasonUseCase
+		if err := sc.Container.Resolve(&seasonUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve SeasonUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		created, err := seasonUseCase.Create(r.Context(), &season)
+		if err != nil {
+			writeSeasonError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(created)
+	}
+}
+
+// Get retrieves a season by ID
+func (sc *SeasonController) Get(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		seasonID, ok := parseSeasonID(w, r)
+		if !ok {
+			return
+		}
+
+		var seasonUseCase *usecases.SeasonUseCase
+		if err := sc.Container.Resolve(&seasonUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve SeasonUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		season, err := seasonUseCase.Get(r.Context(), seasonID)
+		if err != nil {
+			writeSeasonError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(season)
+	}
+}
+
+// Archive archives the standings of an ended season and soft resets its ratings. Admin only.
+func (sc *SeasonController) Archive(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		seasonID, ok := parseSeasonID(w, r)
+		if !ok {
+			return
+		}
+
+		var seasonUseCase *usecases.SeasonUseCase
+		if err := sc.Container.Resolve(&seasonUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve SeasonUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		snapshot, err := seasonUseCase.Archive(r.Context(), seasonID)
+		if err != nil {
+			writeSeasonError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(snapshot)
+	}
+}
+
+// Snapshot retrieves the archived standings of a season
+func (sc *SeasonController) Snapshot(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		seasonID, ok := parseSeasonID(w, r)
+		if !ok {
+			return
+		}
+
+		var seasonUseCase *usecases.SeasonUseCase
+		if err := sc.Container.Resolve(&seasonUseCase); err != nil {
+			slog.ErrorContext(r.Context(), "failed to resolve SeasonUseCase", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "internal_error",
+				Message: "failed to process request",
+			})
+			return
+		}
+
+		snapshot, err := seasonUseCase.Snapshot(r.Context(), seasonID)
+		if err != nil {
+			writeSeasonError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(snapshot)
+	}
+}
+
+// parseSeasonID reads the id route variable, writing a bad request response when it is missing or invalid
+func parseSeasonID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	seasonIDStr, ok := mux.Vars(r)["id"]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "season ID is required",
+		})
+		return uuid.Nil, false
+	}
+
+	seasonID, err := uuid.Parse(seasonIDStr)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "invalid_id",
+			Message: "invalid season ID format",
+		})
+		return uuid.Nil, false
+	}
+
+	return seasonID, true
+}
+
+// parseQueryID reads an optional ID from the query string, writing a bad request response when it is invalid
+func parseQueryID(w http.ResponseWriter, r *http.Request, name string) (*uuid.UUID, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, true
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "invalid_id",
+			Message: fmt.Sprintf("invalid %s format", name),
+		})
+		return nil, false
+	}
+
+	return &id, true
+}
+
+func writeSeasonError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrSeasonForbidden):
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "forbidden",
+			Message: usecases.ErrSeasonForbidden.Error(),
+		})
+	case errors.Is(err, ratings_entities.ErrInvalidSeason):
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	case errors.Is(err, ratings_entities.ErrSeasonNotFound):
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: ratings_entities.ErrSeasonNotFound.Error(),
+		})
+	case errors.Is(err, ratings_entities.ErrNotRanked):
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: ratings_entities.ErrNotRanked.Error(),
+		})
+	case errors.Is(err, ratings_entities.ErrNotArchived):
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: ratings_entities.ErrNotArchived.Error(),
+		})
+	case errors.Is(err, ratings_entities.ErrSeasonNotEnded), errors.Is(err, ratings_entities.ErrSeasonArchived):
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "conflict",
+			Message: err.Error(),
+		})
+	default:
+		slog.ErrorContext(r.Context(), "failed to process season request", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "internal_error",
+			Message: "failed to process request",
+		})
+	}
+}
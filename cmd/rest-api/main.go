@@ -2,9 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/leet-gaming/match-making-api/cmd/rest-api/routing"
 	"github.com/leet-gaming/match-making-api/pkg/domain"
@@ -12,8 +16,12 @@ import (
 	"github.com/leet-gaming/match-making-api/pkg/infra/ioc"
 )
 
+// shutdownTimeout bounds how long in-flight requests may take once the server is asked to stop
+const shutdownTimeout = 10 * time.Second
+
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
@@ -25,9 +33,26 @@ func main() {
 
 	defer builder.Close(c)
 
+	startWorkers(ctx, c)
+
 	router := routing.NewRouter(ctx, c)
 
+	server := &http.Server{Addr: ":4991", Handler: router}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.ErrorContext(shutdownCtx, "Failed to shut down server", "error", err)
+		}
+	}()
+
 	slog.InfoContext(ctx, "Starting server on port 4991")
 
-	http.ListenAndServe(":4991", router)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.ErrorContext(ctx, "Server stopped", "error", err)
+	}
 }
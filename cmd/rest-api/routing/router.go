@@ -43,9 +43,15 @@ func NewRouter(ctx context.Context, container container.Container) http.Handler
 	gameController := controllers.NewGameController(container)
 	gameModeController := controllers.NewGameModeController(container)
 	regionController := controllers.NewRegionController(container)
+	seasonController := controllers.NewSeasonController(container)
+	leaderboardController := controllers.NewLeaderboardController(container)
 	invitationController := controllers.NewInvitationController(container)
 	externalInvitationController := controllers.NewExternalInvitationController(container)
 	notificationController := controllers.NewNotificationController(container)
+	penaltyController := controllers.NewPenaltyController(container)
+	matchController := controllers.NewMatchController(container)
+	playerBlockController := controllers.NewPlayerBlockController(container)
+	queueController := controllers.NewQueueController(container)
 
 	// health
 	r.HandleFunc(Health, healthController.HealthCheck(ctx)).Methods("GET")
@@ -87,6 +93,24 @@ func NewRouter(ctx context.Context, container container.Container) http.Handler
 	resourceContextMiddleware.RegisterOperation("/regions/{id}", "match-making:regions:update")
 	resourceContextMiddleware.RegisterOperation("/regions/{id}", "match-making:regions:delete")
 
+	// seasons
+	r.HandleFunc("/seasons", seasonController.List(ctx)).Methods("GET")
+	r.HandleFunc("/seasons", seasonController.Create(ctx)).Methods("POST")
+	r.HandleFunc("/seasons/{id}", seasonController.Get(ctx)).Methods("GET")
+	r.HandleFunc("/seasons/{id}/archive", seasonController.Archive(ctx)).Methods("POST")
+	r.HandleFunc("/seasons/{id}/snapshot", seasonController.Snapshot(ctx)).Methods("GET")
+	resourceContextMiddleware.RegisterOperation("/seasons", "match-making:seasons:list")
+	resourceContextMiddleware.RegisterOperation("/seasons", "match-making:seasons:create")
+	resourceContextMiddleware.RegisterOperation("/seasons/{id}", "match-making:seasons:get")
+	resourceContextMiddleware.RegisterOperation("/seasons/{id}/archive", "match-making:seasons:archive")
+	resourceContextMiddleware.RegisterOperation("/seasons/{id}/snapshot", "match-making:seasons:get-snapshot")
+
+	// leaderboards
+	r.HandleFunc("/seasons/{id}/leaderboard", leaderboardController.Top(ctx)).Methods("GET")
+	r.HandleFunc("/seasons/{id}/leaderboard/players/{player_id}", leaderboardController.AroundPlayer(ctx)).Methods("GET")
+	resourceContextMiddleware.RegisterOperation("/seasons/{id}/leaderboard", "match-making:leaderboards:top")
+	resourceContextMiddleware.RegisterOperation("/seasons/{id}/leaderboard/players/{player_id}", "match-making:leaderboards:around-player")
+
 	// invitations
 	r.HandleFunc("/invitations", invitationController.Create(ctx)).Methods("POST")
 	r.HandleFunc("/invitations", invitationController.List(ctx)).Methods("GET")
@@ -129,6 +153,38 @@ func NewRouter(ctx context.Context, container container.Container) http.Handler
 	resourceContextMiddleware.RegisterOperation("/notifications/{id}/read", "match-making:notifications:mark-read")
 	resourceContextMiddleware.RegisterOperation("/notifications/{id}/retry", "match-making:notifications:retry")
 
+	// penalties
+	r.HandleFunc("/penalties", penaltyController.List(ctx)).Methods("GET")
+	r.HandleFunc("/penalties/{player_id}", penaltyController.Get(ctx)).Methods("GET")
+	r.HandleFunc("/penalties/{player_id}", penaltyController.Clear(ctx)).Methods("DELETE")
+	resourceContextMiddleware.RegisterOperation("/penalties", "match-making:penalties:list")
+	resourceContextMiddleware.RegisterOperation("/penalties/{player_id}", "match-making:penalties:get")
+	resourceContextMiddleware.RegisterOperation("/penalties/{player_id}", "match-making:penalties:clear")
+
+	// avoid lists
+	r.HandleFunc("/players/{player_id}/blocks", playerBlockController.List(ctx)).Methods("GET")
+	r.HandleFunc("/players/{player_id}/blocks", playerBlockController.Block(ctx)).Methods("POST")
+	r.HandleFunc("/players/{player_id}/blocks/{blocked_id}", playerBlockController.Unblock(ctx)).Methods("DELETE")
+	resourceContextMiddleware.RegisterOperation("/players/{player_id}/blocks", "match-making:blocks:list")
+	resourceContextMiddleware.RegisterOperation("/players/{player_id}/blocks", "match-making:blocks:create")
+	resourceContextMiddleware.RegisterOperation("/players/{player_id}/blocks/{blocked_id}", "match-making:blocks:delete")
+
+	// matches; /matches/quality goes first so it is not taken for a match ID
+	r.HandleFunc("/matches/quality", matchController.Quality(ctx)).Methods("GET")
+	r.HandleFunc("/matches/{id}", matchController.Get(ctx)).Methods("GET")
+	r.HandleFunc("/matches/{id}/start", matchController.Start(ctx)).Methods("POST")
+	r.HandleFunc("/matches/{id}/complete", matchController.Complete(ctx)).Methods("POST")
+	r.HandleFunc("/matches/{id}/cancel", matchController.Cancel(ctx)).Methods("POST")
+	resourceContextMiddleware.RegisterOperation("/matches/quality", "match-making:matches:quality")
+	resourceContextMiddleware.RegisterOperation("/matches/{id}", "match-making:matches:get")
+	resourceContextMiddleware.RegisterOperation("/matches/{id}/start", "match-making:matches:start")
+	resourceContextMiddleware.RegisterOperation("/matches/{id}/complete", "match-making:matches:complete")
+	resourceContextMiddleware.RegisterOperation("/matches/{id}/cancel", "match-making:matches:cancel")
+
+	// queue
+	r.HandleFunc("/queue/{party_id}", queueController.Explain(ctx)).Methods("GET")
+	resourceContextMiddleware.RegisterOperation("/queue/{party_id}", "match-making:queue:explain")
+
 	// Swagger UI
 	r.PathPrefix("/swagger/").Handler(httpSwagger.Handler(
 		httpSwagger.URL("/docs/openapi.yaml"),
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	ratings_usecases "github.com/leet-gaming/match-making-api/pkg/domain/ratings/usecases"
+	"github.com/leet-gaming/match-making-api/pkg/infra/kafka"
+)
+
+// startWorkers starts the background loops and the Kafka consumers of the service. They run until the context is
+// done. A worker whose dependencies cannot be resolved is logged and skipped, so the API keeps serving requests.
+func startWorkers(ctx context.Context, c container.Container) {
+	var consumer *usecases.MatchmakingEventConsumer
+	if err := c.Resolve(&consumer); err != nil {
+		slog.ErrorContext(ctx, "Failed to resolve MatchmakingEventConsumer", "error", err)
+	} else {
+		go consumer.RunPoolReevaluation(ctx, usecases.DefaultPoolReevaluationInterval)
+		startConsumers(ctx, c, consumer)
+	}
+
+	var timeouts *usecases.MatchTimeoutUseCase
+	if err := c.Resolve(&timeouts); err != nil {
+		slog.ErrorContext(ctx, "Failed to resolve MatchTimeoutUseCase", "error", err)
+	} else {
+		go timeouts.RunTimeouts(ctx, usecases.DefaultMatchTimeoutInterval)
+	}
+
+	var seasons *ratings_usecases.SeasonUseCase
+	if err := c.Resolve(&seasons); err != nil {
+		slog.ErrorContext(ctx, "Failed to resolve SeasonUseCase", "error", err)
+	} else {
+		go seasons.RunArchival(ctx, ratings_usecases.DefaultSeasonArchivalInterval)
+	}
+}
+
+// startConsumers feeds the queue, lobby and match result topics to the matchmaking event consumer. Each topic is read
+// by its own consumer group, so other readers of the same topics in this service keep receiving every event.
+func startConsumers(ctx context.Context, c container.Container, consumer *usecases.MatchmakingEventConsumer) {
+	var client *kafka.Client
+	if err := c.Resolve(&client); err != nil {
+		slog.ErrorContext(ctx, "Failed to resolve Kafka client", "error", err)
+		return
+	}
+
+	groupID := client.ConsumerGroupID()
+
+	consumers := map[string]interface{ Start(context.Context) error }{
+		kafka.TopicQueueEvents:    kafka.NewQueueEventConsumer(client, groupID+".queue", consumer.HandleQueueEvent),
+		kafka.TopicLobbyEvents:    kafka.NewLobbyEventConsumer(client, groupID+".lobby", consumer.HandleLobbyEvent),
+		kafka.TopicMatchesResults: kafka.NewMatchResultConsumer(client, groupID+".results", consumer.HandleMatchEvent),
+	}
+
+	for topic, topicConsumer := range consumers {
+		go func(topic string, topicConsumer interface{ Start(context.Context) error }) {
+			if err := topicConsumer.Start(ctx); err != nil {
+				slog.ErrorContext(ctx, "Kafka consumer stopped", "topic", topic, "error", err)
+			}
+		}(topic, topicConsumer)
+	}
+}
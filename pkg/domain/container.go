@@ -7,11 +7,13 @@ import (
 	"github.com/leet-gaming/match-making-api/pkg/domain/iam"
 	"github.com/leet-gaming/match-making-api/pkg/domain/lobbies"
 	"github.com/leet-gaming/match-making-api/pkg/domain/pairing"
+	"github.com/leet-gaming/match-making-api/pkg/domain/ratings"
 	"github.com/leet-gaming/match-making-api/pkg/domain/schedules"
 )
 
 // Inject initializes and sets up the domain components of the application.
-// It sequentially injects dependencies for lobbies, pairing, schedules, and games.
+// It sequentially injects dependencies for games, iam, lobbies, ratings, pairing and schedules. The match strategies
+// come before games, whose game modes name them, and ratings before pairing, whose event consumer rates matches.
 //
 // Parameters:
 //   - c: A container.Container that can be used to cancel the operation or pass deadlines.
@@ -19,5 +21,5 @@ import (
 // Returns:
 //   - error: An error if any of the injection processes fail, nil otherwise.
 func Inject(c container.Container) error {
-	return common.InjectAll(c, game.Inject, iam.Inject, lobbies.Inject, pairing.Inject, schedules.Inject)
+	return common.InjectAll(c, pairing.InjectStrategies, game.Inject, iam.Inject, lobbies.Inject, ratings.Inject, pairing.Inject, schedules.Inject)
 }
@@ -0,0 +1,232 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultPriorityBoostSeconds is the head start boosted parties get when the game mode does not set one
+const DefaultPriorityBoostSeconds = 30
+
+// DefaultMapPatienceSeconds is how long parties wait for others sharing their preferred maps when the game mode does
+// not set it
+const DefaultMapPatienceSeconds = 60
+
+// DefaultRematchPenaltyMMR is what each two parties who met recently add to the MMR spread of a group, when the game
+// mode penalizes rematches without setting it
+const DefaultRematchPenaltyMMR = 100
+
+// DefaultBatchTickSeconds is how often the batch matcher matches a pool when the game mode does not set it
+const DefaultBatchTickSeconds = 10
+
+// MatchmakingSettings holds the per game mode knobs used by the matcher
+type MatchmakingSettings struct {
+	WindowExpansion      []WindowExpansionStep  `json:"window_expansion,omitempty" bson:"window_expansion,omitempty"`             // how skill and ping windows relax while a party waits
+	ReadyCheckSeconds    int                    `json:"ready_check_seconds,omitempty" bson:"ready_check_seconds,omitempty"`       // time players have to accept a match; 0 disables the ready check
+	PriorityBoostSeconds *int                   `json:"priority_boost_seconds,omitempty" bson:"priority_boost_seconds,omitempty"` // head start of parties with a priority boost; nil uses DefaultPriorityBoostSeconds, 0 disables the boost
+	MapPool              []string               `json:"map_pool,omitempty" bson:"map_pool,omitempty"`                             // maps the game mode is played on; empty uses the game's MapPool
+	MapPatienceSeconds   int                    `json:"map_patience_seconds,omitempty" bson:"map_patience_seconds,omitempty"`     // time parties wait for others sharing their preferred maps; 0 uses DefaultMapPatienceSeconds
+	RatingAlgorithm      string                 `json:"rating_algorithm,omitempty" bson:"rating_algorithm,omitempty"`             // elo, glicko2 or trueskill; empty rates matches with glicko2
+	RoleQuotas           []RoleQuota            `json:"role_quotas,omitempty" bson:"role_quotas,omitempty"`                       // players each team needs in each role; empty when roles do not matter
+	RematchAvoidance     *RematchAvoidance      `json:"rematch_avoidance,omitempty" bson:"rematch_avoidance,omitempty"`           // how parties who met recently are kept apart; nil lets them meet again right away
+	Matcher              MatcherMode            `json:"matcher,omitempty" bson:"matcher,omitempty"`                               // greedy or batch; empty matches greedily
+	BatchTickSeconds     int                    `json:"batch_tick_seconds,omitempty" bson:"batch_tick_seconds,omitempty"`         // time between two batches of a pool, in batch mode; 0 uses DefaultBatchTickSeconds
+	Strategy             *MatchStrategySettings `json:"strategy,omitempty" bson:"strategy,omitempty"`                             // how groups are proposed and rated; nil matches by skill when the game has SkillBasedMatching, in FIFO order otherwise
+}
+
+// names of the rating algorithms game modes can rate their matches with
+const (
+	RatingAlgorithmElo       = "elo"       // a single skill estimate per player
+	RatingAlgorithmGlicko2   = "glicko2"   // skill estimate, its deviation and the player's volatility
+	RatingAlgorithmTrueSkill = "trueskill" // skill estimate and its deviation, rating every player of a team
+)
+
+// IsRatingAlgorithm tells whether game modes can rate their matches with the algorithm, regardless of case. The
+// empty name leaves the choice to the ratings module.
+func IsRatingAlgorithm(name string) bool {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", RatingAlgorithmElo, RatingAlgorithmGlicko2, RatingAlgorithmTrueSkill:
+		return true
+	}
+
+	return false
+}
+
+// names of the match strategies every matcher knows
+const (
+	MatchStrategyFIFO      = "fifo"      // longest waiting parties first
+	MatchStrategySkill     = "skill"     // closest MMR first, within the parties' skill windows
+	MatchStrategySchedule  = "schedule"  // longest waiting parties first, among parties whose schedules overlap
+	MatchStrategyComposite = "composite" // weighs the groups proposed by its parts
+)
+
+// names of the parameters tuning how every match strategy rates the groups it proposes
+const (
+	MatchStrategyParamMMRSpread   = "mmr_spread"   // MMR spread at which a group's skill score drops to zero
+	MatchStrategyParamWaitSeconds = "wait_seconds" // wait at which a group's wait score saturates
+	MatchStrategyParamMaxPing     = "max_ping"     // ping at which a group's ping score drops to zero
+)
+
+// IsMatchStrategyParam tells whether the parameter tunes match strategies
+func IsMatchStrategyParam(param string) bool {
+	switch param {
+	case MatchStrategyParamMMRSpread, MatchStrategyParamWaitSeconds, MatchStrategyParamMaxPing:
+		return true
+	}
+
+	return false
+}
+
+// MatchStrategySettings names the strategy proposing the groups a game mode's matches are made of, along with the
+// parameters tuning how it rates them
+type MatchStrategySettings struct {
+	Name   string                  `json:"name" bson:"name"`                         // see the MatchStrategy constants
+	Params map[string]float64      `json:"params,omitempty" bson:"params,omitempty"` // see the MatchStrategyParam constants
+	Parts  []WeightedMatchStrategy `json:"parts,omitempty" bson:"parts,omitempty"`   // strategies a composite strategy weighs
+}
+
+// WeightedMatchStrategy is a part of a composite strategy, along with its weight
+type WeightedMatchStrategy struct {
+	MatchStrategySettings `bson:",inline"`
+	Weight                float64 `json:"weight" bson:"weight"`
+}
+
+// MatcherMode is how the parties of a pool are matched
+type MatcherMode string
+
+const (
+	MatcherGreedy MatcherMode = "greedy" // one match at a time, as soon as parties join
+	MatcherBatch  MatcherMode = "batch"  // every waiting party at once on each tick, for the best total match quality
+)
+
+// IsValid reports whether the mode is one of the known modes. The empty mode matches greedily.
+func (m MatcherMode) IsValid() bool {
+	return m == "" || m == MatcherGreedy || m == MatcherBatch
+}
+
+// Batches reports whether pools are matched in batches on a tick, rather than greedily as parties join
+func (s MatchmakingSettings) Batches() bool {
+	return s.Matcher == MatcherBatch
+}
+
+// BatchTick returns the time between two batches of a pool
+func (s MatchmakingSettings) BatchTick() time.Duration {
+	if s.BatchTickSeconds <= 0 {
+		return DefaultBatchTickSeconds * time.Second
+	}
+
+	return time.Duration(s.BatchTickSeconds) * time.Second
+}
+
+// RoleQuota is how many players of each team must play a role, e.g. 1 tank, 1 healer and 3 dps. Seats of a team
+// left over by its quotas can be taken by players of any role.
+type RoleQuota struct {
+	Role  string `json:"role" bson:"role"`
+	Count int    `json:"count" bson:"count"`
+}
+
+// RematchMode is how the matcher treats groups holding parties who met recently
+type RematchMode string
+
+const (
+	RematchExclude RematchMode = "exclude" // they are never matched together
+	RematchPenalty RematchMode = "penalty" // they are matched together only when they make the best group available
+)
+
+// RematchAvoidance keeps parties who faced each other recently from meeting again, in pools holding enough parties
+// to match them with others. Parties met recently when they faced each other in any of their LastMatches latest
+// matches, or in a match created in the last WithinMinutes.
+type RematchAvoidance struct {
+	Mode          RematchMode `json:"mode" bson:"mode"`
+	LastMatches   int         `json:"last_matches,omitempty" bson:"last_matches,omitempty"`     // matches of a party looked back on; 0 does not count matches
+	WithinMinutes int         `json:"within_minutes,omitempty" bson:"within_minutes,omitempty"` // minutes looked back on; 0 does not look back in time
+	PenaltyMMR    int         `json:"penalty_mmr,omitempty" bson:"penalty_mmr,omitempty"`       // in penalty mode, added to the MMR spread of a group per two parties who met recently; 0 uses DefaultRematchPenaltyMMR
+	MinPoolSize   int         `json:"min_pool_size,omitempty" bson:"min_pool_size,omitempty"`   // parties a pool must hold for rematches to be avoided; 0 always avoids them
+}
+
+// Enabled reports whether rematches are avoided at all: some matches or minutes are looked back on
+func (r *RematchAvoidance) Enabled() bool {
+	return r != nil && (r.LastMatches > 0 || r.WithinMinutes > 0)
+}
+
+// Window returns how far back in time parties who faced each other are kept apart, or 0 when time does not matter
+func (r *RematchAvoidance) Window() time.Duration {
+	if r == nil || r.WithinMinutes <= 0 {
+		return 0
+	}
+
+	return time.Duration(r.WithinMinutes) * time.Minute
+}
+
+// Penalty returns what each two parties who met recently add to the MMR spread of a group, or 0 when such groups are
+// excluded instead
+func (r *RematchAvoidance) Penalty() int {
+	if r == nil || r.Mode != RematchPenalty {
+		return 0
+	}
+
+	if r.PenaltyMMR <= 0 {
+		return DefaultRematchPenaltyMMR
+	}
+
+	return r.PenaltyMMR
+}
+
+// MapPatience returns how long parties wait to be matched with others sharing their preferred maps, before being
+// matched regardless of the map
+func (s MatchmakingSettings) MapPatience() time.Duration {
+	if s.MapPatienceSeconds <= 0 {
+		return DefaultMapPatienceSeconds * time.Second
+	}
+
+	return time.Duration(s.MapPatienceSeconds) * time.Second
+}
+
+// PriorityBoostWindow returns how much earlier than they joined parties with a priority boost are considered to have
+// joined, or 0 when the game mode disables the boost. It bounds the extra wait boosting costs everyone else.
+func (s MatchmakingSettings) PriorityBoostWindow() time.Duration {
+	if s.PriorityBoostSeconds == nil {
+		return DefaultPriorityBoostSeconds * time.Second
+	}
+
+	return time.Duration(*s.PriorityBoostSeconds) * time.Second
+}
+
+// ReadyCheckWindow returns how long players have to accept a match, or 0 when matches need no acceptance
+func (s MatchmakingSettings) ReadyCheckWindow() time.Duration {
+	if s.ReadyCheckSeconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(s.ReadyCheckSeconds) * time.Second
+}
+
+// WindowExpansionStep relaxes the matchmaking windows of parties that have waited at least AfterSeconds.
+// Steps form a curve: the step with the longest AfterSeconds already reached applies, so the last step is the cap.
+type WindowExpansionStep struct {
+	AfterSeconds int `json:"after_seconds" bson:"after_seconds"`           // time waited before the step applies
+	MMRDelta     int `json:"mmr_delta" bson:"mmr_delta"`                   // added to each side of the party's skill window
+	MaxPing      int `json:"max_ping,omitempty" bson:"max_ping,omitempty"` // relaxed MaxPing; never tightens the requested one
+}
+
+// ActiveExpansionStep returns the step that applies after waiting for the given time, or nil when none does yet
+func ActiveExpansionStep(steps []WindowExpansionStep, waited time.Duration) *WindowExpansionStep {
+	ordered := make([]WindowExpansionStep, len(steps))
+	copy(ordered, steps)
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].AfterSeconds < ordered[j].AfterSeconds
+	})
+
+	var active *WindowExpansionStep
+	for i := range ordered {
+		if time.Duration(ordered[i].AfterSeconds)*time.Second > waited {
+			break
+		}
+
+		active = &ordered[i]
+	}
+
+	return active
+}
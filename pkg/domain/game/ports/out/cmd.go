@@ -45,3 +45,9 @@ type RegionReader interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Region, error)
 	Search(ctx context.Context, query interface{}) ([]*entities.Region, error)
 }
+
+// MatchStrategyCatalog knows the match strategies game modes can name. It is provided by the pairing module (see
+// pairing.InjectStrategies)
+type MatchStrategyCatalog interface {
+	Knows(name string) bool
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/gofrs/uuid"
 	"github.com/golobby/container/v3"
@@ -17,25 +18,27 @@ import (
 type CreateGameModeUseCase struct {
 	GameModeWriter out.GameModeWriter
 	GameModeReader out.GameModeReader
+	Strategies     out.MatchStrategyCatalog
 }
 
-func NewCreateGameModeUseCase(gameModeWriter out.GameModeWriter, gameModeReader out.GameModeReader) in.CreateGameModeCommand {
+func NewCreateGameModeUseCase(gameModeWriter out.GameModeWriter, gameModeReader out.GameModeReader, strategies out.MatchStrategyCatalog) in.CreateGameModeCommand {
 	return &CreateGameModeUseCase{
 		GameModeWriter: gameModeWriter,
 		GameModeReader: gameModeReader,
+		Strategies:     strategies,
 	}
 }
 
 func InjectCreateGameMode(c container.Container) error {
-	c.Singleton(func(gameModeWriter out.GameModeWriter, gameModeReader out.GameModeReader) (in.CreateGameModeCommand, error) {
-		return NewCreateGameModeUseCase(gameModeWriter, gameModeReader), nil
+	c.Singleton(func(gameModeWriter out.GameModeWriter, gameModeReader out.GameModeReader, strategies out.MatchStrategyCatalog) (in.CreateGameModeCommand, error) {
+		return NewCreateGameModeUseCase(gameModeWriter, gameModeReader, strategies), nil
 	})
 	return nil
 }
 
 func (usecase *CreateGameModeUseCase) Execute(ctx context.Context, gameMode *game_entities.GameMode) (*game_entities.GameMode, error) {
 	// Validate game mode data
-	if err := validateGameMode(gameMode); err != nil {
+	if err := validateGameMode(gameMode, usecase.Strategies); err != nil {
 		slog.ErrorContext(ctx, "game mode validation failed", "error", err)
 		return nil, fmt.Errorf("invalid game mode data: %w", err)
 	}
@@ -74,8 +77,9 @@ func (usecase *CreateGameModeUseCase) Execute(ctx context.Context, gameMode *gam
 	return createdGameMode, nil
 }
 
-// validateGameMode validates game mode data before creating or updating
-func validateGameMode(gameMode *game_entities.GameMode) error {
+// validateGameMode validates game mode data before creating or updating. Strategies are checked against the ones the
+// matcher knows.
+func validateGameMode(gameMode *game_entities.GameMode, strategies out.MatchStrategyCatalog) error {
 	if gameMode.Name == "" {
 		return errors.New("game mode name is required")
 	}
@@ -88,5 +92,115 @@ func validateGameMode(gameMode *game_entities.GameMode) error {
 		return errors.New("game_id is required and must be a valid UUID")
 	}
 
+	for _, step := range gameMode.Matchmaking.WindowExpansion {
+		if step.AfterSeconds < 0 || step.MMRDelta < 0 || step.MaxPing < 0 {
+			return errors.New("window_expansion steps must not have negative values")
+		}
+	}
+
+	if gameMode.Matchmaking.ReadyCheckSeconds < 0 {
+		return errors.New("ready_check_seconds must not be negative")
+	}
+
+	if boost := gameMode.Matchmaking.PriorityBoostSeconds; boost != nil && *boost < 0 {
+		return errors.New("priority_boost_seconds must not be negative")
+	}
+
+	if gameMode.Matchmaking.MapPatienceSeconds < 0 {
+		return errors.New("map_patience_seconds must not be negative")
+	}
+
+	if !game_entities.IsRatingAlgorithm(gameMode.Matchmaking.RatingAlgorithm) {
+		return errors.New("rating_algorithm must be one of elo, glicko2 or trueskill")
+	}
+
+	roles := make(map[string]bool, len(gameMode.Matchmaking.RoleQuotas))
+	for _, quota := range gameMode.Matchmaking.RoleQuotas {
+		role := strings.ToLower(strings.TrimSpace(quota.Role))
+		if role == "" || quota.Count <= 0 {
+			return errors.New("role_quotas must name a role and need at least one player")
+		}
+
+		if roles[role] {
+			return fmt.Errorf("role_quotas must not repeat the role %s", quota.Role)
+		}
+
+		roles[role] = true
+	}
+
+	if !gameMode.Matchmaking.Matcher.IsValid() {
+		return errors.New("matcher must be greedy or batch")
+	}
+
+	if gameMode.Matchmaking.BatchTickSeconds < 0 {
+		return errors.New("batch_tick_seconds must not be negative")
+	}
+
+	if strategy := gameMode.Matchmaking.Strategy; strategy != nil {
+		if err := validateMatchStrategy(*strategy, strategies); err != nil {
+			return err
+		}
+	}
+
+	if rematches := gameMode.Matchmaking.RematchAvoidance; rematches != nil {
+		if rematches.Mode != game_entities.RematchExclude && rematches.Mode != game_entities.RematchPenalty {
+			return errors.New("rematch_avoidance mode must be exclude or penalty")
+		}
+
+		if rematches.LastMatches < 0 || rematches.WithinMinutes < 0 || rematches.PenaltyMMR < 0 || rematches.MinPoolSize < 0 {
+			return errors.New("rematch_avoidance must not have negative values")
+		}
+
+		if !rematches.Enabled() {
+			return errors.New("rematch_avoidance must look back on last_matches, within_minutes or both")
+		}
+	}
+
+	return nil
+}
+
+// validateMatchStrategy checks the strategy and, for composite strategies, every part. Game modes can only name the
+// strategies the matcher knows, tuned by the parameters they know.
+func validateMatchStrategy(strategy game_entities.MatchStrategySettings, strategies out.MatchStrategyCatalog) error {
+	if strings.TrimSpace(strategy.Name) == "" {
+		return errors.New("strategy name is required")
+	}
+
+	if !strategies.Knows(strategy.Name) {
+		return fmt.Errorf("strategy %s is not known to the matcher", strategy.Name)
+	}
+
+	for param, value := range strategy.Params {
+		if !game_entities.IsMatchStrategyParam(param) {
+			return fmt.Errorf("strategy parameter %s must be mmr_spread, wait_seconds or max_ping", param)
+		}
+
+		if value < 0 {
+			return fmt.Errorf("strategy parameter %s must not be negative", param)
+		}
+	}
+
+	if strategy.Name != game_entities.MatchStrategyComposite {
+		if len(strategy.Parts) > 0 {
+			return fmt.Errorf("only composite strategies have parts, not %s", strategy.Name)
+		}
+
+		return nil
+	}
+
+	if len(strategy.Parts) == 0 {
+		return errors.New("composite strategies need at least one part")
+	}
+
+	for _, part := range strategy.Parts {
+		if part.Weight <= 0 {
+			return errors.New("strategy parts must weigh more than zero")
+		}
+
+		if err := validateMatchStrategy(part.MatchStrategySettings, strategies); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -17,18 +17,20 @@ import (
 type UpdateGameModeUseCase struct {
 	GameModeWriter out.GameModeWriter
 	GameModeReader out.GameModeReader
+	Strategies     out.MatchStrategyCatalog
 }
 
-func NewUpdateGameModeUseCase(gameModeWriter out.GameModeWriter, gameModeReader out.GameModeReader) in.UpdateGameModeCommand {
+func NewUpdateGameModeUseCase(gameModeWriter out.GameModeWriter, gameModeReader out.GameModeReader, strategies out.MatchStrategyCatalog) in.UpdateGameModeCommand {
 	return &UpdateGameModeUseCase{
 		GameModeWriter: gameModeWriter,
 		GameModeReader: gameModeReader,
+		Strategies:     strategies,
 	}
 }
 
 func InjectUpdateGameMode(c container.Container) error {
-	c.Singleton(func(gameModeWriter out.GameModeWriter, gameModeReader out.GameModeReader) (in.UpdateGameModeCommand, error) {
-		return NewUpdateGameModeUseCase(gameModeWriter, gameModeReader), nil
+	c.Singleton(func(gameModeWriter out.GameModeWriter, gameModeReader out.GameModeReader, strategies out.MatchStrategyCatalog) (in.UpdateGameModeCommand, error) {
+		return NewUpdateGameModeUseCase(gameModeWriter, gameModeReader, strategies), nil
 	})
 	return nil
 }
@@ -42,7 +44,7 @@ func (usecase *UpdateGameModeUseCase) Execute(ctx context.Context, id uuid.UUID,
 	}
 
 	// Validate game mode data
-	if err := validateGameMode(gameMode); err != nil {
+	if err := validateGameMode(gameMode, usecase.Strategies); err != nil {
 		slog.ErrorContext(ctx, "game mode validation failed", "error", err, "game_mode_id", id)
 		return nil, fmt.Errorf("invalid game mode data: %w", err)
 	}
@@ -66,6 +68,7 @@ func (usecase *UpdateGameModeUseCase) Execute(ctx context.Context, id uuid.UUID,
 	existingGameMode.Name = gameMode.Name
 	existingGameMode.Description = gameMode.Description
 	existingGameMode.GameID = gameMode.GameID
+	existingGameMode.Matchmaking = gameMode.Matchmaking
 	existingGameMode.UpdatedAt = time.Now()
 
 	// Update the game mode
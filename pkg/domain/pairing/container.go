@@ -1,62 +1,32 @@
 package pairing
 
 import (
-	"sync"
-
 	"github.com/golobby/container/v3"
 	game_out "github.com/leet-gaming/match-making-api/pkg/domain/game/ports/out"
 	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
 	pairing_in "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/in"
 	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
 	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
-	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	parties_out "github.com/leet-gaming/match-making-api/pkg/domain/parties/ports/out"
+	ratings_usecases "github.com/leet-gaming/match-making-api/pkg/domain/ratings/usecases"
 	schedules_in_ports "github.com/leet-gaming/match-making-api/pkg/domain/schedules/ports/in"
+	"github.com/leet-gaming/match-making-api/pkg/infra/billing"
 	"github.com/leet-gaming/match-making-api/pkg/infra/kafka"
+	"github.com/leet-gaming/match-making-api/pkg/infra/squad"
 )
 
-// mockPoolReader is a simple in-memory implementation for development
-type mockPoolReader struct {
-	mu    sync.RWMutex
-	pools map[string]*pairing_entities.Pool
-}
-
-func (m *mockPoolReader) FindPool(criteria *pairing_value_objects.Criteria) (*pairing_entities.Pool, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-	
-	// Simple implementation - create a key based on criteria
-	key := ""
-	if criteria.GameID != nil {
-		key += criteria.GameID.String()
-	}
-	if criteria.Region != nil {
-		key += "-" + criteria.Region.Slug
-	}
-	
-	if pool, exists := m.pools[key]; exists {
-		return pool, nil
+// InjectStrategies registers the registry of the match strategies game modes can name. The game module validates game
+// modes against it, so it is registered before the game module.
+func InjectStrategies(c container.Container) error {
+	if err := c.Singleton(func() (*pairing_entities.StrategyRegistry, error) {
+		return pairing_entities.NewStrategyRegistry(), nil
+	}); err != nil {
+		return err
 	}
-	return nil, nil
-}
-
-// mockPoolWriter is a simple in-memory implementation for development
-type mockPoolWriter struct {
-	reader *mockPoolReader
-}
 
-func (m *mockPoolWriter) Save(pool *pairing_entities.Pool) (*pairing_entities.Pool, error) {
-	m.reader.mu.Lock()
-	defer m.reader.mu.Unlock()
-	
-	if m.reader.pools == nil {
-		m.reader.pools = make(map[string]*pairing_entities.Pool)
-	}
-	
-	// For now, use a simple key - in real implementation this would be based on criteria
-	// Since Pool doesn't store criteria, we'll use a default key for development
-	key := "default-pool"
-	m.reader.pools[key] = pool
-	return pool, nil
+	return c.Singleton(func(strategies *pairing_entities.StrategyRegistry) (game_out.MatchStrategyCatalog, error) {
+		return strategies, nil
+	})
 }
 
 // Inject initializes and registers pairing-related dependencies in the provided container.
@@ -74,29 +44,230 @@ func Inject(c container.Container) error {
 		return err
 	}
 
-	// Register mock PoolReader and PoolWriter for development
-	mockReader := &mockPoolReader{}
-	if err := c.Singleton(func() pairing_out.PoolReader {
-		return mockReader
+	// Register PoolInitiator use case. PoolReader/PoolWriter are provided by the infra layer (see mongodb.InjectPoolRepository)
+	if err := c.Singleton(func() (pairing_in.PoolInitiator, error) {
+		return usecases.NewCreatePoolUseCase(), nil
+	}); err != nil {
+		return err
+	}
+
+	// Register ReadyCheck use case
+	if err := c.Singleton(func(
+		pairReader pairing_out.PairReader,
+		pairWriter pairing_out.PairWriter,
+		poolReader pairing_out.PoolReader,
+		poolWriter pairing_out.PoolWriter,
+	) (*usecases.ReadyCheckUseCase, error) {
+		return &usecases.ReadyCheckUseCase{
+			PairReader: pairReader,
+			PairWriter: pairWriter,
+			PoolReader: poolReader,
+			PoolWriter: poolWriter,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register Penalty use case
+	if err := c.Singleton(func(
+		penaltyReader pairing_out.PenaltyReader,
+		penaltyWriter pairing_out.PenaltyWriter,
+	) (*usecases.PenaltyUseCase, error) {
+		return &usecases.PenaltyUseCase{
+			PenaltyReader: penaltyReader,
+			PenaltyWriter: penaltyWriter,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register Backfill use case. The PartyReader is provided by the parties module
+	if err := c.Singleton(func(
+		pairReader pairing_out.PairReader,
+		pairWriter pairing_out.PairWriter,
+		poolReader pairing_out.PoolReader,
+		poolWriter pairing_out.PoolWriter,
+		partyReader parties_out.PartyReader,
+	) (*usecases.BackfillUseCase, error) {
+		return &usecases.BackfillUseCase{
+			PairReader:  pairReader,
+			PairWriter:  pairWriter,
+			PoolReader:  poolReader,
+			PoolWriter:  poolWriter,
+			PartyReader: partyReader,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register SubscriptionPriority use case. The billing client is provided by the infra layer (see billing.Inject)
+	if err := c.Singleton(func(client billing.SubscriptionServiceClient) (*usecases.SubscriptionPriorityUseCase, error) {
+		return &usecases.SubscriptionPriorityUseCase{Client: client}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register PlayerRoles use case. The player profile client is provided by the infra layer (see squad.Inject)
+	if err := c.Singleton(func(client squad.PlayerProfileServiceClient) (*usecases.PlayerRolesUseCase, error) {
+		return &usecases.PlayerRolesUseCase{Client: client}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register PlayerBlock use case
+	if err := c.Singleton(func(
+		blockReader pairing_out.PlayerBlockReader,
+		blockWriter pairing_out.PlayerBlockWriter,
+	) (*usecases.PlayerBlockUseCase, error) {
+		return &usecases.PlayerBlockUseCase{
+			PlayerBlockReader: blockReader,
+			PlayerBlockWriter: blockWriter,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register MatchLifecycle use case
+	if err := c.Singleton(func(
+		pairReader pairing_out.PairReader,
+		pairWriter pairing_out.PairWriter,
+		eventPublisher *kafka.EventPublisher,
+	) (*usecases.MatchLifecycleUseCase, error) {
+		return &usecases.MatchLifecycleUseCase{
+			PairReader: pairReader,
+			PairWriter: pairWriter,
+			Publisher:  eventPublisher,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register MatchQuality use case
+	if err := c.Singleton(func(pairReader pairing_out.PairReader) (*usecases.MatchQualityUseCase, error) {
+		return &usecases.MatchQualityUseCase{PairReader: pairReader}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register PairCreator use case. The PartyReader is provided by the parties module
+	if err := c.Singleton(func(
+		partyReader parties_out.PartyReader,
+		pairWriter pairing_out.PairWriter,
+	) (pairing_in.PairCreator, error) {
+		return &usecases.CreatePairUseCase{
+			PartyReader: partyReader,
+			PairWriter:  pairWriter,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register AddAndFindNextPair use case. It reads the settings of games and game modes, and reads past pairs to keep
+	// recent opponents apart; the game readers are provided by the infra layer (see mongodb.InjectGameRepository and mongodb.InjectGameModeRepository)
+	if err := c.Singleton(func(
+		poolReader pairing_out.PoolReader,
+		poolWriter pairing_out.PoolWriter,
+		scheduleReader schedules_in_ports.PartyScheduleReader,
+		poolInitiator pairing_in.PoolInitiator,
+		pairCreator pairing_in.PairCreator,
+		scheduleMatcher pairing_in.PartyScheduleMatcher,
+		gameReader game_out.GameReader,
+		gameModeReader game_out.GameModeReader,
+		pairReader pairing_out.PairReader,
+		strategies *pairing_entities.StrategyRegistry,
+	) (*usecases.AddAndFindNextPairUseCase, error) {
+		return &usecases.AddAndFindNextPairUseCase{
+			PoolReader:          poolReader,
+			PoolWriter:          poolWriter,
+			PartyScheduleReader: scheduleReader,
+			PoolInitiator:       poolInitiator,
+			PairCreator:         pairCreator,
+			ScheduleMatcher:     scheduleMatcher,
+			GameReader:          gameReader,
+			GameModeReader:      gameModeReader,
+			PairReader:          pairReader,
+			Strategies:          strategies,
+		}, nil
 	}); err != nil {
 		return err
 	}
 
-	if err := c.Singleton(func() pairing_out.PoolWriter {
-		return &mockPoolWriter{reader: mockReader}
+	// Register QueueExplanation use case. The game readers are provided by the infra layer (see mongodb.InjectGameRepository and mongodb.InjectGameModeRepository)
+	if err := c.Singleton(func(
+		poolReader pairing_out.PoolReader,
+		gameReader game_out.GameReader,
+		gameModeReader game_out.GameModeReader,
+		strategies *pairing_entities.StrategyRegistry,
+	) (*usecases.QueueExplanationUseCase, error) {
+		return &usecases.QueueExplanationUseCase{
+			PoolReader:     poolReader,
+			GameReader:     gameReader,
+			GameModeReader: gameModeReader,
+			Strategies:     strategies,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register SendNotification use case
+	if err := c.Singleton(func(
+		notificationWriter pairing_out.NotificationWriter,
+		notificationReader pairing_out.NotificationReader,
+		preferencesReader pairing_out.UserNotificationPreferencesReader,
+	) (*usecases.SendNotificationUseCase, error) {
+		return &usecases.SendNotificationUseCase{
+			NotificationWriter:                notificationWriter,
+			NotificationReader:                notificationReader,
+			UserNotificationPreferencesReader: preferencesReader,
+			SenderFactory:                     usecases.NewNotificationSenderFactory(),
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register MatchTimeout use case. The GameReader is provided by the infra layer (see mongodb.InjectGameRepository)
+	if err := c.Singleton(func(
+		gameReader game_out.GameReader,
+		pairReader pairing_out.PairReader,
+		lifecycle *usecases.MatchLifecycleUseCase,
+		notifier *usecases.SendNotificationUseCase,
+	) (*usecases.MatchTimeoutUseCase, error) {
+		return &usecases.MatchTimeoutUseCase{
+			GameReader: gameReader,
+			PairReader: pairReader,
+			Lifecycle:  lifecycle,
+			Notifier:   notifier,
+		}, nil
 	}); err != nil {
 		return err
 	}
 
-	// Register MatchmakingEventConsumer
+	// Register MatchmakingEventConsumer. The Rating use case is provided by the ratings module (see ratings.Inject)
 	if err := c.Singleton(func(
 		addAndFindNextPair *usecases.AddAndFindNextPairUseCase,
 		eventPublisher *kafka.EventPublisher,
 		regionReader game_out.RegionReader,
 		poolReader pairing_out.PoolReader,
 		poolWriter pairing_out.PoolWriter,
+		readyCheck *usecases.ReadyCheckUseCase,
+		penalties *usecases.PenaltyUseCase,
+		backfill *usecases.BackfillUseCase,
+		priority *usecases.SubscriptionPriorityUseCase,
+		roles *usecases.PlayerRolesUseCase,
+		blocks *usecases.PlayerBlockUseCase,
+		ratings *ratings_usecases.RatingUseCase,
+		lifecycle *usecases.MatchLifecycleUseCase,
 	) *usecases.MatchmakingEventConsumer {
-		return usecases.NewMatchmakingEventConsumer(addAndFindNextPair, eventPublisher, regionReader, poolReader, poolWriter)
+		return usecases.NewMatchmakingEventConsumer(addAndFindNextPair, eventPublisher, regionReader, poolReader, poolWriter).
+			WithReadyCheck(readyCheck).
+			WithPenalties(penalties).
+			WithBackfill(backfill).
+			WithSubscriptionPriority(priority).
+			WithPlayerRoles(roles).
+			WithBlockList(blocks).
+			WithRatings(ratings).
+			WithLifecycle(lifecycle).
+			WithBatchMatcher(addAndFindNextPair)
 	}); err != nil {
 		return err
 	}
@@ -0,0 +1,211 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/domain/parties/entities"
+)
+
+var ErrTeamNotFound = errors.New("team not found in pair")
+
+// BackfillSlot is a request, posted by a match in progress, for queued players to replace the ones who left one
+// of its teams. Slots are served before regular matches, by the parties that opted into backfill.
+type BackfillSlot struct {
+	ID          uuid.UUID `json:"id" bson:"id"`
+	PairID      uuid.UUID `json:"pair_id" bson:"pair_id"`
+	TeamID      uuid.UUID `json:"team_id" bson:"team_id"`
+	Seats       int       `json:"seats" bson:"seats"`           // players still missing
+	TargetMMR   int       `json:"target_mmr" bson:"target_mmr"` // average MMR of the team
+	RequestedAt time.Time `json:"requested_at" bson:"requested_at"`
+	ExpiresAt   time.Time `json:"expires_at" bson:"expires_at"` // the match stops waiting for replacements afterwards
+}
+
+// BackfillFill is a party taken out of the pool to take seats of a backfill slot
+type BackfillFill struct {
+	Slot  BackfillSlot
+	Entry PoolEntry
+}
+
+// Accepts reports whether the entry can take seats in the slot: the party opted into backfill, fits in the seats
+// left and accepts the team's MMR
+func (s BackfillSlot) Accepts(entry PoolEntry) bool {
+	if !entry.Backfill || entry.PlayerCount() > s.Seats {
+		return false
+	}
+
+	minMMR, maxMMR := entry.SkillWindow()
+
+	return s.TargetMMR >= minMMR && s.TargetMMR <= maxMMR
+}
+
+// RequestBackfill opens the slots in the pool. A slot for a team that already waits on replacements adds its seats
+// to the open one instead.
+func (e *Pool) RequestBackfill(slots ...BackfillSlot) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for _, slot := range slots {
+		i := slices.IndexFunc(e.BackfillSlots, func(open BackfillSlot) bool {
+			return open.PairID == slot.PairID && open.TeamID == slot.TeamID
+		})
+
+		if i < 0 {
+			e.BackfillSlots = append(e.BackfillSlots, slot)
+			continue
+		}
+
+		e.BackfillSlots[i].Seats += slot.Seats
+		e.BackfillSlots[i].TargetMMR = slot.TargetMMR
+		e.BackfillSlots[i].ExpiresAt = slot.ExpiresAt
+	}
+
+	e.UpdatedAt = time.Now()
+}
+
+// OpenSlots returns a copy of the backfill slots still waiting for players, oldest first
+func (e *Pool) OpenSlots() []BackfillSlot {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	slots := make([]BackfillSlot, len(e.BackfillSlots))
+	copy(slots, e.BackfillSlots)
+
+	return slots
+}
+
+// TakeBackfill dequeues the parties filling the open slots, oldest slot first. Each seat goes to the party with the
+// MMR closest to the slot's target, the longest-waiting one on ties. Slots expired at the given instant are closed
+// without being filled, and slots are closed once every seat is taken.
+func (e *Pool) TakeBackfill(now time.Time) []BackfillFill {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	var fills []BackfillFill
+
+	open := make([]BackfillSlot, 0, len(e.BackfillSlots))
+	for _, slot := range e.BackfillSlots {
+		if !slot.ExpiresAt.IsZero() && !now.Before(slot.ExpiresAt) {
+			continue
+		}
+
+		for slot.Seats > 0 {
+			i := e.closestBackfill(slot)
+			if i < 0 {
+				break
+			}
+
+			entry := e.Entries[i]
+			e.Entries = slices.Delete(e.Entries, i, i+1)
+			slot.Seats -= entry.PlayerCount()
+
+			fills = append(fills, BackfillFill{Slot: slot, Entry: entry})
+		}
+
+		if slot.Seats > 0 {
+			open = append(open, slot)
+		}
+	}
+
+	if len(fills) > 0 || len(open) != len(e.BackfillSlots) {
+		e.UpdatedAt = now
+	}
+
+	e.BackfillSlots = open
+
+	return fills
+}
+
+// ReturnBackfill undoes fills whose pair could not take the parties: the parties go back to their original position
+// and their seats open again in the slot they were taken from, until the slot's original deadline.
+func (e *Pool) ReturnBackfill(fills ...BackfillFill) {
+	entries := make([]PoolEntry, 0, len(fills))
+	slots := make([]BackfillSlot, 0, len(fills))
+	for _, fill := range fills {
+		slot := fill.Slot
+		slot.Seats = fill.Entry.PlayerCount()
+
+		entries = append(entries, fill.Entry)
+		slots = append(slots, slot)
+	}
+
+	e.Requeue(entries...)
+	e.RequestBackfill(slots...)
+}
+
+// closestBackfill returns the index of the entry to seat in the slot, or -1 when no entry is accepted.
+// The caller must hold the pool mutex.
+func (e *Pool) closestBackfill(slot BackfillSlot) int {
+	best, bestDistance := -1, math.MaxInt
+	for i, entry := range e.Entries {
+		if !slot.Accepts(entry) {
+			continue
+		}
+
+		// entries are in arrival order, so the longest-waiting entry wins ties
+		if distance := abs(entry.MMR - slot.TargetMMR); distance < bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+
+	return best
+}
+
+// RemovePlayers takes the players off their teams, along with the solo parties they were. Returns how many seats
+// each team lost.
+func (p *Pair) RemovePlayers(playerIDs ...uuid.UUID) map[uuid.UUID]int {
+	seats := make(map[uuid.UUID]int)
+
+	for i := range p.Teams {
+		team := &p.Teams[i]
+		for _, playerID := range playerIDs {
+			if !slices.Contains(team.PlayerIDs, playerID) {
+				continue
+			}
+
+			team.PlayerIDs = slices.DeleteFunc(team.PlayerIDs, func(id uuid.UUID) bool { return id == playerID })
+			team.PartyIDs = slices.DeleteFunc(team.PartyIDs, func(id uuid.UUID) bool { return id == playerID })
+			seats[team.ID]++
+		}
+	}
+
+	for _, playerID := range playerIDs {
+		delete(p.Match, playerID)
+	}
+
+	return seats
+}
+
+// Backfill seats the entry's party in the team, blending its MMR into the team's average
+func (p *Pair) Backfill(teamID uuid.UUID, entry PoolEntry, party *entities.Party) error {
+	i := slices.IndexFunc(p.Teams, func(team Team) bool { return team.ID == teamID })
+	if i < 0 {
+		return ErrTeamNotFound
+	}
+
+	team := &p.Teams[i]
+
+	players, joining := len(team.PlayerIDs), entry.PlayerCount()
+	team.AverageMMR = (team.AverageMMR*players + entry.MMR*joining) / (players + joining)
+	team.PartyIDs = append(team.PartyIDs, entry.PartyID)
+	team.PlayerIDs = append(team.PlayerIDs, entry.Players()...)
+
+	if p.Match == nil {
+		p.Match = make(map[uuid.UUID]*entities.Party)
+	}
+
+	p.Match[entry.PartyID] = party
+
+	return nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
@@ -0,0 +1,165 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+)
+
+func TestPool_TakeBackfill(t *testing.T) {
+	now := time.Now()
+
+	newSlot := func(seats, targetMMR int) pairing_entities.BackfillSlot {
+		return pairing_entities.BackfillSlot{
+			ID:        uuid.New(),
+			PairID:    uuid.New(),
+			TeamID:    uuid.New(),
+			Seats:     seats,
+			TargetMMR: targetMMR,
+			ExpiresAt: now.Add(time.Minute),
+		}
+	}
+
+	backfiller := func(mmr int, size int) pairing_entities.PoolEntry {
+		return pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: mmr, Size: size, Backfill: true}
+	}
+
+	t.Run("Seats The Closest MMR Among Parties Opted Into Backfill", func(t *testing.T) {
+		pool := newPool()
+
+		regular := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: 1500}
+		far := backfiller(1800, 1)
+		near := backfiller(1450, 1)
+		pool.Join(regular)
+		pool.Join(far)
+		pool.Join(near)
+
+		pool.RequestBackfill(newSlot(1, 1500))
+
+		fills := pool.TakeBackfill(now)
+
+		require.Len(t, fills, 1)
+		assert.Equal(t, near.PartyID, fills[0].Entry.PartyID)
+		assert.Equal(t, []uuid.UUID{regular.PartyID, far.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+		assert.Empty(t, pool.OpenSlots(), "a full slot must be closed")
+	})
+
+	t.Run("Keeps The Longest Waiting Party On Ties", func(t *testing.T) {
+		pool := newPool()
+
+		first := backfiller(1400, 1)
+		second := backfiller(1600, 1)
+		pool.Join(first)
+		pool.Join(second)
+
+		pool.RequestBackfill(newSlot(1, 1500))
+
+		fills := pool.TakeBackfill(now)
+
+		require.Len(t, fills, 1)
+		assert.Equal(t, first.PartyID, fills[0].Entry.PartyID)
+	})
+
+	t.Run("Never Splits A Party Nor Overfills The Slot", func(t *testing.T) {
+		pool := newPool()
+
+		duo := backfiller(1500, 2)
+		solo := backfiller(1700, 1)
+		pool.Join(duo)
+		pool.Join(solo)
+
+		pool.RequestBackfill(newSlot(1, 1500))
+
+		fills := pool.TakeBackfill(now)
+
+		require.Len(t, fills, 1)
+		assert.Equal(t, solo.PartyID, fills[0].Entry.PartyID)
+		assert.Equal(t, []uuid.UUID{duo.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+	})
+
+	t.Run("Respects The Skill Window Of The Parties", func(t *testing.T) {
+		pool := newPool()
+
+		picky := backfiller(1000, 1)
+		picky.Criteria = pairing_value_objects.Criteria{SkillRange: &pairing_value_objects.SkillRange{MinMMR: 800, MaxMMR: 1200}}
+		pool.Join(picky)
+
+		pool.RequestBackfill(newSlot(1, 1500))
+
+		assert.Empty(t, pool.TakeBackfill(now))
+		assert.Len(t, pool.OpenSlots(), 1, "the slot must stay open until someone fits")
+	})
+
+	t.Run("Closes Expired Slots Without Filling Them", func(t *testing.T) {
+		pool := newPool()
+		pool.Join(backfiller(1500, 1))
+
+		pool.RequestBackfill(newSlot(1, 1500))
+
+		assert.Empty(t, pool.TakeBackfill(now.Add(2*time.Minute)))
+		assert.Empty(t, pool.OpenSlots())
+		assert.Equal(t, 1, pool.Len())
+	})
+
+	t.Run("Adds Seats To The Slot Already Open For The Team", func(t *testing.T) {
+		pool := newPool()
+
+		slot := newSlot(1, 1500)
+		pool.RequestBackfill(slot)
+
+		another := slot
+		another.ID = uuid.New()
+		pool.RequestBackfill(another)
+
+		slots := pool.OpenSlots()
+		require.Len(t, slots, 1)
+		assert.Equal(t, 2, slots[0].Seats)
+		assert.Len(t, pool.Snapshot().BackfillSlots, 1)
+	})
+}
+
+func TestPair_Backfill(t *testing.T) {
+	stayed, left := uuid.New(), uuid.New()
+
+	pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+	pair.Teams = []pairing_entities.Team{{
+		ID:         uuid.New(),
+		PartyIDs:   []uuid.UUID{stayed, left},
+		PlayerIDs:  []uuid.UUID{stayed, left},
+		AverageMMR: 1500,
+	}}
+	pair.Match[stayed] = nil
+	pair.Match[left] = nil
+
+	t.Run("Removes The Players Leaving And Counts The Seats They Left", func(t *testing.T) {
+		seats := pair.RemovePlayers(left, uuid.New())
+
+		assert.Equal(t, map[uuid.UUID]int{pair.Teams[0].ID: 1}, seats)
+		assert.Equal(t, []uuid.UUID{stayed}, pair.Teams[0].PlayerIDs)
+		assert.Equal(t, []uuid.UUID{stayed}, pair.Teams[0].PartyIDs)
+		assert.NotContains(t, pair.Match, left)
+	})
+
+	t.Run("Seats The Replacement And Blends Its MMR", func(t *testing.T) {
+		replacement := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: 1700, Size: 1}
+
+		require.NoError(t, pair.Backfill(pair.Teams[0].ID, replacement, nil))
+
+		assert.Equal(t, []uuid.UUID{stayed, replacement.PartyID}, pair.Teams[0].PlayerIDs)
+		assert.Equal(t, 1600, pair.Teams[0].AverageMMR)
+		assert.Contains(t, pair.Match, replacement.PartyID)
+	})
+
+	t.Run("Fails For Unknown Teams", func(t *testing.T) {
+		err := pair.Backfill(uuid.New(), pairing_entities.PoolEntry{PartyID: uuid.New()}, nil)
+
+		assert.ErrorIs(t, err, pairing_entities.ErrTeamNotFound)
+	})
+}
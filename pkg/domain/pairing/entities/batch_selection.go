@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"slices"
+	"sort"
+)
+
+const (
+	// batchSearchBudget caps the sets of groups a batch selector looks at, so large pools are matched in bounded time
+	batchSearchBudget = 512
+
+	// batchBranching is how many of the best groups left are tried at each step of the search
+	batchBranching = 3
+)
+
+// BatchSelector picks disjoint groups of queued entries to be matched together, each bringing exactly qty players.
+// It returns the queue indexes of every group, or nil when no acceptable group can be formed.
+type BatchSelector func(entries []PoolEntry, qty int) [][]int
+
+// SelectBatchWith picks the set of disjoint groups the strategy proposes with the highest total quality. Unlike a
+// GroupSelector run over and over, the first group picked never takes the parties another group needs to be formed.
+func SelectBatchWith(strategy MatchStrategy) BatchSelector {
+	return func(entries []PoolEntry, qty int) [][]int {
+		if qty <= 0 || len(entries) == 0 {
+			return nil
+		}
+
+		available := make([]int, len(entries))
+		for i := range available {
+			available[i] = i
+		}
+
+		search := batchSearch{entries: entries, qty: qty, strategy: strategy}
+		search.explore(available, nil, 0)
+
+		return search.best
+	}
+}
+
+// batchSearch looks for the set of disjoint groups with the highest total quality. Groups are proposed again out of
+// the entries left each time one is picked, trying the best few first, until the budget runs out.
+type batchSearch struct {
+	entries  []PoolEntry
+	qty      int
+	strategy MatchStrategy
+	explored int
+	best     [][]int
+	bestSum  float64
+}
+
+// explore extends the groups picked so far with the groups the available entries can form
+func (s *batchSearch) explore(available []int, picked [][]int, sum float64) {
+	s.explored++
+	if sum > s.bestSum || (sum == s.bestSum && len(picked) > len(s.best)) {
+		s.best, s.bestSum = slices.Clone(picked), sum
+	}
+
+	players := 0
+	for _, i := range available {
+		players += s.entries[i].PlayerCount()
+	}
+
+	// no group rates more than 1, so the groups left cannot beat the best set when there are not enough of them
+	if s.explored >= batchSearchBudget || sum+float64(players/s.qty) < s.bestSum {
+		return
+	}
+
+	candidates := s.candidates(available)
+	for _, candidate := range candidates[:min(len(candidates), batchBranching)] {
+		if s.explored >= batchSearchBudget {
+			return
+		}
+
+		left := slices.DeleteFunc(slices.Clone(available), func(i int) bool {
+			return slices.Contains(candidate.Indexes, i)
+		})
+
+		s.explore(left, append(picked, candidate.Indexes), sum+candidate.Quality)
+	}
+}
+
+// candidates returns the groups the strategy proposes out of the available entries, best first. Ties keep the
+// order the strategy proposed them in.
+func (s *batchSearch) candidates(available []int) []MatchCandidate {
+	entries := make([]PoolEntry, len(available))
+	for i, j := range available {
+		entries[i] = s.entries[j]
+	}
+
+	candidates := s.strategy.Candidates(entries, s.qty)
+	for _, candidate := range candidates {
+		for i, j := range candidate.Indexes {
+			candidate.Indexes[i] = available[j]
+		}
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].Quality > candidates[b].Quality
+	})
+
+	return candidates
+}
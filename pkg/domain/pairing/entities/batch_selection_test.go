@@ -0,0 +1,127 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	schedule_entities "github.com/leet-gaming/match-making-api/pkg/domain/schedules/entities"
+)
+
+// withSkill returns a solo party accepting opponents up to delta MMR away
+func withSkill(mmr, delta int) pairing_entities.PoolEntry {
+	entry := teamEntry(1, mmr)
+	entry.Criteria.SkillRange = &pairing_value_objects.SkillRange{MinMMR: mmr - delta, MaxMMR: mmr + delta}
+
+	return entry
+}
+
+func TestSelectBatch(t *testing.T) {
+	selector := pairing_entities.SelectBatchWith(pairing_entities.FIFOStrategy{Model: pairing_entities.DefaultQualityModel})
+
+	t.Run("Forms Every Match The Pool Can Make", func(t *testing.T) {
+		// matching the two oldest parties first, as FIFO does, would leave the other two without a shared region
+		entries := []pairing_entities.PoolEntry{
+			pinging(0, map[string]int{"eu": 30}),
+			pinging(0, map[string]int{"eu": 30, "us": 30}),
+			pinging(0, map[string]int{"us": 30}),
+			pinging(0, map[string]int{"eu": 30}),
+		}
+
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectFIFO(entries, 2))
+		assert.ElementsMatch(t, [][]int{{0, 3}, {1, 2}}, selector(entries, 2))
+	})
+
+	t.Run("Prefers The Best Matches", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1400), teamEntry(1, 1010)}
+
+		assert.Equal(t, [][]int{{0, 2}}, selector(entries, 2))
+	})
+
+	t.Run("Follows The Skill Windows When Selecting By Skill", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{withSkill(1000, 100), withSkill(1400, 100), withSkill(1450, 100)}
+
+		assert.Equal(t, [][]int{{1, 2}}, pairing_entities.SelectBatchWith(pairing_entities.SkillStrategy{Model: pairing_entities.DefaultQualityModel})(entries, 2))
+	})
+
+	t.Run("Forms No Match When The Pool Cannot", func(t *testing.T) {
+		assert.Nil(t, selector([]pairing_entities.PoolEntry{teamEntry(1, 1000)}, 2))
+	})
+}
+
+func TestPool_TakeBatch(t *testing.T) {
+	pool := newPool()
+	for range 5 {
+		pool.Join(teamEntry(1, 1000))
+	}
+
+	selector := pairing_entities.SelectBatchWith(pairing_entities.FIFOStrategy{Model: pairing_entities.DefaultQualityModel})
+	now := time.Now()
+
+	groups := pool.TakeBatch(2, now, time.Minute, selector)
+
+	require.Len(t, groups, 2)
+	assert.Equal(t, 1, pool.Len())
+	assert.True(t, now.Equal(pool.BatchedAt))
+
+	pool.Join(teamEntry(1, 1000))
+
+	assert.Nil(t, pool.TakeBatch(2, now.Add(30*time.Second), time.Minute, selector), "the next batch is not due yet")
+	assert.Len(t, pool.TakeBatch(2, now.Add(time.Minute), time.Minute, selector), 1)
+	assert.Zero(t, pool.Len())
+}
+
+func TestPool_TakeBatch_PartiesLeaving(t *testing.T) {
+	pool := newPool()
+	entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1000), teamEntry(1, 1000), teamEntry(1, 1000)}
+	for _, entry := range entries {
+		pool.Join(entry)
+	}
+
+	// the first party leaves while the batch is being formed, which needs the pool not to be locked
+	fifo := pairing_entities.SelectBatchWith(pairing_entities.FIFOStrategy{Model: pairing_entities.DefaultQualityModel})
+	selector := func(waiting []pairing_entities.PoolEntry, qty int) [][]int {
+		_, err := pool.Remove(entries[0].PartyID)
+		require.NoError(t, err)
+
+		return fifo(waiting, qty)
+	}
+
+	groups := pool.TakeBatch(2, time.Now(), time.Minute, selector)
+
+	require.Len(t, groups, 1, "the group of the party leaving is left out")
+	assert.NotContains(t, []uuid.UUID{groups[0][0].PartyID, groups[0][1].PartyID}, entries[0].PartyID)
+	assert.Equal(t, 1, pool.Len(), "the party grouped with the one leaving stays queued")
+}
+
+func TestQualityModel_Rate(t *testing.T) {
+	now := time.Now()
+
+	low, high := pinging(0, map[string]int{"eu": 20}), pinging(0, map[string]int{"eu": 100})
+	low.MMR, high.MMR = 1000, 1250
+	low.JoinedAt, high.JoinedAt = now.Add(-time.Minute), now.Add(-time.Minute)
+
+	t.Run("Rates Every Factor", func(t *testing.T) {
+		quality := pairing_entities.DefaultQualityModel.Rate([]pairing_entities.PoolEntry{low, high}, now, strictMaxPing)
+
+		assert.InDelta(t, 0.5, quality.Skill, 0.001)
+		assert.InDelta(t, 0.5, quality.Wait, 0.001)
+		assert.InDelta(t, 0.5, quality.Ping, 0.001)
+		assert.InDelta(t, 1, quality.Schedule, 0.001)
+		assert.InDelta(t, 0.6, quality.Total, 0.001)
+	})
+
+	t.Run("Rates The Share Of Overlapping Schedules", func(t *testing.T) {
+		model := pairing_entities.DefaultQualityModel
+		model.Overlaps = func(a, b schedule_entities.Schedule) bool { return false }
+
+		low.Criteria.Schedule, high.Criteria.Schedule = &schedule_entities.Schedule{}, &schedule_entities.Schedule{}
+
+		assert.Zero(t, model.Rate([]pairing_entities.PoolEntry{low, high}, now, strictMaxPing).Schedule)
+	})
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"maps"
+	"slices"
+)
+
+// RegionLatency is a region a group of parties can play in, along with the ping of its worst connected player
+type RegionLatency struct {
+	Region    string // slug
+	WorstPing int    // ms
+}
+
+// EligibleRegions returns the ping of the party to every region it accepts under maxPing (0 accepts any ping).
+// Parties that did not report their pings return nil: they accept whatever region the others play in.
+func (e PoolEntry) EligibleRegions(maxPing int) map[string]int {
+	if len(e.Pings) == 0 {
+		return nil
+	}
+
+	eligible := make(map[string]int, len(e.Pings))
+	for region, ping := range e.Pings {
+		if maxPing <= 0 || ping <= maxPing {
+			eligible[region] = ping
+		}
+	}
+
+	return eligible
+}
+
+// intersectRegions keeps the regions both sides accept, with the worst of their pings. A nil side accepts any
+// region. Returns false when the sides have no region in common.
+func intersectRegions(group, eligible map[string]int) (map[string]int, bool) {
+	if eligible == nil {
+		return group, true
+	}
+
+	if group == nil {
+		return eligible, len(eligible) > 0
+	}
+
+	shared := make(map[string]int, min(len(group), len(eligible)))
+	for region, worst := range group {
+		if ping, ok := eligible[region]; ok {
+			shared[region] = max(worst, ping)
+		}
+	}
+
+	return shared, len(shared) > 0
+}
+
+// BestRegion returns the region minimizing the worst ping among the regions every entry accepts under the MaxPing
+// given by maxPing. Ties go to the first region by slug, so the choice is stable. Returns false when no entry
+// reported its pings, or when the entries have no region in common.
+func BestRegion(entries []PoolEntry, maxPing func(PoolEntry) int) (RegionLatency, bool) {
+	var regions map[string]int
+	for _, entry := range entries {
+		var ok bool
+		if regions, ok = intersectRegions(regions, entry.EligibleRegions(maxPing(entry))); !ok {
+			return RegionLatency{}, false
+		}
+	}
+
+	if len(regions) == 0 {
+		return RegionLatency{}, false
+	}
+
+	best := RegionLatency{WorstPing: -1}
+	for _, region := range slices.Sorted(maps.Keys(regions)) {
+		if best.WorstPing < 0 || regions[region] < best.WorstPing {
+			best = RegionLatency{Region: region, WorstPing: regions[region]}
+		}
+	}
+
+	return best, true
+}
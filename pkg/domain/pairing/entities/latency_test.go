@@ -0,0 +1,111 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+)
+
+func pinging(maxPing int, pings map[string]int) pairing_entities.PoolEntry {
+	return pairing_entities.PoolEntry{
+		PartyID:  uuid.New(),
+		JoinedAt: time.Now(),
+		Size:     1,
+		Pings:    pings,
+		Criteria: pairing_value_objects.Criteria{MaxPing: maxPing},
+	}
+}
+
+func strictMaxPing(e pairing_entities.PoolEntry) int {
+	return e.Criteria.MaxPing
+}
+
+func TestBestRegion(t *testing.T) {
+	t.Run("Minimizes The Worst Ping", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			pinging(100, map[string]int{"eu-west": 20, "us-east": 90}),
+			pinging(100, map[string]int{"eu-west": 80, "us-east": 30}),
+			pinging(100, map[string]int{"eu-west": 40, "us-east": 60}),
+		}
+
+		region, ok := pairing_entities.BestRegion(entries, strictMaxPing)
+
+		assert.True(t, ok)
+		assert.Equal(t, pairing_entities.RegionLatency{Region: "eu-west", WorstPing: 80}, region)
+	})
+
+	t.Run("Skips Regions Above Anyone's MaxPing", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			pinging(50, map[string]int{"eu-west": 20, "us-east": 45}),
+			pinging(100, map[string]int{"eu-west": 30, "us-east": 10}),
+			pinging(25, map[string]int{"eu-west": 90, "us-east": 20}),
+		}
+
+		region, ok := pairing_entities.BestRegion(entries, strictMaxPing)
+
+		assert.True(t, ok)
+		assert.Equal(t, "us-east", region.Region)
+		assert.Equal(t, 45, region.WorstPing)
+	})
+
+	t.Run("Lets Parties Without Pings Play Anywhere", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			pinging(0, nil),
+			pinging(0, map[string]int{"sa-east": 15}),
+		}
+
+		region, ok := pairing_entities.BestRegion(entries, strictMaxPing)
+
+		assert.True(t, ok)
+		assert.Equal(t, "sa-east", region.Region)
+	})
+
+	t.Run("Finds No Region When Nobody Reported Pings Or They Share None", func(t *testing.T) {
+		_, ok := pairing_entities.BestRegion([]pairing_entities.PoolEntry{pinging(0, nil)}, strictMaxPing)
+		assert.False(t, ok)
+
+		_, ok = pairing_entities.BestRegion([]pairing_entities.PoolEntry{
+			pinging(0, map[string]int{"eu-west": 20}),
+			pinging(0, map[string]int{"us-east": 20}),
+		}, strictMaxPing)
+		assert.False(t, ok)
+	})
+}
+
+func TestSelectors_GroupPartiesSharingARegion(t *testing.T) {
+	europe := pinging(60, map[string]int{"eu-west": 20, "us-east": 110})
+	america := pinging(60, map[string]int{"eu-west": 120, "us-east": 25})
+	atlantic := pinging(60, map[string]int{"eu-west": 50, "us-east": 55})
+
+	entries := []pairing_entities.PoolEntry{europe, america, atlantic}
+
+	t.Run("FIFO", func(t *testing.T) {
+		assert.Equal(t, []int{0, 2}, pairing_entities.SelectFIFO(entries, 2))
+	})
+
+	t.Run("By Skill", func(t *testing.T) {
+		assert.Equal(t, []int{0, 2}, pairing_entities.SelectBySkill(entries, 2))
+	})
+
+	t.Run("Waits When No Group Shares A Region", func(t *testing.T) {
+		assert.Nil(t, pairing_entities.SelectFIFO([]pairing_entities.PoolEntry{europe, america}, 2))
+	})
+
+	t.Run("Relaxes MaxPing With Wait Time", func(t *testing.T) {
+		waiting := europe
+		waiting.JoinedAt = time.Now().Add(-time.Minute)
+
+		relaxed := america
+		relaxed.JoinedAt = time.Now().Add(-time.Minute)
+
+		steps := []game_entities.WindowExpansionStep{{AfterSeconds: 30, MaxPing: 150}}
+
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: pairing_entities.SelectionRules{Steps: steps}})([]pairing_entities.PoolEntry{waiting, relaxed}, 2))
+	})
+}
@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// MapRule makes selectors hold back parties whose preferred maps do not overlap, so they are matched less eagerly
+// than parties agreeing on a map. The zero MapRule groups parties regardless of their preferences.
+type MapRule struct {
+	Pool     []string      // maps that can be played; empty allows any map
+	Patience time.Duration // how long a party waits for others sharing its preferred maps before playing any map
+}
+
+// PreferredMaps returns the maps of the pool the party prefers, in its order of preference. Returns nil when the party
+// has no preferences, or only prefers maps out of the pool: it plays any map.
+func (e PoolEntry) PreferredMaps(pool []string) []string {
+	var preferred []string
+	for _, m := range e.Criteria.MapPreferences {
+		if (len(pool) == 0 || slices.Contains(pool, m)) && !slices.Contains(preferred, m) {
+			preferred = append(preferred, m)
+		}
+	}
+
+	return preferred
+}
+
+// mapAgreement tracks the maps preferred by the parties of a group, to hold back parties sharing none of them
+type mapAgreement struct {
+	rule      MapRule
+	now       time.Time
+	preferred map[string]bool // maps preferred by any party of the group; empty while nobody has preferences
+	holding   bool            // some party of the group still holds out for its preferred maps
+}
+
+// accepts tells whether the party can join the group: it plays any map, it shares a preferred map with the group, or
+// both the party and the group have waited long enough to play a map they do not prefer
+func (a *mapAgreement) accepts(entry PoolEntry) bool {
+	if a.rule.Patience <= 0 || len(a.preferred) == 0 {
+		return true
+	}
+
+	preferred := entry.PreferredMaps(a.rule.Pool)
+	if len(preferred) == 0 || slices.ContainsFunc(preferred, func(m string) bool { return a.preferred[m] }) {
+		return true
+	}
+
+	return !a.holding && !a.holdsOut(entry)
+}
+
+// add records the preferences of a party joining the group
+func (a *mapAgreement) add(entry PoolEntry) {
+	preferred := entry.PreferredMaps(a.rule.Pool)
+	if len(preferred) == 0 {
+		return
+	}
+
+	if a.preferred == nil {
+		a.preferred = make(map[string]bool, len(preferred))
+	}
+
+	for _, m := range preferred {
+		a.preferred[m] = true
+	}
+
+	a.holding = a.holding || a.holdsOut(entry)
+}
+
+// holdsOut tells whether the party has not waited its patience out yet, so it only plays the maps it prefers
+func (a *mapAgreement) holdsOut(entry PoolEntry) bool {
+	return entry.WaitTime(a.now) < a.rule.Patience
+}
+
+// MapVotes counts the votes of the entries for the maps of the pool: every party votes for each map it prefers,
+// weighted by the number of players it brings
+func MapVotes(entries []PoolEntry, pool []string) map[string]int {
+	votes := make(map[string]int)
+	for _, entry := range entries {
+		for _, m := range entry.PreferredMaps(pool) {
+			votes[m] += entry.PlayerCount()
+		}
+	}
+
+	return votes
+}
+
+// ChooseMap picks the map of a match by weighted vote: each map voted for (see MapVotes) is drawn with a chance
+// proportional to its votes. When nobody voted for a map of the pool, a map is drawn at random from the pool.
+// Returns false when there are neither votes nor a pool to draw from.
+func ChooseMap(entries []PoolEntry, pool []string) (string, bool) {
+	votes := MapVotes(entries, pool)
+	if len(votes) == 0 {
+		if len(pool) == 0 {
+			return "", false
+		}
+
+		return pool[rand.IntN(len(pool))], true
+	}
+
+	total := 0
+	for _, count := range votes {
+		total += count
+	}
+
+	// maps are walked by name so the same draw always picks the same map
+	draw := rand.IntN(total)
+	for _, m := range slices.Sorted(maps.Keys(votes)) {
+		if draw < votes[m] {
+			return m, true
+		}
+
+		draw -= votes[m]
+	}
+
+	return "", false
+}
@@ -0,0 +1,111 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+)
+
+func preferring(waited time.Duration, maps ...string) pairing_entities.PoolEntry {
+	return pairing_entities.PoolEntry{
+		PartyID:  uuid.New(),
+		JoinedAt: time.Now().Add(-waited),
+		Size:     1,
+		Criteria: pairing_value_objects.Criteria{MapPreferences: maps},
+	}
+}
+
+func TestPoolEntry_PreferredMaps(t *testing.T) {
+	t.Run("Keeps The Preferences In The Map Pool", func(t *testing.T) {
+		entry := preferring(0, "nuke", "dust2", "nuke", "inferno")
+
+		assert.Equal(t, []string{"nuke", "inferno"}, entry.PreferredMaps([]string{"inferno", "nuke"}))
+	})
+
+	t.Run("Keeps Every Preference Without A Map Pool", func(t *testing.T) {
+		assert.Equal(t, []string{"dust2"}, preferring(0, "dust2").PreferredMaps(nil))
+	})
+
+	t.Run("Plays Any Map When No Preference Is In The Pool", func(t *testing.T) {
+		assert.Nil(t, preferring(0, "dust2").PreferredMaps([]string{"inferno"}))
+	})
+}
+
+func TestChooseMap(t *testing.T) {
+	pool := []string{"dust2", "inferno", "nuke"}
+
+	t.Run("Weighs Votes By Players", func(t *testing.T) {
+		duo := preferring(0, "inferno", "nuke")
+		duo.Size = 2
+
+		votes := pairing_entities.MapVotes([]pairing_entities.PoolEntry{duo, preferring(0, "nuke", "vertigo")}, pool)
+
+		assert.Equal(t, map[string]int{"inferno": 2, "nuke": 3}, votes)
+	})
+
+	t.Run("Only Draws Maps Voted For", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{preferring(0, "inferno", "nuke"), preferring(0, "nuke"), preferring(0)}
+
+		for range 20 {
+			m, ok := pairing_entities.ChooseMap(entries, pool)
+
+			assert.True(t, ok)
+			assert.Contains(t, []string{"inferno", "nuke"}, m)
+		}
+	})
+
+	t.Run("Falls Back To A Random Map Of The Pool", func(t *testing.T) {
+		m, ok := pairing_entities.ChooseMap([]pairing_entities.PoolEntry{preferring(0, "vertigo"), preferring(0)}, pool)
+
+		assert.True(t, ok)
+		assert.Contains(t, pool, m)
+	})
+
+	t.Run("Chooses Nothing Without Votes Nor Pool", func(t *testing.T) {
+		_, ok := pairing_entities.ChooseMap([]pairing_entities.PoolEntry{preferring(0)}, nil)
+
+		assert.False(t, ok)
+	})
+}
+
+func TestSelectors_HoldBackPartiesDisagreeingOnMaps(t *testing.T) {
+	rules := pairing_entities.SelectionRules{Maps: pairing_entities.MapRule{Patience: time.Minute}}
+
+	t.Run("Groups Parties Sharing A Preferred Map First", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			preferring(10*time.Second, "dust2"),
+			preferring(5*time.Second, "inferno"),
+			preferring(0, "nuke", "dust2"),
+		}
+
+		assert.Equal(t, []int{0, 2}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+		assert.Equal(t, []int{0, 2}, pairing_entities.SelectWith(pairing_entities.SkillStrategy{Rules: rules})(entries, 2))
+	})
+
+	t.Run("Groups Parties Without Preferences With Anyone", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{preferring(0, "dust2"), preferring(0)}
+
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+	})
+
+	t.Run("Waits Until Everyone Waited Their Patience Out", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{preferring(2*time.Minute, "dust2"), preferring(10*time.Second, "inferno")}
+
+		assert.Nil(t, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+
+		entries[1] = preferring(2*time.Minute, "inferno")
+
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+	})
+
+	t.Run("Ignores Preferences Without Patience", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{preferring(0, "dust2"), preferring(0, "inferno")}
+
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectFIFO(entries, 2))
+	})
+}
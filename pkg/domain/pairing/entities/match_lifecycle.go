@@ -0,0 +1,117 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type MatchStatus string
+
+const (
+	MatchCreated   MatchStatus = "created"
+	MatchStarted   MatchStatus = "started"
+	MatchCompleted MatchStatus = "completed"
+	MatchCancelled MatchStatus = "cancelled"
+)
+
+// CancelReasonTimedOut is the cancel reason of the matches still running past the MaxDuration of their game
+const CancelReasonTimedOut = "timed_out"
+
+// CancelReasonReadyCheckFailed is the cancel reason of the matches whose ready check was declined or expired
+const CancelReasonReadyCheckFailed = "ready_check_failed"
+
+var (
+	ErrPairNotFound      = errors.New("pair not found")
+	ErrInvalidTransition = errors.New("invalid match status transition")
+	ErrMatchNotConfirmed = errors.New("match is waiting on its ready check")
+	ErrPairChanged       = errors.New("pair was saved by someone else since it was read")
+)
+
+// matchTransitions lists the statuses a match can move to from each status. Completed and cancelled matches are final.
+var matchTransitions = map[MatchStatus][]MatchStatus{
+	MatchCreated: {MatchStarted, MatchCancelled},
+	MatchStarted: {MatchCompleted, MatchCancelled},
+}
+
+// Lifecycle returns the status of the match. Pairs stored before matches had a lifecycle count as created.
+func (p *Pair) Lifecycle() MatchStatus {
+	if p.Status == "" {
+		return MatchCreated
+	}
+
+	return p.Status
+}
+
+// IsFinished reports whether the match was completed or cancelled
+func (p *Pair) IsFinished() bool {
+	status := p.Lifecycle()
+
+	return status == MatchCompleted || status == MatchCancelled
+}
+
+// HasTimedOut reports whether the match is still running past the given maximum duration. Matches without a maximum
+// duration never time out.
+func (p *Pair) HasTimedOut(maxDuration time.Duration, now time.Time) bool {
+	if maxDuration <= 0 || p.Lifecycle() != MatchStarted || p.StartedAt == nil {
+		return false
+	}
+
+	return now.After(p.StartedAt.Add(maxDuration))
+}
+
+// Start moves the match to started. A match waiting on its ready check cannot start. Returns false, without error,
+// when the match was already started.
+func (p *Pair) Start(now time.Time) (bool, error) {
+	if p.Lifecycle() == MatchCreated && !p.IsConfirmed() {
+		return false, fmt.Errorf("Pair.Start: pair %v: %w", p.ID, ErrMatchNotConfirmed)
+	}
+
+	changed, err := p.transition(MatchStarted, now)
+	if changed {
+		p.StartedAt = &now
+	}
+
+	return changed, err
+}
+
+// Complete moves the started match to completed. Returns false, without error, when the match was already completed.
+func (p *Pair) Complete(now time.Time) (bool, error) {
+	changed, err := p.transition(MatchCompleted, now)
+	if changed {
+		p.CompletedAt = &now
+	}
+
+	return changed, err
+}
+
+// Cancel moves the match to cancelled for the given reason, whether it started or not. Returns false, without
+// error, when the match was already cancelled.
+func (p *Pair) Cancel(reason string, now time.Time) (bool, error) {
+	changed, err := p.transition(MatchCancelled, now)
+	if changed {
+		p.CancelledAt = &now
+		p.CancelReason = reason
+	}
+
+	return changed, err
+}
+
+// transition moves the match to the status when the lifecycle allows it. Moving to the current status is a no-op,
+// so events delivered twice are harmless.
+func (p *Pair) transition(to MatchStatus, now time.Time) (bool, error) {
+	from := p.Lifecycle()
+	if from == to {
+		return false, nil
+	}
+
+	if !slices.Contains(matchTransitions[from], to) {
+		return false, fmt.Errorf("Pair: pair %v cannot go from %s to %s: %w", p.ID, from, to, ErrInvalidTransition)
+	}
+
+	p.Status = to
+	p.UpdatedAt = now
+
+	return true, nil
+}
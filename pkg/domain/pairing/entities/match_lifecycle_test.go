@@ -0,0 +1,113 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+)
+
+func TestPair_Lifecycle(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Starts And Completes A Confirmed Match", func(t *testing.T) {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+
+		started, err := pair.Start(now)
+		require.NoError(t, err)
+		assert.True(t, started)
+		assert.Equal(t, pairing_entities.MatchStarted, pair.Lifecycle())
+		assert.Equal(t, now, *pair.StartedAt)
+
+		completed, err := pair.Complete(now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.True(t, completed)
+		assert.True(t, pair.IsFinished())
+		assert.Equal(t, now.Add(time.Minute), *pair.CompletedAt)
+	})
+
+	t.Run("Cancels A Match Before Or After It Started", func(t *testing.T) {
+		created := pairing_entities.NewPair(2, common.ResourceOwner{})
+		started := pairing_entities.NewPair(2, common.ResourceOwner{})
+		_, err := started.Start(now)
+		require.NoError(t, err)
+
+		for _, pair := range []*pairing_entities.Pair{created, started} {
+			cancelled, err := pair.Cancel("server crashed", now)
+			require.NoError(t, err)
+			assert.True(t, cancelled)
+			assert.Equal(t, pairing_entities.MatchCancelled, pair.Lifecycle())
+			assert.Equal(t, "server crashed", pair.CancelReason)
+			assert.Equal(t, now, *pair.CancelledAt)
+		}
+	})
+
+	t.Run("Ignores Moving To The Current Status", func(t *testing.T) {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+		_, err := pair.Cancel("first", now)
+		require.NoError(t, err)
+
+		cancelled, err := pair.Cancel("second", now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.False(t, cancelled)
+		assert.Equal(t, "first", pair.CancelReason)
+		assert.Equal(t, now, *pair.CancelledAt)
+	})
+
+	t.Run("Rejects Transitions Out Of Finished Matches", func(t *testing.T) {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+		_, err := pair.Cancel("no show", now)
+		require.NoError(t, err)
+
+		_, err = pair.Start(now)
+		assert.ErrorIs(t, err, pairing_entities.ErrInvalidTransition)
+
+		_, err = pair.Complete(now)
+		assert.ErrorIs(t, err, pairing_entities.ErrInvalidTransition)
+		assert.Equal(t, pairing_entities.MatchCancelled, pair.Lifecycle())
+	})
+
+	t.Run("Rejects Completing A Match That Did Not Start", func(t *testing.T) {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+
+		_, err := pair.Complete(now)
+		assert.ErrorIs(t, err, pairing_entities.ErrInvalidTransition)
+		assert.Nil(t, pair.CompletedAt)
+	})
+
+	t.Run("Rejects Starting A Match Waiting On Its Ready Check", func(t *testing.T) {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+		pair.ReadyCheck = pairing_entities.NewReadyCheck([]pairing_entities.PoolEntry{{}}, now, time.Minute)
+
+		_, err := pair.Start(now)
+		assert.ErrorIs(t, err, pairing_entities.ErrMatchNotConfirmed)
+		assert.Equal(t, pairing_entities.MatchCreated, pair.Lifecycle())
+	})
+
+	t.Run("Times Out Matches Running Past Their Maximum Duration", func(t *testing.T) {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+		assert.False(t, pair.HasTimedOut(time.Minute, now.Add(time.Hour)))
+
+		_, err := pair.Start(now)
+		require.NoError(t, err)
+
+		assert.False(t, pair.HasTimedOut(time.Hour, now.Add(time.Hour)))
+		assert.True(t, pair.HasTimedOut(time.Hour, now.Add(time.Hour+time.Second)))
+		assert.False(t, pair.HasTimedOut(0, now.Add(time.Hour)))
+
+		_, err = pair.Complete(now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.False(t, pair.HasTimedOut(time.Hour, now.Add(2*time.Hour)))
+	})
+
+	t.Run("Treats Pairs Without Status As Created", func(t *testing.T) {
+		pair := &pairing_entities.Pair{}
+
+		assert.Equal(t, pairing_entities.MatchCreated, pair.Lifecycle())
+		assert.False(t, pair.IsFinished())
+	})
+}
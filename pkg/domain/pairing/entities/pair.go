@@ -1,8 +1,11 @@
 package entities
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
 	"github.com/leet-gaming/match-making-api/pkg/domain/parties/entities"
 )
 
@@ -16,9 +19,22 @@ const (
 
 type Pair struct {
 	common.BaseEntity
-	Match          map[uuid.UUID]*entities.Party `json:"match" bson:"match"`
-	ConflictStatus ConflictStatus                `json:"conflict_status" bson:"conflict_status"`
-	ConflictReason string                        `json:"conflict_reason,omitempty" bson:"conflict_reason,omitempty"`
+	Match          map[uuid.UUID]*entities.Party   `json:"match" bson:"match"`
+	Teams          []Team                          `json:"teams,omitempty" bson:"teams,omitempty"`
+	ReadyCheck     *ReadyCheck                     `json:"ready_check,omitempty" bson:"ready_check,omitempty"`
+	Criteria       *pairing_value_objects.Criteria `json:"criteria,omitempty" bson:"criteria,omitempty"`     // of the pool the parties were matched in
+	Region         string                          `json:"region,omitempty" bson:"region,omitempty"`         // slug of the region chosen by latency, when the parties reported their pings
+	WorstPing      int                             `json:"worst_ping,omitempty" bson:"worst_ping,omitempty"` // ms, of the worst connected player in Region
+	Map            string                          `json:"map,omitempty" bson:"map,omitempty"`               // chosen by the parties' vote, or drawn from the map pool
+	Quality        *QualityReport                  `json:"quality,omitempty" bson:"quality,omitempty"`       // how good the match was when it was made
+	ConflictStatus ConflictStatus                  `json:"conflict_status" bson:"conflict_status"`
+	ConflictReason string                          `json:"conflict_reason,omitempty" bson:"conflict_reason,omitempty"`
+	Status         MatchStatus                     `json:"status" bson:"status"` // see Lifecycle
+	StartedAt      *time.Time                      `json:"started_at,omitempty" bson:"started_at,omitempty"`
+	CompletedAt    *time.Time                      `json:"completed_at,omitempty" bson:"completed_at,omitempty"`
+	CancelledAt    *time.Time                      `json:"cancelled_at,omitempty" bson:"cancelled_at,omitempty"`
+	CancelReason   string                          `json:"cancel_reason,omitempty" bson:"cancel_reason,omitempty"`
+	Version        int                             `json:"-" bson:"version"` // bumped on every save, see PairWriter.Update
 }
 
 func NewPair(size int, resourceOwner common.ResourceOwner) *Pair {
@@ -26,5 +42,11 @@ func NewPair(size int, resourceOwner common.ResourceOwner) *Pair {
 		BaseEntity:     common.NewEntity(resourceOwner),
 		Match:          make(map[uuid.UUID]*entities.Party, size),
 		ConflictStatus: ConflictStatusNone,
+		Status:         MatchCreated,
 	}
 }
+
+// IsConfirmed reports whether the match can go ahead: either no ready check was needed or everyone accepted it
+func (p *Pair) IsConfirmed() bool {
+	return p.ReadyCheck == nil || p.ReadyCheck.Status == ReadyCheckAccepted
+}
@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+)
+
+type OffenseType string
+
+const (
+	OffenseReadyCheckDeclined       OffenseType = "ready_check_declined"         // declined, or let expire, the ready check of a match
+	OffenseQueueLeftAfterMatchFound OffenseType = "queue_left_after_match_found" // left the queue while the match found was waiting on the ready check
+	OffenseMatchAbandoned           OffenseType = "match_abandoned"              // left a match before it ended
+)
+
+var ErrQueueCooldown = errors.New("player is serving a queue cooldown")
+
+// Severity is how many steps the offense climbs on the cooldown ladder
+func (o OffenseType) Severity() int {
+	if o == OffenseMatchAbandoned {
+		return 2
+	}
+
+	return 1
+}
+
+// PenaltyPolicy sets how cooldowns escalate with the offenses a player commits, and how long offenses count
+type PenaltyPolicy struct {
+	Cooldowns  []time.Duration // cooldown applied at each step of the ladder; past the last step the last cooldown repeats
+	DecayAfter time.Duration   // offenses older than this no longer count towards escalation
+}
+
+// DefaultPenaltyPolicy is used when no policy is configured
+var DefaultPenaltyPolicy = PenaltyPolicy{
+	Cooldowns:  []time.Duration{2 * time.Minute, 10 * time.Minute, 30 * time.Minute, 2 * time.Hour, 24 * time.Hour},
+	DecayAfter: 7 * 24 * time.Hour,
+}
+
+// CooldownFor returns the cooldown applied once a player reaches the given number of steps on the ladder
+func (p PenaltyPolicy) CooldownFor(steps int) time.Duration {
+	if steps <= 0 || len(p.Cooldowns) == 0 {
+		return 0
+	}
+
+	return p.Cooldowns[min(steps, len(p.Cooldowns))-1]
+}
+
+type Offense struct {
+	Type       OffenseType `json:"type" bson:"type"`
+	PairID     uuid.UUID   `json:"pair_id,omitempty" bson:"pair_id,omitempty"`
+	Cooldown   int64       `json:"cooldown_seconds" bson:"cooldown_seconds"`
+	OccurredAt time.Time   `json:"occurred_at" bson:"occurred_at"`
+}
+
+// PlayerPenalty holds the offenses a player committed recently and the queue cooldown they are serving.
+// There is one per player, identified by the player ID.
+type PlayerPenalty struct {
+	common.BaseEntity
+	PlayerID      uuid.UUID `json:"player_id" bson:"player_id"`
+	Offenses      []Offense `json:"offenses" bson:"offenses"`
+	CooldownUntil time.Time `json:"cooldown_until" bson:"cooldown_until"`
+}
+
+func NewPlayerPenalty(playerID uuid.UUID) *PlayerPenalty {
+	entity := common.NewEntity(common.ResourceOwner{UserID: playerID})
+	entity.ID = playerID
+
+	return &PlayerPenalty{
+		BaseEntity: entity,
+		PlayerID:   playerID,
+		Offenses:   []Offense{},
+	}
+}
+
+// Record adds the offense, escalating the cooldown by the severity of every offense that has not decayed yet.
+// A cooldown already being served is never shortened. Returns the cooldown applied for this offense. An offense
+// already recorded for the same pair is skipped, so the same match reported twice is only penalized once; no
+// cooldown is applied then.
+func (p *PlayerPenalty) Record(offense Offense, policy PenaltyPolicy) time.Duration {
+	if p.Recorded(offense.Type, offense.PairID) {
+		return 0
+	}
+
+	p.Decay(offense.OccurredAt, policy)
+
+	steps := offense.Type.Severity()
+	for _, previous := range p.Offenses {
+		steps += previous.Type.Severity()
+	}
+
+	cooldown := policy.CooldownFor(steps)
+	offense.Cooldown = int64(cooldown / time.Second)
+
+	p.Offenses = append(p.Offenses, offense)
+	if until := offense.OccurredAt.Add(cooldown); until.After(p.CooldownUntil) {
+		p.CooldownUntil = until
+	}
+
+	p.UpdatedAt = offense.OccurredAt
+
+	return cooldown
+}
+
+// Recorded tells whether an offense of the type was already recorded for the pair. Offenses without a pair are
+// never considered recorded.
+func (p *PlayerPenalty) Recorded(offenseType OffenseType, pairID uuid.UUID) bool {
+	if pairID == uuid.Nil {
+		return false
+	}
+
+	return slices.ContainsFunc(p.Offenses, func(offense Offense) bool {
+		return offense.Type == offenseType && offense.PairID == pairID
+	})
+}
+
+// Decay forgets the offenses older than the policy allows. Returns whether any was forgotten.
+func (p *PlayerPenalty) Decay(now time.Time, policy PenaltyPolicy) bool {
+	if policy.DecayAfter <= 0 {
+		return false
+	}
+
+	active := p.Offenses[:0]
+	for _, offense := range p.Offenses {
+		if now.Sub(offense.OccurredAt) < policy.DecayAfter {
+			active = append(active, offense)
+		}
+	}
+
+	decayed := len(active) != len(p.Offenses)
+	p.Offenses = active
+
+	return decayed
+}
+
+// IsCoolingDown tells whether the player is still barred from queueing
+func (p *PlayerPenalty) IsCoolingDown(now time.Time) bool {
+	return now.Before(p.CooldownUntil)
+}
+
+// RemainingCooldown returns how long until the player can queue again
+func (p *PlayerPenalty) RemainingCooldown(now time.Time) time.Duration {
+	if !p.IsCoolingDown(now) {
+		return 0
+	}
+
+	return p.CooldownUntil.Sub(now)
+}
@@ -0,0 +1,70 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+)
+
+func TestPlayerPenalty(t *testing.T) {
+	now := time.Now()
+	policy := pairing_entities.PenaltyPolicy{
+		Cooldowns:  []time.Duration{time.Minute, 10 * time.Minute, time.Hour},
+		DecayAfter: 24 * time.Hour,
+	}
+
+	offense := func(offense pairing_entities.OffenseType, at time.Time) pairing_entities.Offense {
+		return pairing_entities.Offense{Type: offense, PairID: uuid.New(), OccurredAt: at}
+	}
+
+	t.Run("Escalates The Cooldown With Every Offense", func(t *testing.T) {
+		penalty := pairing_entities.NewPlayerPenalty(uuid.New())
+
+		assert.Equal(t, time.Minute, penalty.Record(offense(pairing_entities.OffenseReadyCheckDeclined, now), policy))
+		assert.Equal(t, 10*time.Minute, penalty.Record(offense(pairing_entities.OffenseQueueLeftAfterMatchFound, now), policy))
+		assert.Equal(t, time.Hour, penalty.Record(offense(pairing_entities.OffenseReadyCheckDeclined, now), policy))
+		assert.Equal(t, time.Hour, penalty.Record(offense(pairing_entities.OffenseReadyCheckDeclined, now), policy), "the last cooldown repeats")
+
+		assert.True(t, penalty.IsCoolingDown(now.Add(59*time.Minute)))
+		assert.False(t, penalty.IsCoolingDown(now.Add(time.Hour)))
+		assert.Equal(t, 30*time.Minute, penalty.RemainingCooldown(now.Add(30*time.Minute)))
+	})
+
+	t.Run("Abandoning A Match Climbs Two Steps", func(t *testing.T) {
+		penalty := pairing_entities.NewPlayerPenalty(uuid.New())
+
+		assert.Equal(t, 10*time.Minute, penalty.Record(offense(pairing_entities.OffenseMatchAbandoned, now), policy))
+	})
+
+	t.Run("Forgets Decayed Offenses", func(t *testing.T) {
+		penalty := pairing_entities.NewPlayerPenalty(uuid.New())
+		penalty.Record(offense(pairing_entities.OffenseReadyCheckDeclined, now.Add(-48*time.Hour)), policy)
+		penalty.Record(offense(pairing_entities.OffenseReadyCheckDeclined, now.Add(-47*time.Hour)), policy)
+
+		assert.Equal(t, time.Minute, penalty.Record(offense(pairing_entities.OffenseReadyCheckDeclined, now), policy))
+		assert.Len(t, penalty.Offenses, 1)
+	})
+
+	t.Run("Records An Offense Once Per Match", func(t *testing.T) {
+		penalty := pairing_entities.NewPlayerPenalty(uuid.New())
+		abandoned := offense(pairing_entities.OffenseMatchAbandoned, now)
+
+		assert.Equal(t, 10*time.Minute, penalty.Record(abandoned, policy))
+		assert.Zero(t, penalty.Record(abandoned, policy), "the same match reported twice")
+		assert.Equal(t, time.Hour, penalty.Record(pairing_entities.Offense{Type: pairing_entities.OffenseReadyCheckDeclined, PairID: abandoned.PairID, OccurredAt: now}, policy))
+		assert.Len(t, penalty.Offenses, 2)
+	})
+
+	t.Run("Never Shortens A Cooldown Being Served", func(t *testing.T) {
+		penalty := pairing_entities.NewPlayerPenalty(uuid.New())
+		penalty.CooldownUntil = now.Add(2 * time.Hour)
+
+		penalty.Record(offense(pairing_entities.OffenseReadyCheckDeclined, now), policy)
+
+		assert.Equal(t, now.Add(2*time.Hour), penalty.CooldownUntil)
+	})
+}
@@ -0,0 +1,155 @@
+package entities
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+)
+
+// BlockScope is where a player refuses to meet a player they blocked
+type BlockScope string
+
+const (
+	BlockAsTeammate BlockScope = "teammate" // never in the same team
+	BlockAsOpponent BlockScope = "opponent" // never in opposing teams
+	BlockAlways     BlockScope = "always"   // never in the same match
+)
+
+var (
+	ErrInvalidBlockScope   = errors.New("block scope must be teammate, opponent or always")
+	ErrSelfBlock           = errors.New("players cannot block themselves")
+	ErrPlayerBlockNotFound = errors.New("player block not found")
+)
+
+// IsValid reports whether the scope is one of the known scopes
+func (s BlockScope) IsValid() bool {
+	return s == BlockAsTeammate || s == BlockAsOpponent || s == BlockAlways
+}
+
+// PlayerBlock is a player of the avoid list of another: the matcher never puts them in the same team or in opposing
+// teams, depending on its scope. There is one per blocking and blocked player, identified by both.
+type PlayerBlock struct {
+	common.BaseEntity
+	PlayerID  uuid.UUID  `json:"player_id" bson:"player_id"`   // player who blocks
+	BlockedID uuid.UUID  `json:"blocked_id" bson:"blocked_id"` // player blocked
+	Scope     BlockScope `json:"scope" bson:"scope"`
+}
+
+func NewPlayerBlock(playerID, blockedID uuid.UUID, scope BlockScope) *PlayerBlock {
+	entity := common.NewEntity(common.ResourceOwner{UserID: playerID})
+	entity.ID = PlayerBlockID(playerID, blockedID)
+
+	return &PlayerBlock{
+		BaseEntity: entity,
+		PlayerID:   playerID,
+		BlockedID:  blockedID,
+		Scope:      scope,
+	}
+}
+
+// PlayerBlockID returns the ID of the block of blockedID by playerID, so blocking a player twice replaces the block
+func PlayerBlockID(playerID, blockedID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(playerID, blockedID[:])
+}
+
+// BlockedPlayers returns the players the blocks avoid, by blocked player. A player blocked both as teammate and as
+// opponent, by the same or different players, is avoided always.
+func BlockedPlayers(blocks []*PlayerBlock) map[uuid.UUID]BlockScope {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	blocked := make(map[uuid.UUID]BlockScope, len(blocks))
+	for _, block := range blocks {
+		if scope, ok := blocked[block.BlockedID]; ok && scope != block.Scope {
+			blocked[block.BlockedID] = BlockAlways
+			continue
+		}
+
+		blocked[block.BlockedID] = block.Scope
+	}
+
+	return blocked
+}
+
+// blockConflict is how two parties avoid each other, as a set of blockTeammate and blockOpponent
+type blockConflict uint8
+
+const (
+	blockTeammate blockConflict = 1 << iota
+	blockOpponent
+)
+
+func (s BlockScope) conflict() blockConflict {
+	switch s {
+	case BlockAsTeammate:
+		return blockTeammate
+	case BlockAsOpponent:
+		return blockOpponent
+	case BlockAlways:
+		return blockTeammate | blockOpponent
+	}
+
+	return 0
+}
+
+// conflictWith returns how the players of either party avoid the players of the other, looking them up in the
+// blocks each party queued with
+func (e PoolEntry) conflictWith(other PoolEntry) blockConflict {
+	var conflict blockConflict
+	for _, playerID := range other.Players() {
+		conflict |= e.Blocks[playerID].conflict()
+	}
+
+	for _, playerID := range e.Players() {
+		conflict |= other.Blocks[playerID].conflict()
+	}
+
+	return conflict
+}
+
+// blockConflicts returns the conflict of every two entries, by their indexes. Returns nil when no entry avoids another.
+func blockConflicts(entries []PoolEntry) [][]blockConflict {
+	var conflicts [][]blockConflict
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			if len(entries[i].Blocks) == 0 && len(entries[j].Blocks) == 0 {
+				continue
+			}
+
+			conflict := entries[i].conflictWith(entries[j])
+			if conflict == 0 {
+				continue
+			}
+
+			if conflicts == nil {
+				conflicts = make([][]blockConflict, len(entries))
+				for k := range conflicts {
+					conflicts[k] = make([]blockConflict, len(entries))
+				}
+			}
+
+			conflicts[i][j], conflicts[j][i] = conflict, conflict
+		}
+	}
+
+	return conflicts
+}
+
+// allowsTeam reports whether the entry i can join the team given the teams of the entries before it, without
+// sharing a team with the players it avoids as teammates nor facing the ones it avoids as opponents
+func allowsTeam(conflicts [][]blockConflict, assignment []int, i, team int) bool {
+	if conflicts == nil {
+		return true
+	}
+
+	for j := 0; j < i; j++ {
+		conflict := conflicts[i][j]
+		if (assignment[j] == team && conflict&blockTeammate != 0) || (assignment[j] != team && conflict&blockOpponent != 0) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+)
+
+// blocking makes the first player of the party block the first player of the other party with the scope
+func blocking(party *pairing_entities.PoolEntry, other pairing_entities.PoolEntry, scope pairing_entities.BlockScope) {
+	party.Blocks = map[uuid.UUID]pairing_entities.BlockScope{other.PlayerIDs[0]: scope}
+}
+
+func TestBlockedPlayers(t *testing.T) {
+	playerID, teammate, blocked := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("Avoids Players Blocked With Different Scopes Always", func(t *testing.T) {
+		blocks := []*pairing_entities.PlayerBlock{
+			pairing_entities.NewPlayerBlock(playerID, blocked, pairing_entities.BlockAsTeammate),
+			pairing_entities.NewPlayerBlock(teammate, blocked, pairing_entities.BlockAsOpponent),
+			pairing_entities.NewPlayerBlock(playerID, teammate, pairing_entities.BlockAsOpponent),
+		}
+
+		assert.Equal(t, map[uuid.UUID]pairing_entities.BlockScope{
+			blocked:  pairing_entities.BlockAlways,
+			teammate: pairing_entities.BlockAsOpponent,
+		}, pairing_entities.BlockedPlayers(blocks))
+	})
+
+	t.Run("Identifies Blocks By Both Players", func(t *testing.T) {
+		assert.Equal(t, pairing_entities.NewPlayerBlock(playerID, blocked, pairing_entities.BlockAlways).ID, pairing_entities.PlayerBlockID(playerID, blocked))
+		assert.NotEqual(t, pairing_entities.PlayerBlockID(playerID, blocked), pairing_entities.PlayerBlockID(blocked, playerID))
+	})
+}
+
+func TestFormTeams_Blocks(t *testing.T) {
+	layout := pairing_entities.TeamLayout{NumberOfTeams: 2, MaxPlayersPerTeam: 2}
+
+	t.Run("Separates Players Blocked As Teammates", func(t *testing.T) {
+		// by MMR alone the first two would team up against the last two
+		entries := []pairing_entities.PoolEntry{teamEntry(1, 2000), teamEntry(1, 1000), teamEntry(1, 1900), teamEntry(1, 1100)}
+		blocking(&entries[1], entries[0], pairing_entities.BlockAsTeammate)
+
+		teams, err := pairing_entities.FormTeams(entries, layout)
+
+		require.NoError(t, err)
+		byParty := teamOf(teams)
+		assert.NotEqual(t, byParty[entries[0].PartyID], byParty[entries[1].PartyID])
+	})
+
+	t.Run("Teams Up Players Blocked As Opponents", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{teamEntry(1, 2000), teamEntry(1, 1900), teamEntry(1, 1100), teamEntry(1, 1000)}
+		blocking(&entries[0], entries[1], pairing_entities.BlockAsOpponent)
+
+		teams, err := pairing_entities.FormTeams(entries, layout)
+
+		require.NoError(t, err)
+		byParty := teamOf(teams)
+		assert.Equal(t, byParty[entries[0].PartyID], byParty[entries[1].PartyID])
+	})
+
+	t.Run("Fails When Players Block Each Other Always", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1000)}
+		blocking(&entries[1], entries[0], pairing_entities.BlockAlways)
+
+		_, err := pairing_entities.FormTeams(entries, pairing_entities.TeamLayout{NumberOfTeams: 2})
+
+		assert.ErrorIs(t, err, pairing_entities.ErrNoTeamArrangement)
+	})
+}
+
+func TestSelectors_HonorBlocks(t *testing.T) {
+	t.Run("Skips Parties Blocked By The Group", func(t *testing.T) {
+		rules := pairing_entities.SelectionRules{Layout: pairing_entities.TeamLayout{NumberOfTeams: 2, MaxPlayersPerTeam: 1}}
+		entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1000), teamEntry(1, 1000)}
+		blocking(&entries[0], entries[1], pairing_entities.BlockAsOpponent)
+
+		assert.Equal(t, []int{0, 2}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+		assert.Equal(t, []int{0, 2}, pairing_entities.SelectWith(pairing_entities.SkillStrategy{Rules: rules})(entries, 2))
+	})
+
+	t.Run("Keeps Parties Blocked As Teammates When They Can Face Each Other", func(t *testing.T) {
+		rules := pairing_entities.SelectionRules{Layout: pairing_entities.TeamLayout{NumberOfTeams: 2, MaxPlayersPerTeam: 1}}
+		entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1000)}
+		blocking(&entries[1], entries[0], pairing_entities.BlockAsTeammate)
+
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+	})
+
+	t.Run("Waits When Every Candidate Is Blocked", func(t *testing.T) {
+		rules := pairing_entities.SelectionRules{Layout: pairing_entities.TeamLayout{NumberOfTeams: 2}}
+		entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1000)}
+		blocking(&entries[0], entries[1], pairing_entities.BlockAlways)
+
+		assert.Nil(t, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+	})
+}
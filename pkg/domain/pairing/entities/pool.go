@@ -4,7 +4,10 @@
 package entities
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
@@ -14,68 +17,278 @@ import (
 )
 
 type Pool struct {
-	Parties []uuid.UUID `json:"party_ids" bson:"party_ids"` // alterar para PairRequest/ objeto que armazene data de entraada no pool
-	Lobby   lobbies_entities.Lobby
+	ID            uuid.UUID                      `json:"id" bson:"_id"`  // derived from Key, see PoolID
+	Key           string                         `json:"key" bson:"key"` // see Criteria.PoolKey
+	Criteria      pairing_value_objects.Criteria `json:"criteria" bson:"criteria"`
+	Entries       []PoolEntry                    `json:"entries" bson:"entries"`                                   // in arrival order
+	BackfillSlots []BackfillSlot                 `json:"backfill_slots,omitempty" bson:"backfill_slots,omitempty"` // served before regular matches, see TakeBackfill
+	Lobby         lobbies_entities.Lobby
 	// MinimumDate *time.Time
 	// MaximumDate *time.Time
 
-	// Criteria pairing_value_objects.Criteria `json:"criteria" bson:"criteria"`
-
 	PartySize uint8
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	BatchedAt time.Time   `json:"batched_at,omitempty" bson:"batched_at,omitempty"` // last time the batch matcher ran on the pool, see TakeBatch
 	mutex     *sync.Mutex `json:"-" bson:"-"`
 	cond      *sync.Cond  `json:"-" bson:"-"`
 }
 
 func NewPool(mutex *sync.Mutex, cond *sync.Cond, c pairing_value_objects.Criteria) *Pool {
+	key := c.PoolKey()
+	now := time.Now()
+
 	return &Pool{
-		mutex: mutex,
-		cond:  cond,
-		// Criteria: c,
+		ID:        PoolID(key),
+		Key:       key,
+		Criteria:  c,
+		CreatedAt: now,
+		UpdatedAt: now,
+		mutex:     mutex,
+		cond:      cond,
 	}
 }
 
-func (e *Pool) Join(pid uuid.UUID) int {
+// PoolID derives the stable identifier of the pool stored under the given key,
+// so the same criteria always map to the same persisted document.
+func PoolID(key string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("pool:"+key))
+}
+
+// GetID returns the pool identifier.
+func (e Pool) GetID() uuid.UUID {
+	return e.ID
+}
+
+// Restore rebuilds the synchronization primitives of a pool decoded from storage.
+// It must be called before the pool is shared between goroutines.
+func (e *Pool) Restore() *Pool {
+	e.mutex = &sync.Mutex{}
+	e.cond = sync.NewCond(e.mutex)
+
+	return e
+}
+
+// Snapshot returns a copy of the pool state that can be persisted while other goroutines keep using the pool.
+func (e *Pool) Snapshot() *Pool {
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 
-	for i, p := range e.Parties {
-		if p == pid {
-			e.cond.Signal()
+	snapshot := &Pool{
+		ID:            e.ID,
+		Key:           e.Key,
+		Criteria:      e.Criteria,
+		Entries:       make([]PoolEntry, len(e.Entries)),
+		BackfillSlots: slices.Clone(e.BackfillSlots),
+		Lobby:         e.Lobby,
+		PartySize:     e.PartySize,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+		BatchedAt:     e.BatchedAt,
+	}
+
+	copy(snapshot.Entries, e.Entries)
+
+	return snapshot
+}
+
+// Join enqueues the entry, stamping JoinedAt when it is not set, and returns its 1-based position.
+// Joining is idempotent: a party already queued keeps its original entry and position.
+func (e *Pool) Join(entry PoolEntry) int {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	// wake up every waiting Peek: each one may be looking for a different group
+	defer e.cond.Broadcast()
+
+	for i, queued := range e.Entries {
+		if queued.PartyID == entry.PartyID {
 			return i + 1
 		}
 	}
 
-	e.Parties = append(e.Parties, pid) // TODO: timestamp da entrada no pool
+	if entry.JoinedAt.IsZero() {
+		entry.JoinedAt = time.Now()
+	}
+
+	entry.Size = entry.PlayerCount()
+
+	e.Entries = append(e.Entries, entry)
+	e.UpdatedAt = time.Now()
+
+	return len(e.Entries)
+}
+
+// Requeue puts entries taken out of the pool back at their original position, according to when they joined.
+// Entries whose party is queued again in the meantime are skipped.
+func (e *Pool) Requeue(entries ...PoolEntry) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	defer e.cond.Broadcast()
+
+	for _, entry := range entries {
+		if e.indexOf(entry.PartyID) >= 0 {
+			continue
+		}
+
+		position := sort.Search(len(e.Entries), func(i int) bool {
+			return e.Entries[i].JoinedAt.After(entry.JoinedAt)
+		})
+
+		e.Entries = slices.Insert(e.Entries, position, entry)
+	}
+
+	e.UpdatedAt = time.Now()
+}
+
+// Peek dequeues the longest-waiting entries bringing qty players between them, waiting for them to join if needed.
+// See PeekWith for the cancellation semantics.
+func (e *Pool) Peek(ctx context.Context, qty int) ([]PoolEntry, error) {
+	return e.PeekWith(ctx, qty, SelectFIFO)
+}
+
+// PeekWith dequeues the group of entries chosen by the selector, bringing qty players between them, waiting for new entries until the selector
+// finds one. It gives up when the context is done, returning its error (ie, context.DeadlineExceeded when the
+// context has a deadline), so callers must never pass a context that cannot end unless they mean to wait forever.
+func (e *Pool) PeekWith(ctx context.Context, qty int, selector GroupSelector) ([]PoolEntry, error) {
+	if qty <= 0 {
+		return nil, fmt.Errorf("Pool.PeekWith: invalid group size %d", qty)
+	}
+
+	// cond.Wait cannot watch the context by itself, so wake every waiter up when it ends
+	stop := context.AfterFunc(ctx, func() {
+		e.mutex.Lock()
+		defer e.mutex.Unlock()
+
+		e.cond.Broadcast()
+	})
+	defer stop()
 
-	position := len(e.Parties)
-	e.cond.Signal()
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for {
+		if group := e.take(qty, selector); group != nil {
+			return group, nil
+		}
 
-	return position
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		e.cond.Wait()
+	}
 }
 
-func (e *Pool) Peek(qty int) []uuid.UUID {
+// TryPeek works like Peek, but returns nil right away when the entries are not there yet
+func (e *Pool) TryPeek(qty int) []PoolEntry {
+	return e.TryPeekWith(qty, SelectFIFO)
+}
+
+// TryPeekWith works like PeekWith, but returns nil right away instead of waiting when the selector finds no group
+func (e *Pool) TryPeekWith(qty int, selector GroupSelector) []PoolEntry {
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 
-	for len(e.Parties) == 0 {
-		e.cond.Wait() // test how does it behaves on a empty queue
+	return e.take(qty, selector)
+}
+
+// TakeBatch dequeues at once every group chosen by the selector, provided the last batch was taken at least tick
+// before now. Groups not bringing exactly qty players are left in the pool. Returns nil when the batch is not due
+// yet, or no group can be formed.
+//
+// The selector runs on a copy of the entries without holding the pool mutex, so parties keep joining and leaving while
+// a large pool is matched; groups with a party that left in the meantime are left out of the batch.
+func (e *Pool) TakeBatch(qty int, now time.Time, tick time.Duration, selector BatchSelector) [][]PoolEntry {
+	if !e.startBatch(now, tick) || qty <= 0 {
+		return nil
+	}
+
+	entries := e.QueuedEntries()
+	if len(entries) == 0 {
+		return nil
 	}
 
-	if len(e.Parties) < qty {
+	selection := selector(entries, qty)
+	if len(selection) == 0 {
 		return nil
 	}
 
-	p := e.Parties[:qty]
-	e.Parties = e.Parties[qty:]
-	return p
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	queued := make(map[uuid.UUID]int, len(e.Entries))
+	for i, entry := range e.Entries {
+		queued[entry.PartyID] = i
+	}
+
+	var groups [][]PoolEntry
+	taken := make(map[int]bool)
+	for _, selected := range selection {
+		group := make([]PoolEntry, 0, len(selected))
+		indexes := make([]int, 0, len(selected))
+		players := 0
+		for _, i := range selected {
+			index, ok := queued[entries[i].PartyID]
+			if !ok || taken[index] {
+				break
+			}
+
+			group = append(group, e.Entries[index])
+			indexes = append(indexes, index)
+			players += e.Entries[index].PlayerCount()
+		}
+
+		if len(indexes) != len(selected) || players != qty {
+			continue
+		}
+
+		for _, index := range indexes {
+			taken[index] = true
+		}
+
+		groups = append(groups, group)
+	}
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	remaining := make([]PoolEntry, 0, len(e.Entries)-len(taken))
+	for i, entry := range e.Entries {
+		if !taken[i] {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	e.Entries = remaining
+	e.UpdatedAt = now
+
+	return groups
+}
+
+// startBatch records now as the time of the last batch, unless the previous one was taken less than tick before
+func (e *Pool) startBatch(now time.Time, tick time.Duration) bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if now.Sub(e.BatchedAt) < tick {
+		return false
+	}
+
+	e.BatchedAt = now
+
+	return true
 }
 
 func (e *Pool) Remove(partyID uuid.UUID) (int, error) {
-	for i, pid := range e.Parties {
-		if pid == partyID {
-			e.Parties = append(e.Parties[:i], e.Parties[i+1:]...)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for i, entry := range e.Entries {
+		if entry.PartyID == partyID {
+			e.Entries = append(e.Entries[:i], e.Entries[i+1:]...)
+			e.UpdatedAt = time.Now()
 			return i + 1, nil
 		}
 	}
@@ -84,11 +297,82 @@ func (e *Pool) Remove(partyID uuid.UUID) (int, error) {
 }
 
 func (e *Pool) IsQueued(pid uuid.UUID) (int, bool) {
-	for i, p := range e.Parties {
-		if p == pid {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for i, entry := range e.Entries {
+		if entry.PartyID == pid {
 			return i, true
 		}
 	}
 
 	return -1, false
 }
+
+// Len returns how many entries are waiting in the pool
+func (e *Pool) Len() int {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return len(e.Entries)
+}
+
+// QueuedEntries returns a copy of the waiting entries, in arrival order
+func (e *Pool) QueuedEntries() []PoolEntry {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	entries := make([]PoolEntry, len(e.Entries))
+	copy(entries, e.Entries)
+
+	return entries
+}
+
+// indexOf returns the index of the party's entry, or -1 when it is not queued. The caller must hold the pool mutex.
+func (e *Pool) indexOf(pid uuid.UUID) int {
+	for i, entry := range e.Entries {
+		if entry.PartyID == pid {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// take dequeues the group chosen by the selector, as long as it brings exactly qty players.
+// The caller must hold the pool mutex.
+func (e *Pool) take(qty int, selector GroupSelector) []PoolEntry {
+	if qty <= 0 || len(e.Entries) == 0 {
+		return nil
+	}
+
+	selected := selector(e.Entries, qty)
+	if len(selected) == 0 {
+		return nil
+	}
+
+	p := make([]PoolEntry, 0, len(selected))
+	taken := make(map[int]bool, len(selected))
+	players := 0
+	for _, i := range selected {
+		p = append(p, e.Entries[i])
+		taken[i] = true
+		players += e.Entries[i].PlayerCount()
+	}
+
+	if players != qty {
+		return nil
+	}
+
+	remaining := make([]PoolEntry, 0, len(e.Entries)-len(selected))
+	for i, entry := range e.Entries {
+		if !taken[i] {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	e.Entries = remaining
+	e.UpdatedAt = time.Now()
+
+	return p
+}
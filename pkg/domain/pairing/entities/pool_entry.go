@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+)
+
+// PoolEntry is a party waiting in a pool, along with everything the matcher needs to know about it
+type PoolEntry struct {
+	PartyID   uuid.UUID                      `json:"party_id" bson:"party_id"`
+	PlayerIDs []uuid.UUID                    `json:"player_ids,omitempty" bson:"player_ids,omitempty"`
+	JoinedAt  time.Time                      `json:"joined_at" bson:"joined_at"`
+	Size      int                            `json:"size" bson:"size"` // number of players in the party
+	MMR       int                            `json:"mmr" bson:"mmr"`
+	Pings     map[string]int                 `json:"pings,omitempty" bson:"pings,omitempty"`       // latency in ms, by region slug
+	Backfill  bool                           `json:"backfill,omitempty" bson:"backfill,omitempty"` // the party accepts to replace players who left a match in progress
+	Roles     map[uuid.UUID][]string         `json:"roles,omitempty" bson:"roles,omitempty"`       // roles each player accepts to play, by player
+	Blocks    map[uuid.UUID]BlockScope       `json:"blocks,omitempty" bson:"blocks,omitempty"`     // players the party's players blocked, by blocked player
+	Criteria  pairing_value_objects.Criteria `json:"criteria" bson:"criteria"`                     // as requested when the party joined
+
+	RecentOpponents []uuid.UUID `json:"recent_opponents,omitempty" bson:"recent_opponents,omitempty"` // parties faced recently, kept apart under a RematchRule
+}
+
+// Players returns the players of the party. Entries without PlayerIDs are solo parties identified by their player.
+func (e PoolEntry) Players() []uuid.UUID {
+	if len(e.PlayerIDs) == 0 {
+		return []uuid.UUID{e.PartyID}
+	}
+
+	return e.PlayerIDs
+}
+
+// AcceptedRoles returns the roles the player accepts to play. Players accepting no role in particular can play any.
+func (e PoolEntry) AcceptedRoles(playerID uuid.UUID) []string {
+	return e.Roles[playerID]
+}
+
+// PlayerCount returns how many players the party brings, never less than one
+func (e PoolEntry) PlayerCount() int {
+	return max(e.Size, len(e.PlayerIDs), 1)
+}
+
+// WaitTime returns how long the party has been waiting in the pool at the given instant
+func (e PoolEntry) WaitTime(now time.Time) time.Duration {
+	if e.JoinedAt.IsZero() || now.Before(e.JoinedAt) {
+		return 0
+	}
+
+	return now.Sub(e.JoinedAt)
+}
+
+// HasPriority tells whether the party's subscription grants it a priority boost
+func (e PoolEntry) HasPriority() bool {
+	return e.Criteria.PriorityBoost
+}
+
+// PriorityJoinedAt returns when the party is considered to have joined the queue: headStart earlier than it did
+// when it has a priority boost, the actual JoinedAt otherwise
+func (e PoolEntry) PriorityJoinedAt(headStart time.Duration) time.Time {
+	if !e.HasPriority() {
+		return e.JoinedAt
+	}
+
+	return e.JoinedAt.Add(-headStart)
+}
+
+// SkillWindow returns the MMR range the entry accepts. Entries without a SkillRange accept any MMR.
+func (e PoolEntry) SkillWindow() (int, int) {
+	if e.Criteria.SkillRange == nil {
+		return math.MinInt, math.MaxInt
+	}
+
+	return e.Criteria.SkillRange.MinMMR, e.Criteria.SkillRange.MaxMMR
+}
+
+// RelaxedSkillWindow returns the skill window widened by the expansion step reached at the given instant
+func (e PoolEntry) RelaxedSkillWindow(now time.Time, steps []game_entities.WindowExpansionStep) (int, int) {
+	minMMR, maxMMR := e.SkillWindow()
+
+	step := game_entities.ActiveExpansionStep(steps, e.WaitTime(now))
+	if step == nil || e.Criteria.SkillRange == nil {
+		return minMMR, maxMMR
+	}
+
+	return minMMR - step.MMRDelta, maxMMR + step.MMRDelta
+}
+
+// RelaxedMaxPing returns the MaxPing accepted at the given instant, after the expansion step reached so far.
+// Zero means any ping is accepted.
+func (e PoolEntry) RelaxedMaxPing(now time.Time, steps []game_entities.WindowExpansionStep) int {
+	maxPing := e.Criteria.MaxPing
+
+	step := game_entities.ActiveExpansionStep(steps, e.WaitTime(now))
+	if maxPing == 0 || step == nil || step.MaxPing <= maxPing {
+		return maxPing
+	}
+
+	return step.MaxPing
+}
+
+// PartyIDs returns the party ID of each entry, preserving order
+func PartyIDs(entries []PoolEntry) []uuid.UUID {
+	pids := make([]uuid.UUID, len(entries))
+	for i, entry := range entries {
+		pids[i] = entry.PartyID
+	}
+
+	return pids
+}
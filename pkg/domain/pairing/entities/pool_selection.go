@@ -0,0 +1,273 @@
+package entities
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	schedule_entities "github.com/leet-gaming/match-making-api/pkg/domain/schedules/entities"
+)
+
+// GroupSelector picks queued entries to be matched together, bringing exactly qty players between them.
+// It returns their indexes in queue order, or nil when no acceptable group can be formed yet.
+type GroupSelector func(entries []PoolEntry, qty int) []int
+
+// SelectFIFO picks the longest-waiting entries adding up to qty players, regardless of skill.
+// Parties too big for the slots left are skipped, so they never hold back the queue behind them.
+// Like every selector, it only groups parties that share a region under their MaxPing (see EligibleRegions).
+func SelectFIFO(entries []PoolEntry, qty int) []int {
+	return SelectWith(FIFOStrategy{})(entries, qty)
+}
+
+// SelectBySkill picks the group of entries adding up to qty players whose skill windows all overlap with the
+// smallest MMR spread. Ties are broken in favor of the group holding the longest-waiting entry.
+func SelectBySkill(entries []PoolEntry, qty int) []int {
+	return SelectWith(SkillStrategy{})(entries, qty)
+}
+
+// SelectionRules constrain the groups selectors pick, on top of the players they need
+type SelectionRules struct {
+	Layout    TeamLayout                          // teams the group must be seated in; the zero layout seats anyone
+	Steps     []game_entities.WindowExpansionStep // curve relaxing skill windows and MaxPing with wait time
+	Maps      MapRule                             // how long parties hold out for their preferred maps
+	Rematches RematchRule                         // how parties who faced each other recently are kept apart
+
+	// Schedules tells whether two schedules share some availability. When set, parties are only grouped with the
+	// parties whose schedules overlap theirs; parties without schedule play whenever the others do.
+	Schedules func(a, b schedule_entities.Schedule) bool
+}
+
+// groupRules are the SelectionRules as they apply at a given instant
+type groupRules struct {
+	layout    TeamLayout
+	maxPing   func(PoolEntry) int // nil uses each entry's Criteria.MaxPing
+	maps      MapRule
+	rematch   RematchRule
+	schedules func(a, b schedule_entities.Schedule) bool // nil groups parties regardless of their schedules
+	now       time.Time
+}
+
+func (r SelectionRules) at(now time.Time) groupRules {
+	rules := groupRules{layout: r.Layout, maps: r.Maps, rematch: r.Rematches, schedules: r.Schedules, now: now}
+	if len(r.Steps) > 0 {
+		rules.maxPing = func(e PoolEntry) int {
+			return e.RelaxedMaxPing(now, r.Steps)
+		}
+	}
+
+	return rules
+}
+
+// WithPriority makes the selector favor parties with a priority boost, treating them as if they had joined headStart
+// earlier than they did. A party is only ever overtaken by boosted parties that joined less than headStart after it,
+// so the extra wait boosts cost everyone else is bounded and free parties are never starved.
+func WithPriority(selector GroupSelector, headStart time.Duration) GroupSelector {
+	return func(entries []PoolEntry, qty int) []int {
+		if headStart <= 0 || !slices.ContainsFunc(entries, PoolEntry.HasPriority) {
+			return selector(entries, qty)
+		}
+
+		// the selector sees the queue in priority order; its picks are mapped back to queue indexes
+		order := make([]int, len(entries))
+		for i := range order {
+			order[i] = i
+		}
+
+		sort.SliceStable(order, func(a, b int) bool {
+			return entries[order[a]].PriorityJoinedAt(headStart).Before(entries[order[b]].PriorityJoinedAt(headStart))
+		})
+
+		prioritized := make([]PoolEntry, len(entries))
+		for i, j := range order {
+			prioritized[i] = entries[j]
+		}
+
+		selected := selector(prioritized, qty)
+		if len(selected) == 0 {
+			return nil
+		}
+
+		indexes := make([]int, len(selected))
+		for i, j := range selected {
+			indexes[i] = order[j]
+		}
+
+		sort.Ints(indexes)
+
+		return indexes
+	}
+}
+
+// groupBuilder accumulates parties into a group of qty players that the layout can seat, in a region they all accept,
+// holding back parties that do not share a preferred map with the group until they waited their patience out.
+// The group's players must also be able to fill the layout's role quotas, and be split into its teams without
+// teaming up with or facing the players they blocked. Parties who faced each other recently are kept apart, or
+// counted against the group, depending on the rematch rule.
+type groupBuilder struct {
+	groupRules
+	qty       int
+	maps      mapAgreement
+	indexes   []int
+	members   []PoolEntry
+	sizes     []int
+	players   int
+	regions   map[string]int // worst ping by region the group can still play in; nil while any region will do
+	rematches int            // two parties of the group who faced each other recently, counted once
+}
+
+// newGroupBuilder starts an empty group of qty players under the rules
+func newGroupBuilder(qty int, rules groupRules) groupBuilder {
+	return groupBuilder{groupRules: rules, qty: qty, maps: mapAgreement{rule: rules.maps, now: rules.now}}
+}
+
+// add puts the entry in the group when it still has room for the whole party, they still share a region and the
+// party agrees with the group on the maps
+func (g *groupBuilder) add(i int, entry PoolEntry) bool {
+	size := entry.PlayerCount()
+	if g.players+size > g.qty || !g.layout.fits(append(g.sizes, size), false) || !g.maps.accepts(entry) || !g.overlaps(entry) {
+		return false
+	}
+
+	rematches := 0
+	if g.rematch.Exclude || g.rematch.penalizes() {
+		for _, member := range g.members {
+			if entry.rematches(member) {
+				rematches++
+			}
+		}
+	}
+
+	if rematches > 0 && g.rematch.Exclude {
+		return false
+	}
+
+	regions, ok := intersectRegions(g.regions, entry.EligibleRegions(g.pingLimit(entry)))
+	if !ok || !g.layout.splits(append(g.members, entry), false) {
+		return false
+	}
+
+	g.indexes = append(g.indexes, i)
+	g.members = append(g.members, entry)
+	g.sizes = append(g.sizes, size)
+	g.players += size
+	g.regions = regions
+	g.rematches += rematches
+	g.maps.add(entry)
+
+	return true
+}
+
+// overlaps tells whether the schedule of the party overlaps the schedules of every party of the group, when the
+// rules group parties by schedule
+func (g *groupBuilder) overlaps(entry PoolEntry) bool {
+	if g.schedules == nil || entry.Criteria.Schedule == nil {
+		return true
+	}
+
+	for _, member := range g.members {
+		if member.Criteria.Schedule != nil && !g.schedules(*entry.Criteria.Schedule, *member.Criteria.Schedule) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (g *groupBuilder) pingLimit(entry PoolEntry) int {
+	if g.maxPing == nil {
+		return entry.Criteria.MaxPing
+	}
+
+	return g.maxPing(entry)
+}
+
+func (g *groupBuilder) full() bool {
+	return g.players == g.qty
+}
+
+func (g *groupBuilder) complete() bool {
+	return g.full() && g.layout.fits(g.sizes, true) && g.layout.splits(g.members, true)
+}
+
+// fifoGroups returns the group of qty players each entry can be matched in, in queue order
+func fifoGroups(entries []PoolEntry, qty int, rules groupRules) []groupBuilder {
+	var groups []groupBuilder
+	for a := range entries {
+		if group, ok := fifoGroup(entries, a, qty, rules); ok {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// fifoGroup fills a group around the entry a with the oldest parties that fit. Returns false when the group cannot
+// be completed.
+func fifoGroup(entries []PoolEntry, a, qty int, rules groupRules) (groupBuilder, bool) {
+	group := newGroupBuilder(qty, rules)
+	if !group.add(a, entries[a]) {
+		return group, false
+	}
+
+	for b := 0; b < len(entries) && !group.full(); b++ {
+		if b != a {
+			group.add(b, entries[b])
+		}
+	}
+
+	return group, group.complete()
+}
+
+// skillGroups returns the group of qty players anchored on each entry, by MMR. Each group is anchored on its lowest
+// MMR entry, then takes the closest entries above it whose windows still intersect the group's, so its indexes are
+// in MMR order.
+func skillGroups(entries []PoolEntry, qty int, window func(PoolEntry) (int, int), rules groupRules) []groupBuilder {
+	// ranked by MMR; ties keep queue order so older entries are tried first
+	ranked := make([]int, len(entries))
+	for i := range ranked {
+		ranked[i] = i
+	}
+
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return entries[ranked[a]].MMR < entries[ranked[b]].MMR
+	})
+
+	var groups []groupBuilder
+	for a := range ranked {
+		group := newGroupBuilder(qty, rules)
+		if !group.add(ranked[a], entries[ranked[a]]) {
+			continue
+		}
+
+		low, high := window(entries[ranked[a]])
+
+		for b := a + 1; b < len(ranked) && !group.full(); b++ {
+			minMMR, maxMMR := window(entries[ranked[b]])
+			if minMMR > high || maxMMR < low {
+				continue
+			}
+
+			if group.add(ranked[b], entries[ranked[b]]) {
+				low, high = max(low, minMMR), min(high, maxMMR)
+			}
+		}
+
+		if group.complete() {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// oldest returns the lowest queue index of the group
+func oldest(group []int) int {
+	lowest := math.MaxInt
+	for _, i := range group {
+		lowest = min(lowest, i)
+	}
+
+	return lowest
+}
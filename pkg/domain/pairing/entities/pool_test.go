@@ -0,0 +1,426 @@
+package entities_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+)
+
+func newPool() *pairing_entities.Pool {
+	mutex := &sync.Mutex{}
+	return pairing_entities.NewPool(mutex, sync.NewCond(mutex), pairing_value_objects.Criteria{PairSize: 2})
+}
+
+func TestPool_Peek_Cancellation(t *testing.T) {
+	t.Run("Returns The Context Error When Cancelled While Waiting", func(t *testing.T) {
+		pool := newPool()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		entries, err := pool.Peek(ctx, 2)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, entries)
+	})
+
+	t.Run("Gives Up At The Deadline", func(t *testing.T) {
+		pool := newPool()
+		pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New()})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+		defer cancel()
+
+		started := time.Now()
+		entries, err := pool.Peek(ctx, 2)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Nil(t, entries)
+		assert.Less(t, time.Since(started), time.Second)
+		assert.Equal(t, 1, pool.Len(), "entries must stay queued when the peek gives up")
+	})
+
+	t.Run("Returns Right Away When The Group Is Already There", func(t *testing.T) {
+		pool := newPool()
+		pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New()})
+		pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New()})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		entries, err := pool.Peek(ctx, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+		assert.Zero(t, pool.Len())
+	})
+
+	t.Run("Wakes Up When Enough Parties Join", func(t *testing.T) {
+		pool := newPool()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		first, second := uuid.New(), uuid.New()
+		go func() {
+			pool.Join(pairing_entities.PoolEntry{PartyID: first})
+			time.Sleep(10 * time.Millisecond)
+			pool.Join(pairing_entities.PoolEntry{PartyID: second})
+		}()
+
+		entries, err := pool.Peek(ctx, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first, second}, pairing_entities.PartyIDs(entries))
+	})
+
+	t.Run("Releases Every Waiter On Cancellation", func(t *testing.T) {
+		pool := newPool()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 10)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := pool.Peek(ctx, 2)
+				errs <- err
+			}()
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.ErrorIs(t, err, context.Canceled)
+		}
+	})
+
+	t.Run("Rejects Invalid Group Sizes", func(t *testing.T) {
+		_, err := newPool().Peek(context.Background(), 0)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestPool_TryPeek(t *testing.T) {
+	pool := newPool()
+
+	assert.Nil(t, pool.TryPeek(2), "empty pool must not block")
+
+	pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New()})
+	assert.Nil(t, pool.TryPeek(2))
+	assert.Equal(t, 1, pool.Len())
+
+	pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New()})
+	assert.Len(t, pool.TryPeek(2), 2)
+	assert.Zero(t, pool.Len())
+}
+
+// TestPool_ConcurrentAccess is meant to be run with -race: every accessor is hammered at the same time
+// and each party must end up either matched once, removed, or still queued.
+func TestPool_ConcurrentAccess(t *testing.T) {
+	const (
+		joiners        = 8
+		partiesPerJoin = 200
+		peekers        = 4
+	)
+
+	pool := newPool()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	partyIDs := make([][]uuid.UUID, joiners)
+	for i := range partyIDs {
+		partyIDs[i] = make([]uuid.UUID, partiesPerJoin)
+		for j := range partyIDs[i] {
+			partyIDs[i][j] = uuid.New()
+		}
+	}
+
+	var (
+		mu      sync.Mutex
+		matched = make(map[uuid.UUID]int)
+		removed = make(map[uuid.UUID]bool)
+	)
+
+	var producers sync.WaitGroup
+	for i := 0; i < joiners; i++ {
+		producers.Add(1)
+		go func(ids []uuid.UUID) {
+			defer producers.Done()
+
+			for j, pid := range ids {
+				pool.Join(pairing_entities.PoolEntry{PartyID: pid})
+				pool.IsQueued(pid)
+
+				// every tenth party leaves the queue right away, if it was not matched yet
+				if j%10 == 0 {
+					if _, err := pool.Remove(pid); err == nil {
+						mu.Lock()
+						removed[pid] = true
+						mu.Unlock()
+					}
+				}
+			}
+		}(partyIDs[i])
+	}
+
+	consumersCtx, stopConsumers := context.WithCancel(ctx)
+	var consumers sync.WaitGroup
+	for i := 0; i < peekers; i++ {
+		consumers.Add(1)
+		go func(blocking bool) {
+			defer consumers.Done()
+
+			for {
+				var entries []pairing_entities.PoolEntry
+				if blocking {
+					var err error
+					if entries, err = pool.Peek(consumersCtx, 2); err != nil {
+						return
+					}
+				} else {
+					if consumersCtx.Err() != nil {
+						return
+					}
+					entries = pool.TryPeek(2)
+				}
+
+				mu.Lock()
+				for _, entry := range entries {
+					matched[entry.PartyID]++
+				}
+				mu.Unlock()
+			}
+		}(i%2 == 0)
+	}
+
+	readers := make(chan struct{})
+	go func() {
+		defer close(readers)
+
+		for consumersCtx.Err() == nil {
+			pool.Len()
+			pool.QueuedEntries()
+			pool.Snapshot()
+		}
+	}()
+
+	producers.Wait()
+
+	// let consumers drain what can still be paired, then stop them
+	require.Eventually(t, func() bool { return pool.Len() < 2 }, 5*time.Second, time.Millisecond)
+	stopConsumers()
+	consumers.Wait()
+	<-readers
+
+	remaining := make(map[uuid.UUID]bool)
+	for _, entry := range pool.QueuedEntries() {
+		remaining[entry.PartyID] = true
+	}
+
+	for _, ids := range partyIDs {
+		for _, pid := range ids {
+			outcomes := matched[pid]
+			if removed[pid] {
+				outcomes++
+			}
+			if remaining[pid] {
+				outcomes++
+			}
+
+			assert.Equal(t, 1, outcomes, "party %v must be matched, removed or queued exactly once", pid)
+		}
+	}
+}
+
+func TestPool_Requeue(t *testing.T) {
+	now := time.Now()
+	first := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: now.Add(-3 * time.Minute)}
+	second := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: now.Add(-2 * time.Minute)}
+	third := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: now.Add(-time.Minute)}
+
+	t.Run("Puts Entries Back At Their Original Position", func(t *testing.T) {
+		pool := newPool()
+		pool.Join(first)
+		pool.Join(second)
+		pool.Join(third)
+
+		taken := pool.TryPeekWith(2, func(entries []pairing_entities.PoolEntry, qty int) []int { return []int{0, 2} })
+		require.Len(t, taken, 2)
+
+		pool.Requeue(taken...)
+
+		assert.Equal(t, []uuid.UUID{first.PartyID, second.PartyID, third.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+	})
+
+	t.Run("Skips Parties That Are Already Queued", func(t *testing.T) {
+		pool := newPool()
+		pool.Join(first)
+
+		pool.Requeue(first, second)
+
+		assert.Equal(t, []uuid.UUID{first.PartyID, second.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+	})
+
+	t.Run("Wakes Up Waiting Peeks", func(t *testing.T) {
+		pool := newPool()
+		pool.Join(first)
+
+		done := make(chan []pairing_entities.PoolEntry)
+		go func() {
+			entries, _ := pool.Peek(context.Background(), 2)
+			done <- entries
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		pool.Requeue(second)
+
+		select {
+		case entries := <-done:
+			assert.Equal(t, []uuid.UUID{first.PartyID, second.PartyID}, pairing_entities.PartyIDs(entries))
+		case <-time.After(time.Second):
+			t.Fatal("Peek was not woken up by Requeue")
+		}
+	})
+}
+
+func TestPool_TryPeekWith_PacksPartiesIntoTeams(t *testing.T) {
+	party := func(size int) pairing_entities.PoolEntry {
+		return pairing_entities.PoolEntry{PartyID: uuid.New(), Size: size}
+	}
+
+	fiveVersusFive := pairing_entities.TeamLayout{NumberOfTeams: 2, MinPlayersPerTeam: 5, MaxPlayersPerTeam: 5}
+
+	testCases := []struct {
+		name     string
+		sizes    []int
+		expected []int // indexes of the entries taken, nil when none
+	}{
+		{
+			name:     "Mixes Stacks, Duos And Solos",
+			sizes:    []int{3, 2, 2, 2, 1},
+			expected: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name:     "Skips Parties That Cannot Be Seated Next To The Others",
+			sizes:    []int{4, 4, 4, 1, 1},
+			expected: []int{0, 1, 3, 4},
+		},
+		{
+			name:     "Waits When The Players Add Up But Teams Cannot Be Evened Out",
+			sizes:    []int{4, 4, 2},
+			expected: nil,
+		},
+		{
+			name:     "Takes Two Full Stacks",
+			sizes:    []int{5, 3, 5},
+			expected: []int{0, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pool := newPool()
+
+			var entries []pairing_entities.PoolEntry
+			for _, size := range tc.sizes {
+				entry := party(size)
+				entries = append(entries, entry)
+				pool.Join(entry)
+			}
+
+			taken := pool.TryPeekWith(10, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: pairing_entities.SelectionRules{Layout: fiveVersusFive}}))
+
+			if tc.expected == nil {
+				assert.Nil(t, taken)
+				assert.Equal(t, len(tc.sizes), pool.Len())
+				return
+			}
+
+			var expected []uuid.UUID
+			for _, i := range tc.expected {
+				expected = append(expected, entries[i].PartyID)
+			}
+
+			assert.Equal(t, expected, pairing_entities.PartyIDs(taken))
+			assert.Equal(t, len(tc.sizes)-len(tc.expected), pool.Len())
+		})
+	}
+
+	t.Run("Rejects Groups That Do Not Bring Exactly The Requested Players", func(t *testing.T) {
+		pool := newPool()
+		pool.Join(party(3))
+		pool.Join(party(1))
+
+		taken := pool.TryPeekWith(3, func(entries []pairing_entities.PoolEntry, qty int) []int { return []int{0, 1} })
+
+		assert.Nil(t, taken)
+		assert.Equal(t, 2, pool.Len())
+	})
+}
+
+func TestWithPriority(t *testing.T) {
+	now := time.Now()
+
+	entry := func(joinedAgo time.Duration, boosted bool) pairing_entities.PoolEntry {
+		return pairing_entities.PoolEntry{
+			PartyID:  uuid.New(),
+			JoinedAt: now.Add(-joinedAgo),
+			Size:     1,
+			Criteria: pairing_value_objects.Criteria{PriorityBoost: boosted},
+		}
+	}
+
+	selector := pairing_entities.WithPriority(pairing_entities.SelectFIFO, 30*time.Second)
+
+	t.Run("Lets Boosted Parties Overtake Those Who Joined Within The Head Start", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			entry(20*time.Second, false),
+			entry(15*time.Second, false),
+			entry(10*time.Second, true),
+		}
+
+		assert.Equal(t, []int{0, 2}, selector(entries, 2))
+	})
+
+	t.Run("Never Lets Boosted Parties Overtake Those Waiting Longer Than The Head Start", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			entry(2*time.Minute, false),
+			entry(90*time.Second, false),
+			entry(10*time.Second, true),
+		}
+
+		assert.Equal(t, []int{0, 1}, selector(entries, 2))
+	})
+
+	t.Run("Keeps Queue Order Without Boosted Parties", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			entry(20*time.Second, false),
+			entry(15*time.Second, false),
+			entry(10*time.Second, false),
+		}
+
+		assert.Equal(t, []int{0, 1}, selector(entries, 2))
+	})
+
+	t.Run("Returns Nil When The Selector Finds No Group", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{entry(10*time.Second, true)}
+
+		assert.Nil(t, selector(entries, 2))
+	})
+}
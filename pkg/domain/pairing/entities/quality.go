@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"time"
+
+	schedule_entities "github.com/leet-gaming/match-making-api/pkg/domain/schedules/entities"
+)
+
+// weights of the factors of MatchQuality in its Total; they add up to 1
+const (
+	skillWeight    = 0.4
+	waitWeight     = 0.2
+	pingWeight     = 0.2
+	scheduleWeight = 0.2
+)
+
+// MatchQuality rates a group of parties as a match. Every factor goes from 0, the worst, to 1, the best.
+type MatchQuality struct {
+	Skill    float64 `json:"skill" bson:"skill"`       // closeness of the parties' MMR
+	Wait     float64 `json:"wait" bson:"wait"`         // how long the parties waited, so the longest waits are served first
+	Ping     float64 `json:"ping" bson:"ping"`         // latency of the worst connected party in the best region
+	Schedule float64 `json:"schedule" bson:"schedule"` // share of the parties whose schedules overlap
+	Total    float64 `json:"total" bson:"total"`       // weighted average of the factors
+}
+
+// QualityModel scales the factors of MatchQuality
+type QualityModel struct {
+	MMRSpread int           // MMR spread of a group rating 0 on skill
+	Wait      time.Duration // average wait of a group rating 1 on wait
+	Ping      int           // ms, worst ping of a group rating 0 on ping
+	HeadStart time.Duration // parties with a priority boost count as having waited this much longer
+
+	// Overlaps tells whether two schedules share some availability. When nil, every schedule overlaps.
+	Overlaps func(a, b schedule_entities.Schedule) bool
+
+	// OverlapMinutes measures how long two schedules share availability. When nil, reports leave overlaps out.
+	OverlapMinutes func(a, b schedule_entities.Schedule) int
+}
+
+// DefaultQualityModel rates groups 500 MMR apart, or with a player at 200 ms, as the worst matches, and favors groups
+// that waited two minutes on average as much as it can
+var DefaultQualityModel = QualityModel{MMRSpread: 500, Wait: 2 * time.Minute, Ping: 200}
+
+// Rate returns the quality of the match the entries would play at the given instant, in the best region they accept
+// under the MaxPing given by maxPing
+func (m QualityModel) Rate(entries []PoolEntry, now time.Time, maxPing func(PoolEntry) int) MatchQuality {
+	return m.rate(entries, 0, now, maxPing)
+}
+
+// rate works like Rate, counting penalty as extra MMR spread
+func (m QualityModel) rate(entries []PoolEntry, penalty int, now time.Time, maxPing func(PoolEntry) int) MatchQuality {
+	if len(entries) == 0 {
+		return MatchQuality{}
+	}
+
+	lowest, highest := entries[0].MMR, entries[0].MMR
+	var waited time.Duration
+	for _, entry := range entries {
+		lowest, highest = min(lowest, entry.MMR), max(highest, entry.MMR)
+		waited += entry.WaitTime(now)
+		if entry.HasPriority() {
+			waited += m.HeadStart
+		}
+	}
+
+	quality := MatchQuality{
+		Skill:    1 - ratio(float64(highest-lowest+penalty), float64(m.MMRSpread)),
+		Wait:     ratio(float64(waited)/float64(len(entries)), float64(m.Wait)),
+		Ping:     1,
+		Schedule: m.scheduleOverlap(entries),
+	}
+
+	if region, ok := BestRegion(entries, maxPing); ok {
+		quality.Ping = 1 - ratio(float64(region.WorstPing), float64(m.Ping))
+	}
+
+	quality.Total = skillWeight*quality.Skill + waitWeight*quality.Wait + pingWeight*quality.Ping + scheduleWeight*quality.Schedule
+
+	return quality
+}
+
+// scheduleOverlap returns the share of the pairs of entries whose schedules overlap. Entries without schedule play
+// whenever the others do.
+func (m QualityModel) scheduleOverlap(entries []PoolEntry) float64 {
+	if m.Overlaps == nil {
+		return 1
+	}
+
+	pairs, overlapping := 0, 0
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			a, b := entries[i].Criteria.Schedule, entries[j].Criteria.Schedule
+			if a == nil || b == nil {
+				continue
+			}
+
+			pairs++
+			if m.Overlaps(*a, *b) {
+				overlapping++
+			}
+		}
+	}
+
+	if pairs == 0 {
+		return 1
+	}
+
+	return float64(overlapping) / float64(pairs)
+}
+
+// ratio returns value over scale, capped between 0 and 1. A scale of 0 or less rates every value 0.
+func ratio(value, scale float64) float64 {
+	if scale <= 0 || value <= 0 {
+		return 0
+	}
+
+	return min(value/scale, 1)
+}
@@ -0,0 +1,245 @@
+package entities
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+)
+
+// longestWaitsReported is how many of the longest waits of a match its QualityReport keeps
+const longestWaitsReported = 3
+
+// Relaxation is a rule a match was allowed to bend to be made
+type Relaxation string
+
+const (
+	RelaxedSkillWindow    Relaxation = "skill_window"    // the parties' skill windows do not overlap unless widened by the expansion curve
+	RelaxedMaxPing        Relaxation = "max_ping"        // the parties only share a region once their MaxPing is raised by the expansion curve
+	RelaxedMapPreferences Relaxation = "map_preferences" // some parties share no preferred map, having waited their map patience out
+	RelaxedRematch        Relaxation = "rematch"         // some parties faced each other recently
+)
+
+// QualityReport records how good a match was when it was made, so matchmaking parameters can be tuned from data
+type QualityReport struct {
+	Score                  MatchQuality `json:"score" bson:"score"`
+	MMRSpread              int          `json:"mmr_spread" bson:"mmr_spread"`                                                 // between the lowest and highest MMR party
+	TeamBalance            int          `json:"team_balance" bson:"team_balance"`                                             // between the teams of highest and lowest average MMR; 0 without teams
+	MaxPing                int          `json:"max_ping" bson:"max_ping"`                                                     // ms, of the worst connected party in the match's region; 0 when no ping was reported
+	ScheduleOverlapMinutes *int         `json:"schedule_overlap_minutes,omitempty" bson:"schedule_overlap_minutes,omitempty"` // shortest overlap between the schedules of two parties; nil when fewer than two parties have one
+	LongestWaitSeconds     []int        `json:"longest_wait_seconds" bson:"longest_wait_seconds"`                             // of the longest-waiting parties, longest first
+	Relaxations            []Relaxation `json:"relaxations,omitempty" bson:"relaxations,omitempty"`
+}
+
+// Report returns the quality report of the match the entries make in the teams, at the given instant, under the rules
+// they were grouped by
+func (m QualityModel) Report(entries []PoolEntry, teams []Team, rules SelectionRules, now time.Time) QualityReport {
+	maxPing := rules.at(now).maxPing
+	if maxPing == nil {
+		maxPing = func(e PoolEntry) int { return e.Criteria.MaxPing }
+	}
+
+	rematches := 0
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			if entries[i].rematches(entries[j]) {
+				rematches++
+			}
+		}
+	}
+
+	penalty := 0
+	if rules.Rematches.penalizes() {
+		penalty = rematches * rules.Rematches.Penalty
+	}
+
+	report := QualityReport{
+		Score:              m.rate(entries, penalty, now, maxPing),
+		MMRSpread:          mmrSpread(entries),
+		TeamBalance:        teamBalance(teams),
+		LongestWaitSeconds: longestWaits(entries, now),
+	}
+
+	region, placed := BestRegion(entries, maxPing)
+	if placed {
+		report.MaxPing = region.WorstPing
+	}
+
+	if m.OverlapMinutes != nil {
+		report.ScheduleOverlapMinutes = m.shortestOverlap(entries)
+	}
+
+	if len(rules.Steps) > 0 && !skillWindowsOverlap(entries) {
+		report.Relaxations = append(report.Relaxations, RelaxedSkillWindow)
+	}
+
+	if _, ok := BestRegion(entries, func(e PoolEntry) int { return e.Criteria.MaxPing }); placed && !ok {
+		report.Relaxations = append(report.Relaxations, RelaxedMaxPing)
+	}
+
+	if rules.Maps.Patience > 0 && !mapPreferencesShared(entries, rules.Maps.Pool) {
+		report.Relaxations = append(report.Relaxations, RelaxedMapPreferences)
+	}
+
+	if rematches > 0 {
+		report.Relaxations = append(report.Relaxations, RelaxedRematch)
+	}
+
+	return report
+}
+
+// shortestOverlap returns the shortest overlap, in minutes, between the schedules of two entries. Returns nil when
+// fewer than two entries have a schedule.
+func (m QualityModel) shortestOverlap(entries []PoolEntry) *int {
+	var shortest *int
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			a, b := entries[i].Criteria.Schedule, entries[j].Criteria.Schedule
+			if a == nil || b == nil {
+				continue
+			}
+
+			if minutes := m.OverlapMinutes(*a, *b); shortest == nil || minutes < *shortest {
+				shortest = &minutes
+			}
+		}
+	}
+
+	return shortest
+}
+
+func mmrSpread(entries []PoolEntry) int {
+	if len(entries) == 0 {
+		return 0
+	}
+
+	lowest, highest := entries[0].MMR, entries[0].MMR
+	for _, entry := range entries {
+		lowest, highest = min(lowest, entry.MMR), max(highest, entry.MMR)
+	}
+
+	return highest - lowest
+}
+
+func teamBalance(teams []Team) int {
+	if len(teams) == 0 {
+		return 0
+	}
+
+	lowest, highest := teams[0].AverageMMR, teams[0].AverageMMR
+	for _, team := range teams {
+		lowest, highest = min(lowest, team.AverageMMR), max(highest, team.AverageMMR)
+	}
+
+	return highest - lowest
+}
+
+// longestWaits returns the waits of the longest-waiting entries in seconds, longest first
+func longestWaits(entries []PoolEntry, now time.Time) []int {
+	waits := make([]int, len(entries))
+	for i, entry := range entries {
+		waits[i] = int(entry.WaitTime(now).Seconds())
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(waits)))
+
+	return waits[:min(len(waits), longestWaitsReported)]
+}
+
+// skillWindowsOverlap tells whether the skill windows of the entries overlap before being widened
+func skillWindowsOverlap(entries []PoolEntry) bool {
+	lowest, highest := math.MinInt, math.MaxInt
+	for _, entry := range entries {
+		minMMR, maxMMR := entry.SkillWindow()
+		lowest, highest = max(lowest, minMMR), min(highest, maxMMR)
+	}
+
+	return lowest <= highest
+}
+
+// mapPreferencesShared tells whether every entry preferring maps of the pool shares one with the others
+func mapPreferencesShared(entries []PoolEntry, pool []string) bool {
+	var shared []string
+	for _, entry := range entries {
+		preferred := entry.PreferredMaps(pool)
+		if len(preferred) == 0 {
+			continue
+		}
+
+		if shared == nil {
+			shared = preferred
+			continue
+		}
+
+		shared = slices.DeleteFunc(slices.Clone(shared), func(m string) bool { return !slices.Contains(preferred, m) })
+		if len(shared) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// QualitySummary sums up the quality reports of a set of matches
+type QualitySummary struct {
+	Matches                       int                `json:"matches"` // reporting their quality; matches made before reports were kept are left out
+	AverageScore                  float64            `json:"average_score"`
+	AverageMMRSpread              float64            `json:"average_mmr_spread"`
+	AverageTeamBalance            float64            `json:"average_team_balance"`
+	AverageMaxPing                float64            `json:"average_max_ping"`
+	AverageScheduleOverlapMinutes float64            `json:"average_schedule_overlap_minutes"` // over the matches reporting an overlap
+	AverageLongestWaitSeconds     float64            `json:"average_longest_wait_seconds"`     // of the longest-waiting party of each match
+	Relaxations                   map[Relaxation]int `json:"relaxations"`                      // matches that needed each relaxation
+}
+
+// SummarizeQuality sums up the quality reports of the pairs. Pairs whose ready check failed were never played, so they
+// are left out.
+func SummarizeQuality(pairs []*Pair) QualitySummary {
+	summary := QualitySummary{Relaxations: make(map[Relaxation]int)}
+
+	var score, spread, balance, ping, wait, overlap float64
+	overlaps := 0
+	for _, pair := range pairs {
+		if pair == nil || pair.Quality == nil || pair.CancelReason == CancelReasonReadyCheckFailed {
+			continue
+		}
+
+		report := pair.Quality
+		summary.Matches++
+
+		score += report.Score.Total
+		spread += float64(report.MMRSpread)
+		balance += float64(report.TeamBalance)
+		ping += float64(report.MaxPing)
+
+		if len(report.LongestWaitSeconds) > 0 {
+			wait += float64(report.LongestWaitSeconds[0])
+		}
+
+		if report.ScheduleOverlapMinutes != nil {
+			overlap += float64(*report.ScheduleOverlapMinutes)
+			overlaps++
+		}
+
+		for _, relaxation := range report.Relaxations {
+			summary.Relaxations[relaxation]++
+		}
+	}
+
+	if summary.Matches == 0 {
+		return summary
+	}
+
+	matches := float64(summary.Matches)
+	summary.AverageScore = score / matches
+	summary.AverageMMRSpread = spread / matches
+	summary.AverageTeamBalance = balance / matches
+	summary.AverageMaxPing = ping / matches
+	summary.AverageLongestWaitSeconds = wait / matches
+
+	if overlaps > 0 {
+		summary.AverageScheduleOverlapMinutes = overlap / float64(overlaps)
+	}
+
+	return summary
+}
@@ -0,0 +1,129 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	schedule_entities "github.com/leet-gaming/match-making-api/pkg/domain/schedules/entities"
+)
+
+func TestQualityModel_Report(t *testing.T) {
+	now := time.Now()
+
+	// waiting 3, 2 and 1 minutes
+	entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1100), teamEntry(1, 1300)}
+	for i := range entries {
+		entries[i].JoinedAt = now.Add(-time.Duration(3-i) * time.Minute)
+	}
+
+	teams := []pairing_entities.Team{{AverageMMR: 1050}, {AverageMMR: 1300}}
+
+	t.Run("Reports The Spread, Balance And Waits Of The Match", func(t *testing.T) {
+		report := pairing_entities.DefaultQualityModel.Report(entries, teams, pairing_entities.SelectionRules{}, now)
+
+		assert.Equal(t, 300, report.MMRSpread)
+		assert.Equal(t, 250, report.TeamBalance)
+		assert.Equal(t, []int{180, 120, 60}, report.LongestWaitSeconds)
+		assert.Zero(t, report.MaxPing)
+		assert.Nil(t, report.ScheduleOverlapMinutes)
+		assert.Empty(t, report.Relaxations)
+		assert.Equal(t, pairing_entities.DefaultQualityModel.Rate(entries, now, strictMaxPing).Total, report.Score.Total)
+	})
+
+	t.Run("Reports The Shortest Schedule Overlap", func(t *testing.T) {
+		model := pairing_entities.DefaultQualityModel
+		// 30 minutes per combination of options; the second party shares an hour with either of the others
+		model.OverlapMinutes = func(a, b schedule_entities.Schedule) int { return len(a.Options) * len(b.Options) * 30 }
+
+		scheduled := append([]pairing_entities.PoolEntry(nil), entries...)
+		scheduled[0].Criteria.Schedule = &schedule_entities.Schedule{Options: map[int]schedule_entities.DateOption{0: {}, 1: {}}}
+		scheduled[1].Criteria.Schedule = &schedule_entities.Schedule{Options: map[int]schedule_entities.DateOption{0: {}}}
+		scheduled[2].Criteria.Schedule = &schedule_entities.Schedule{Options: map[int]schedule_entities.DateOption{0: {}, 1: {}}}
+
+		report := model.Report(scheduled, nil, pairing_entities.SelectionRules{}, now)
+
+		require.NotNil(t, report.ScheduleOverlapMinutes)
+		assert.Equal(t, 60, *report.ScheduleOverlapMinutes)
+	})
+
+	t.Run("Reports The Relaxations The Match Needed", func(t *testing.T) {
+		relaxed := []pairing_entities.PoolEntry{
+			pinging(50, map[string]int{"eu": 80}),
+			pinging(50, map[string]int{"eu": 30}),
+		}
+
+		relaxed[0].JoinedAt, relaxed[1].JoinedAt = now.Add(-time.Minute), now.Add(-time.Minute)
+		relaxed[0].MMR, relaxed[1].MMR = 1000, 1300
+		relaxed[0].Criteria.SkillRange = &pairing_value_objects.SkillRange{MinMMR: 900, MaxMMR: 1100}
+		relaxed[1].Criteria.SkillRange = &pairing_value_objects.SkillRange{MinMMR: 1200, MaxMMR: 1400}
+		relaxed[0].Criteria.MapPreferences, relaxed[1].Criteria.MapPreferences = []string{"dust2"}, []string{"nuke"}
+		met(&relaxed[0], &relaxed[1])
+
+		rules := pairing_entities.SelectionRules{
+			Steps:     []game_entities.WindowExpansionStep{{AfterSeconds: 30, MMRDelta: 200, MaxPing: 100}},
+			Maps:      pairing_entities.MapRule{Patience: 30 * time.Second},
+			Rematches: pairing_entities.RematchRule{Penalty: 100},
+		}
+
+		report := pairing_entities.DefaultQualityModel.Report(relaxed, nil, rules, now)
+
+		assert.Equal(t, 80, report.MaxPing)
+		assert.Equal(t, []pairing_entities.Relaxation{
+			pairing_entities.RelaxedSkillWindow,
+			pairing_entities.RelaxedMaxPing,
+			pairing_entities.RelaxedMapPreferences,
+			pairing_entities.RelaxedRematch,
+		}, report.Relaxations)
+	})
+}
+
+func TestSummarizeQuality(t *testing.T) {
+	overlap := 90
+
+	pairs := []*pairing_entities.Pair{
+		{Quality: &pairing_entities.QualityReport{
+			Score:                  pairing_entities.MatchQuality{Total: 0.8},
+			MMRSpread:              100,
+			TeamBalance:            10,
+			MaxPing:                40,
+			ScheduleOverlapMinutes: &overlap,
+			LongestWaitSeconds:     []int{120, 60},
+			Relaxations:            []pairing_entities.Relaxation{pairing_entities.RelaxedSkillWindow},
+		}},
+		{Quality: &pairing_entities.QualityReport{
+			Score:              pairing_entities.MatchQuality{Total: 0.4},
+			MMRSpread:          300,
+			TeamBalance:        30,
+			MaxPing:            80,
+			LongestWaitSeconds: []int{240},
+			Relaxations:        []pairing_entities.Relaxation{pairing_entities.RelaxedSkillWindow, pairing_entities.RelaxedMaxPing},
+		}},
+		{}, // made before quality was reported
+		{ // never played
+			Quality:      &pairing_entities.QualityReport{Score: pairing_entities.MatchQuality{Total: 0.1}},
+			CancelReason: pairing_entities.CancelReasonReadyCheckFailed,
+		},
+	}
+
+	summary := pairing_entities.SummarizeQuality(pairs)
+
+	assert.Equal(t, 2, summary.Matches)
+	assert.InDelta(t, 0.6, summary.AverageScore, 0.001)
+	assert.InDelta(t, 200, summary.AverageMMRSpread, 0.001)
+	assert.InDelta(t, 20, summary.AverageTeamBalance, 0.001)
+	assert.InDelta(t, 60, summary.AverageMaxPing, 0.001)
+	assert.InDelta(t, 90, summary.AverageScheduleOverlapMinutes, 0.001)
+	assert.InDelta(t, 180, summary.AverageLongestWaitSeconds, 0.001)
+	assert.Equal(t, map[pairing_entities.Relaxation]int{
+		pairing_entities.RelaxedSkillWindow: 2,
+		pairing_entities.RelaxedMaxPing:     1,
+	}, summary.Relaxations)
+
+	assert.Zero(t, pairing_entities.SummarizeQuality(nil).Matches)
+}
@@ -0,0 +1,196 @@
+package entities
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+)
+
+// BlockingReason is why the matcher keeps two queued parties apart
+type BlockingReason string
+
+const (
+	BlockedBySchedule BlockingReason = "no_schedule_overlap" // their schedules share no availability
+	BlockedByMMRGap   BlockingReason = "mmr_gap"             // their skill windows do not overlap, even widened by their wait
+	BlockedByMaps     BlockingReason = "map_preferences"     // they prefer different maps, and one of them still holds out for its own
+	BlockedByRematch  BlockingReason = "recent_opponents"    // they faced each other recently, and the game mode excludes rematches
+	BlockedByPlayers  BlockingReason = "blocked_players"     // their players cannot be split into teams without facing or teaming up with players they blocked
+	BlockedByRegion   BlockingReason = "region_mismatch"     // they share no region under their MaxPing, even raised by their wait
+)
+
+// blockingReasons are every reason, in the order ties are listed in
+var blockingReasons = []BlockingReason{BlockedBySchedule, BlockedByMMRGap, BlockedByMaps, BlockedByRematch, BlockedByPlayers, BlockedByRegion}
+
+// Blocker is a reason keeping a queued party apart from other parties of its pool
+type Blocker struct {
+	Reason  BlockingReason `json:"reason"`
+	Parties int            `json:"parties"` // kept apart from the party for this reason
+}
+
+// QueueExplanation tells where a queued party stands in its pool and why it has not been matched yet
+type QueueExplanation struct {
+	PartyID       uuid.UUID                         `json:"party_id"`
+	PoolKey       string                            `json:"pool_key"`
+	Position      int                               `json:"position"` // 1 for the longest-waiting party
+	WaitedSeconds int                               `json:"waited_seconds"`
+	SkillWindow   *pairing_value_objects.SkillRange `json:"skill_window,omitempty"` // widened by the wait so far; nil when any MMR is accepted
+	MaxPing       int                               `json:"max_ping"`               // ms, raised by the wait so far; 0 when any ping is accepted
+	Candidates    int                               `json:"candidates"`             // other queued parties the party can be matched with
+	Blockers      []Blocker                         `json:"blockers"`               // most parties kept apart first
+}
+
+// QueueExplainer explains why queued parties are not matched yet, with the predicates the strategy groups them by.
+// Groups must also bring the right number of players, so compatible candidates do not always make a match.
+type QueueExplainer struct {
+	Strategy MatchStrategy
+	Steps    []game_entities.WindowExpansionStep // curve widening the party's windows with wait time
+}
+
+// Explain returns the explanation of the party waiting in the pool, at the given instant. Returns false when the
+// party is not queued in the pool.
+func (x QueueExplainer) Explain(pool *Pool, partyID uuid.UUID, now time.Time) (QueueExplanation, bool) {
+	entries := pool.QueuedEntries()
+
+	i := slices.IndexFunc(entries, func(e PoolEntry) bool { return e.PartyID == partyID })
+	if i < 0 {
+		return QueueExplanation{}, false
+	}
+
+	entry := entries[i]
+	explanation := QueueExplanation{
+		PartyID:       partyID,
+		PoolKey:       pool.Key,
+		Position:      i + 1,
+		WaitedSeconds: int(entry.WaitTime(now).Seconds()),
+		MaxPing:       entry.RelaxedMaxPing(now, x.Steps),
+		Blockers:      []Blocker{},
+	}
+
+	if minMMR, maxMMR := entry.RelaxedSkillWindow(now, x.Steps); minMMR != math.MinInt || maxMMR != math.MaxInt {
+		explanation.SkillWindow = &pairing_value_objects.SkillRange{MinMMR: minMMR, MaxMMR: maxMMR}
+	}
+
+	kept := make(map[BlockingReason]int)
+	for j, other := range entries {
+		if j == i {
+			continue
+		}
+
+		reasons := blockersOf(x.Strategy, entries, entry, other, now)
+		if len(reasons) == 0 {
+			explanation.Candidates++
+		}
+
+		for _, reason := range reasons {
+			kept[reason]++
+		}
+	}
+
+	for _, reason := range blockingReasons {
+		if kept[reason] > 0 {
+			explanation.Blockers = append(explanation.Blockers, Blocker{Reason: reason, Parties: kept[reason]})
+		}
+	}
+
+	sort.SliceStable(explanation.Blockers, func(a, b int) bool {
+		return explanation.Blockers[a].Parties > explanation.Blockers[b].Parties
+	})
+
+	return explanation, true
+}
+
+// blockersOf returns why the strategy keeps the two entries of the pool apart, under its rules at the given instant.
+// A composite strategy keeps them apart for the reasons every part does, since any part proposing them is enough.
+// Strategies this package does not know are not explained.
+func blockersOf(strategy MatchStrategy, entries []PoolEntry, a, b PoolEntry, now time.Time) []BlockingReason {
+	switch s := strategy.(type) {
+	case FIFOStrategy:
+		return s.Rules.at(now).blockers(entries, a, b, nil)
+	case SkillStrategy:
+		window := PoolEntry.SkillWindow
+		if len(s.Rules.Steps) > 0 {
+			window = func(e PoolEntry) (int, int) {
+				return e.RelaxedSkillWindow(now, s.Rules.Steps)
+			}
+		}
+
+		return s.Rules.at(now).blockers(entries, a, b, window)
+	case ScheduleStrategy:
+		rules := s.Rules.at(now)
+		if rules.schedules == nil {
+			rules.schedules = s.Model.Overlaps
+		}
+
+		return rules.blockers(entries, a, b, nil)
+	case CompositeStrategy:
+		var shared []BlockingReason
+		parts := 0
+		for _, part := range s.Parts {
+			if part.Weight <= 0 {
+				continue
+			}
+
+			reasons := blockersOf(part.Strategy, entries, a, b, now)
+			if parts == 0 {
+				shared = reasons
+			} else {
+				shared = slices.DeleteFunc(shared, func(r BlockingReason) bool { return !slices.Contains(reasons, r) })
+			}
+
+			parts++
+		}
+
+		return shared
+	}
+
+	return nil
+}
+
+// blockers returns why the rules keep the two entries of the pool apart: the predicates a groupBuilder adds parties
+// by. Skill windows are only compared when given a window.
+func (r groupRules) blockers(entries []PoolEntry, a, b PoolEntry, window func(PoolEntry) (int, int)) []BlockingReason {
+	var reasons []BlockingReason
+
+	g := groupBuilder{groupRules: r, members: []PoolEntry{a}, maps: mapAgreement{rule: r.maps, now: r.now}}
+	g.maps.add(a)
+
+	if !g.overlaps(b) {
+		reasons = append(reasons, BlockedBySchedule)
+	}
+
+	if window != nil {
+		minA, maxA := window(a)
+		minB, maxB := window(b)
+		if max(minA, minB) > min(maxA, maxB) {
+			reasons = append(reasons, BlockedByMMRGap)
+		}
+	}
+
+	if !g.maps.accepts(b) {
+		reasons = append(reasons, BlockedByMaps)
+	}
+
+	if r.rematch.in(entries).Exclude && a.rematches(b) {
+		reasons = append(reasons, BlockedByRematch)
+	}
+
+	if a.conflictWith(b) != 0 && !r.layout.splits([]PoolEntry{a, b}, false) {
+		reasons = append(reasons, BlockedByPlayers)
+	}
+
+	regions, ok := intersectRegions(nil, a.EligibleRegions(g.pingLimit(a)))
+	if ok {
+		_, ok = intersectRegions(regions, b.EligibleRegions(g.pingLimit(b)))
+	}
+
+	if !ok {
+		reasons = append(reasons, BlockedByRegion)
+	}
+
+	return reasons
+}
@@ -0,0 +1,144 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	schedule_entities "github.com/leet-gaming/match-making-api/pkg/domain/schedules/entities"
+)
+
+func TestQueueExplainer_Explain(t *testing.T) {
+	now := time.Now()
+	steps := []game_entities.WindowExpansionStep{{AfterSeconds: 60, MMRDelta: 50, MaxPing: 100}}
+	layout := pairing_entities.TeamLayout{NumberOfTeams: 2}
+
+	// the explained party waited two minutes, the others just joined
+	party := withSkill(1000, 100)
+	party.Pings, party.Criteria.MaxPing, party.JoinedAt = map[string]int{"eu": 80}, 50, now.Add(-2*time.Minute)
+
+	others := []pairing_entities.PoolEntry{withSkill(1050, 100), withSkill(1500, 100), withSkill(1000, 100), withSkill(1000, 100), withSkill(1300, 50), withSkill(1000, 100), withSkill(1000, 100)}
+	for i := range others {
+		others[i].Pings, others[i].JoinedAt = map[string]int{"eu": 30}, now
+	}
+
+	compatible, distant, elsewhere, blocked, near, faced, holdout := others[0], others[1], others[2], others[3], others[4], others[5], others[6]
+	elsewhere.Pings = map[string]int{"na": 30}
+	blocking(&party, blocked, pairing_entities.BlockAlways)
+	party.RecentOpponents = []uuid.UUID{faced.PartyID}
+	party.Criteria.MapPreferences, holdout.Criteria.MapPreferences = []string{"mirage"}, []string{"dust"}
+
+	pool := newPool()
+	for _, entry := range []pairing_entities.PoolEntry{party, compatible, distant, elsewhere, blocked, near, faced, holdout} {
+		pool.Join(entry)
+	}
+
+	rules := pairing_entities.SelectionRules{
+		Layout:    layout,
+		Steps:     steps,
+		Maps:      pairing_entities.MapRule{Patience: 5 * time.Minute},
+		Rematches: pairing_entities.RematchRule{Exclude: true},
+	}
+
+	explainer := pairing_entities.QueueExplainer{
+		Strategy: pairing_entities.SkillStrategy{Rules: rules},
+		Steps:    steps,
+	}
+
+	t.Run("Tells Where The Party Stands", func(t *testing.T) {
+		explanation, ok := explainer.Explain(pool, party.PartyID, now)
+
+		require.True(t, ok)
+		assert.Equal(t, pool.Key, explanation.PoolKey)
+		assert.Equal(t, 1, explanation.Position)
+		assert.Equal(t, 120, explanation.WaitedSeconds)
+		assert.Equal(t, &pairing_value_objects.SkillRange{MinMMR: 850, MaxMMR: 1150}, explanation.SkillWindow)
+		assert.Equal(t, 100, explanation.MaxPing)
+	})
+
+	t.Run("Counts The Candidates And Why The Others Are Kept Apart", func(t *testing.T) {
+		explanation, _ := explainer.Explain(pool, party.PartyID, now)
+
+		assert.Equal(t, 1, explanation.Candidates)
+		assert.Equal(t, []pairing_entities.Blocker{
+			{Reason: pairing_entities.BlockedByMMRGap, Parties: 2},
+			{Reason: pairing_entities.BlockedByMaps, Parties: 1},
+			{Reason: pairing_entities.BlockedByRematch, Parties: 1},
+			{Reason: pairing_entities.BlockedByPlayers, Parties: 1},
+			{Reason: pairing_entities.BlockedByRegion, Parties: 1},
+		}, explanation.Blockers)
+	})
+
+	t.Run("Only Keeps Recent Opponents Apart When The Pool Can Afford It", func(t *testing.T) {
+		small := rules
+		small.Rematches.MinPoolSize = 100
+
+		explanation, _ := pairing_entities.QueueExplainer{Strategy: pairing_entities.SkillStrategy{Rules: small}, Steps: steps}.Explain(pool, party.PartyID, now)
+
+		assert.Equal(t, 2, explanation.Candidates)
+	})
+
+	t.Run("Only Compares Skill Windows When The Strategy Matches By Skill", func(t *testing.T) {
+		fifo := explainer
+		fifo.Strategy = pairing_entities.FIFOStrategy{Rules: rules}
+
+		explanation, _ := fifo.Explain(pool, party.PartyID, now)
+
+		assert.Equal(t, 3, explanation.Candidates)
+		assert.Equal(t, []pairing_entities.Blocker{
+			{Reason: pairing_entities.BlockedByMaps, Parties: 1},
+			{Reason: pairing_entities.BlockedByRematch, Parties: 1},
+			{Reason: pairing_entities.BlockedByPlayers, Parties: 1},
+			{Reason: pairing_entities.BlockedByRegion, Parties: 1},
+		}, explanation.Blockers)
+	})
+
+	t.Run("Returns False When The Party Is Not Queued", func(t *testing.T) {
+		_, ok := explainer.Explain(pool, uuid.New(), now)
+		assert.False(t, ok)
+	})
+}
+
+func TestQueueExplainer_Explain_Schedules(t *testing.T) {
+	now := time.Now()
+	morning, evening := &schedule_entities.Schedule{ID: uuid.New()}, &schedule_entities.Schedule{ID: uuid.New()}
+
+	entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1000), teamEntry(1, 1000)}
+	entries[0].Criteria.Schedule, entries[1].Criteria.Schedule, entries[2].Criteria.Schedule = morning, evening, morning
+
+	pool := newPool()
+	for _, entry := range entries {
+		pool.Join(entry)
+	}
+
+	model := pairing_entities.DefaultQualityModel
+	model.Overlaps = func(a, b schedule_entities.Schedule) bool { return a.ID == b.ID }
+
+	t.Run("Keeps Parties Whose Schedules Do Not Overlap Apart", func(t *testing.T) {
+		explainer := pairing_entities.QueueExplainer{Strategy: pairing_entities.ScheduleStrategy{Model: model}}
+
+		explanation, ok := explainer.Explain(pool, entries[0].PartyID, now)
+
+		require.True(t, ok)
+		assert.Equal(t, 1, explanation.Candidates)
+		assert.Equal(t, []pairing_entities.Blocker{{Reason: pairing_entities.BlockedBySchedule, Parties: 1}}, explanation.Blockers)
+	})
+
+	t.Run("Composite Strategies Only Keep Apart Parties Every Part Does", func(t *testing.T) {
+		explainer := pairing_entities.QueueExplainer{Strategy: pairing_entities.CompositeStrategy{Parts: []pairing_entities.WeightedStrategy{
+			{Strategy: pairing_entities.FIFOStrategy{Model: model}, Weight: 1},
+			{Strategy: pairing_entities.ScheduleStrategy{Model: model}, Weight: 1},
+		}}}
+
+		explanation, _ := explainer.Explain(pool, entries[0].PartyID, now)
+
+		assert.Equal(t, 2, explanation.Candidates)
+		assert.Empty(t, explanation.Blockers)
+	})
+}
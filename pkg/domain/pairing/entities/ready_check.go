@@ -0,0 +1,166 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReadyCheckStatus string
+
+const (
+	ReadyCheckPending  ReadyCheckStatus = "pending"
+	ReadyCheckAccepted ReadyCheckStatus = "accepted"
+	ReadyCheckFailed   ReadyCheckStatus = "failed"
+)
+
+var (
+	ErrReadyCheckClosed     = errors.New("ready check is no longer pending")
+	ErrNotInReadyCheck      = errors.New("player is not part of the ready check")
+	ErrReadyCheckNotStarted = errors.New("pair has no ready check")
+)
+
+// ReadyCheck holds a pair until every matched player accepts it. A party accepts once all of its players do.
+// The check fails as soon as a player declines, or when it expires with players still pending.
+type ReadyCheck struct {
+	Status    ReadyCheckStatus `json:"status" bson:"status"`
+	Entries   []PoolEntry      `json:"-" bson:"entries"` // as taken from the pool, to put them back when the check fails
+	Accepted  []uuid.UUID      `json:"accepted_player_ids" bson:"accepted_player_ids"`
+	Declined  []uuid.UUID      `json:"declined_player_ids,omitempty" bson:"declined_player_ids,omitempty"`
+	StartedAt time.Time        `json:"started_at" bson:"started_at"`
+	ExpiresAt time.Time        `json:"expires_at" bson:"expires_at"`
+}
+
+// NewReadyCheck starts a ready check for the matched entries, expiring after the given window
+func NewReadyCheck(entries []PoolEntry, now time.Time, window time.Duration) *ReadyCheck {
+	return &ReadyCheck{
+		Status:    ReadyCheckPending,
+		Entries:   append([]PoolEntry(nil), entries...),
+		Accepted:  []uuid.UUID{},
+		StartedAt: now,
+		ExpiresAt: now.Add(window),
+	}
+}
+
+// Respond records the player's answer. An answer arriving after the deadline is not recorded: it fails the check.
+func (r *ReadyCheck) Respond(playerID uuid.UUID, accepted bool, now time.Time) error {
+	if r.Status != ReadyCheckPending {
+		return fmt.Errorf("ReadyCheck.Respond: %w", ErrReadyCheckClosed)
+	}
+
+	if !slices.ContainsFunc(r.Entries, func(e PoolEntry) bool { return slices.Contains(e.Players(), playerID) }) {
+		return fmt.Errorf("ReadyCheck.Respond: player %v: %w", playerID, ErrNotInReadyCheck)
+	}
+
+	if r.Expire(now) {
+		return nil
+	}
+
+	if !accepted {
+		r.Declined = append(r.Declined, playerID)
+		r.Status = ReadyCheckFailed
+		return nil
+	}
+
+	if !slices.Contains(r.Accepted, playerID) {
+		r.Accepted = append(r.Accepted, playerID)
+	}
+
+	if r.everyoneAccepted() {
+		r.Status = ReadyCheckAccepted
+	}
+
+	return nil
+}
+
+// Expire fails the check when it is still pending past its deadline. Returns whether it did.
+func (r *ReadyCheck) Expire(now time.Time) bool {
+	if r.Status != ReadyCheckPending || now.Before(r.ExpiresAt) {
+		return false
+	}
+
+	r.Status = ReadyCheckFailed
+
+	return true
+}
+
+// Returning lists the entries going back to the pool after a failed check: when someone declined, every party but
+// the decliners'; when the check expired, the parties that had fully accepted.
+func (r *ReadyCheck) Returning() []PoolEntry {
+	var returning []PoolEntry
+	for _, entry := range r.Entries {
+		if r.keeps(entry) {
+			returning = append(returning, entry)
+		}
+	}
+
+	return returning
+}
+
+// Dropped lists the entries removed from matchmaking after a failed check, the complement of Returning
+func (r *ReadyCheck) Dropped() []PoolEntry {
+	var dropped []PoolEntry
+	for _, entry := range r.Entries {
+		if !r.keeps(entry) {
+			dropped = append(dropped, entry)
+		}
+	}
+
+	return dropped
+}
+
+// Offenders lists the players to blame for a failed check: the ones who declined, or when the check expired, the
+// ones who never accepted
+func (r *ReadyCheck) Offenders() []uuid.UUID {
+	if r.Status != ReadyCheckFailed {
+		return nil
+	}
+
+	if len(r.Declined) > 0 {
+		return r.Declined
+	}
+
+	var offenders []uuid.UUID
+	for _, entry := range r.Entries {
+		for _, pid := range entry.Players() {
+			if !slices.Contains(r.Accepted, pid) {
+				offenders = append(offenders, pid)
+			}
+		}
+	}
+
+	return offenders
+}
+
+func (r *ReadyCheck) keeps(entry PoolEntry) bool {
+	if r.Status != ReadyCheckFailed {
+		return false
+	}
+
+	for _, pid := range entry.Players() {
+		if slices.Contains(r.Declined, pid) {
+			return false
+		}
+
+		if len(r.Declined) == 0 && !slices.Contains(r.Accepted, pid) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *ReadyCheck) everyoneAccepted() bool {
+	for _, entry := range r.Entries {
+		for _, pid := range entry.Players() {
+			if !slices.Contains(r.Accepted, pid) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
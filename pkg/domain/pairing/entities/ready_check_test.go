@@ -0,0 +1,93 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+)
+
+func TestReadyCheck(t *testing.T) {
+	now := time.Now()
+
+	newCheck := func() (*pairing_entities.ReadyCheck, pairing_entities.PoolEntry, pairing_entities.PoolEntry, pairing_entities.PoolEntry) {
+		duo := pairing_entities.PoolEntry{PartyID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+		solo := pairing_entities.PoolEntry{PartyID: uuid.New()}
+		other := pairing_entities.PoolEntry{PartyID: uuid.New()}
+
+		return pairing_entities.NewReadyCheck([]pairing_entities.PoolEntry{duo, solo, other}, now, 20*time.Second), duo, solo, other
+	}
+
+	t.Run("Is Accepted Once Every Player Accepts", func(t *testing.T) {
+		check, duo, solo, other := newCheck()
+
+		for _, pid := range []uuid.UUID{duo.PlayerIDs[0], solo.PartyID, other.PartyID} {
+			require.NoError(t, check.Respond(pid, true, now))
+		}
+
+		assert.Equal(t, pairing_entities.ReadyCheckPending, check.Status, "the duo is only ready when both players are")
+
+		require.NoError(t, check.Respond(duo.PlayerIDs[1], true, now))
+
+		assert.Equal(t, pairing_entities.ReadyCheckAccepted, check.Status)
+		assert.Empty(t, check.Returning())
+	})
+
+	t.Run("Fails When A Player Declines, Returning Everyone Else", func(t *testing.T) {
+		check, duo, solo, other := newCheck()
+
+		require.NoError(t, check.Respond(solo.PartyID, true, now))
+		require.NoError(t, check.Respond(duo.PlayerIDs[1], false, now))
+
+		assert.Equal(t, pairing_entities.ReadyCheckFailed, check.Status)
+		assert.Equal(t, []uuid.UUID{solo.PartyID, other.PartyID}, pairing_entities.PartyIDs(check.Returning()))
+		assert.Equal(t, []uuid.UUID{duo.PartyID}, pairing_entities.PartyIDs(check.Dropped()))
+		assert.Equal(t, []uuid.UUID{duo.PlayerIDs[1]}, check.Offenders())
+	})
+
+	t.Run("Expires Dropping The Parties That Did Not Accept", func(t *testing.T) {
+		check, duo, solo, other := newCheck()
+
+		require.NoError(t, check.Respond(duo.PlayerIDs[0], true, now))
+		require.NoError(t, check.Respond(solo.PartyID, true, now))
+
+		assert.False(t, check.Expire(now.Add(19*time.Second)))
+		assert.True(t, check.Expire(now.Add(20*time.Second)))
+
+		assert.Equal(t, pairing_entities.ReadyCheckFailed, check.Status)
+		assert.Equal(t, []uuid.UUID{solo.PartyID}, pairing_entities.PartyIDs(check.Returning()))
+		assert.Equal(t, []uuid.UUID{duo.PartyID, other.PartyID}, pairing_entities.PartyIDs(check.Dropped()))
+		assert.Equal(t, []uuid.UUID{duo.PlayerIDs[1], other.PartyID}, check.Offenders())
+	})
+
+	t.Run("Late Answers Fail The Check Instead Of Being Recorded", func(t *testing.T) {
+		check, _, solo, _ := newCheck()
+
+		require.NoError(t, check.Respond(solo.PartyID, true, now.Add(time.Minute)))
+
+		assert.Equal(t, pairing_entities.ReadyCheckFailed, check.Status)
+		assert.Empty(t, check.Accepted)
+	})
+
+	t.Run("Rejects Players Outside The Pair", func(t *testing.T) {
+		check, _, _, _ := newCheck()
+
+		err := check.Respond(uuid.New(), true, now)
+
+		assert.ErrorIs(t, err, pairing_entities.ErrNotInReadyCheck)
+		assert.Equal(t, pairing_entities.ReadyCheckPending, check.Status)
+	})
+
+	t.Run("Rejects Answers Once Closed", func(t *testing.T) {
+		check, _, solo, other := newCheck()
+		require.NoError(t, check.Respond(solo.PartyID, false, now))
+
+		err := check.Respond(other.PartyID, true, now)
+
+		assert.ErrorIs(t, err, pairing_entities.ErrReadyCheckClosed)
+	})
+}
@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RematchRule makes selectors keep apart parties who faced each other recently (see PoolEntry.RecentOpponents), as
+// long as the pool holds enough parties to match them with others. The zero RematchRule lets them meet again.
+type RematchRule struct {
+	Exclude     bool // recent opponents are never grouped; otherwise groups holding them are penalized
+	Penalty     int  // MMR added to the spread of a group picked by skill, per two recent opponents it holds
+	MinPoolSize int  // entries the pool must hold for rematches to be avoided
+}
+
+// in returns the rule as it applies to the entries of a pool: the zero rule when the pool is too small to afford
+// avoiding rematches, or nobody in it met anybody recently
+func (r RematchRule) in(entries []PoolEntry) RematchRule {
+	if (!r.Exclude && r.Penalty <= 0) || len(entries) < r.MinPoolSize {
+		return RematchRule{}
+	}
+
+	if !slices.ContainsFunc(entries, func(e PoolEntry) bool { return len(e.RecentOpponents) > 0 }) {
+		return RematchRule{}
+	}
+
+	return r
+}
+
+// penalizes reports whether groups holding recent opponents can be picked, at a cost
+func (r RematchRule) penalizes() bool {
+	return !r.Exclude && r.Penalty > 0
+}
+
+// rematches tells whether either party faced the other recently
+func (e PoolEntry) rematches(other PoolEntry) bool {
+	return slices.Contains(e.RecentOpponents, other.PartyID) || slices.Contains(other.RecentOpponents, e.PartyID)
+}
+
+// RecentOpponents returns the parties the party faced in its lastMatches latest matches, or in matches created since
+// the given time. Either bound is ignored when zero. Cancelled matches were never played, so they do not count.
+// Parties in the same team as the party were not faced; when the match has no teams, every other party was.
+func RecentOpponents(partyID uuid.UUID, pairs []*Pair, lastMatches int, since time.Time) []uuid.UUID {
+	played := make([]*Pair, 0, len(pairs))
+	for _, pair := range pairs {
+		if pair != nil && pair.Lifecycle() != MatchCancelled {
+			played = append(played, pair)
+		}
+	}
+
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].CreatedAt.After(played[j].CreatedAt)
+	})
+
+	var opponents []uuid.UUID
+	for i, pair := range played {
+		if (lastMatches <= 0 || i >= lastMatches) && (since.IsZero() || pair.CreatedAt.Before(since)) {
+			continue
+		}
+
+		for _, opponent := range pair.opponentsOf(partyID) {
+			if !slices.Contains(opponents, opponent) {
+				opponents = append(opponents, opponent)
+			}
+		}
+	}
+
+	return opponents
+}
+
+// opponentsOf returns the parties of the match the party faced
+func (p *Pair) opponentsOf(partyID uuid.UUID) []uuid.UUID {
+	var opponents []uuid.UUID
+	if len(p.Teams) == 0 {
+		for id := range p.Match {
+			if id != partyID {
+				opponents = append(opponents, id)
+			}
+		}
+
+		return opponents
+	}
+
+	for _, team := range p.Teams {
+		if !slices.Contains(team.PartyIDs, partyID) {
+			opponents = append(opponents, team.PartyIDs...)
+		}
+	}
+
+	return opponents
+}
@@ -0,0 +1,106 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/parties/entities"
+)
+
+// played returns a match between the parties created at the given time, without teams
+func played(at time.Time, partyIDs ...uuid.UUID) *pairing_entities.Pair {
+	pair := pairing_entities.NewPair(len(partyIDs), common.ResourceOwner{})
+	pair.CreatedAt = at
+	for _, partyID := range partyIDs {
+		pair.Match[partyID] = &entities.Party{}
+	}
+
+	return pair
+}
+
+// met makes the entries recent opponents of each other
+func met(a, b *pairing_entities.PoolEntry) {
+	a.RecentOpponents = append(a.RecentOpponents, b.PartyID)
+	b.RecentOpponents = append(b.RecentOpponents, a.PartyID)
+}
+
+func TestRecentOpponents(t *testing.T) {
+	now := time.Now()
+	partyID, first, second, third := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	pairs := []*pairing_entities.Pair{
+		played(now.Add(-2*time.Hour), partyID, first),
+		played(now.Add(-time.Minute), partyID, third),
+		played(now.Add(-time.Hour), partyID, second),
+	}
+
+	t.Run("Looks Back On The Latest Matches", func(t *testing.T) {
+		assert.ElementsMatch(t, []uuid.UUID{third, second}, pairing_entities.RecentOpponents(partyID, pairs, 2, time.Time{}))
+	})
+
+	t.Run("Looks Back In Time", func(t *testing.T) {
+		assert.ElementsMatch(t, []uuid.UUID{third}, pairing_entities.RecentOpponents(partyID, pairs, 0, now.Add(-10*time.Minute)))
+	})
+
+	t.Run("Counts Matches Within Either Bound", func(t *testing.T) {
+		assert.ElementsMatch(t, []uuid.UUID{third, second}, pairing_entities.RecentOpponents(partyID, pairs, 1, now.Add(-90*time.Minute)))
+	})
+
+	t.Run("Skips Cancelled Matches", func(t *testing.T) {
+		cancelled := played(now, partyID, first)
+		cancelled.Status = pairing_entities.MatchCancelled
+
+		assert.ElementsMatch(t, []uuid.UUID{third}, pairing_entities.RecentOpponents(partyID, append(pairs, cancelled), 1, time.Time{}))
+	})
+
+	t.Run("Skips Teammates", func(t *testing.T) {
+		pair := played(now, partyID, first, second)
+		pair.Teams = []pairing_entities.Team{
+			{PartyIDs: []uuid.UUID{partyID, first}},
+			{PartyIDs: []uuid.UUID{second}},
+		}
+
+		assert.Equal(t, []uuid.UUID{second}, pairing_entities.RecentOpponents(partyID, []*pairing_entities.Pair{pair}, 1, time.Time{}))
+	})
+}
+
+func TestSelectors_AvoidRematches(t *testing.T) {
+	// the two oldest parties met recently; the third one met nobody
+	entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1010), teamEntry(1, 1200)}
+	met(&entries[0], &entries[1])
+
+	t.Run("Excludes Groups Of Recent Opponents", func(t *testing.T) {
+		rules := pairing_entities.SelectionRules{Rematches: pairing_entities.RematchRule{Exclude: true}}
+
+		assert.Equal(t, []int{0, 2}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+		assert.Equal(t, []int{1, 2}, pairing_entities.SelectWith(pairing_entities.SkillStrategy{Rules: rules})(entries, 2))
+		assert.Nil(t, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries[:2], 2))
+	})
+
+	t.Run("Picks Recent Opponents Last In FIFO Order When Penalized", func(t *testing.T) {
+		rules := pairing_entities.SelectionRules{Rematches: pairing_entities.RematchRule{Penalty: 100}}
+
+		assert.Equal(t, []int{0, 2}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries[:2], 2))
+	})
+
+	t.Run("Adds The Penalty To The Spread Of Skill Groups", func(t *testing.T) {
+		// 10 MMR apart plus the penalty is still closer than the 190 MMR of the next group
+		rules := pairing_entities.SelectionRules{Rematches: pairing_entities.RematchRule{Penalty: 100}}
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectWith(pairing_entities.SkillStrategy{Rules: rules})(entries, 2))
+
+		rules.Rematches.Penalty = 200
+		assert.Equal(t, []int{1, 2}, pairing_entities.SelectWith(pairing_entities.SkillStrategy{Rules: rules})(entries, 2))
+	})
+
+	t.Run("Lets Recent Opponents Meet In Small Pools", func(t *testing.T) {
+		rules := pairing_entities.SelectionRules{Rematches: pairing_entities.RematchRule{Exclude: true, MinPoolSize: 4}}
+
+		assert.Equal(t, []int{0, 1}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 2))
+	})
+}
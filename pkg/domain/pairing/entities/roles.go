@@ -0,0 +1,166 @@
+package entities
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// roleSeat is a seat of a team: one of the players a role quota asks for, or a seat left over by the quotas ("")
+// that a player of any role can take
+type roleSeat string
+
+// roleSeating seats the players of a team in its role seats, as a bipartite matching of players to the seats of the
+// roles they accept. Players accepting no role in particular can take any seat.
+type roleSeating struct {
+	players []uuid.UUID
+	accepts [][]string
+	seats   []roleSeat
+
+	seatOf   []int // seat taken by each player, -1 while standing
+	playerOf []int // player sitting in each seat, -1 while free
+}
+
+// seatRoles returns the role of every player of the team's entries, when they can all be seated in the layout's role
+// seats. When complete is true, every seat a quota reserves must be taken and the team must reach its minimum.
+// Players sitting in a seat left over by the quotas play the first role they accept, if any.
+func (l TeamLayout) seatRoles(team []PoolEntry, complete bool) (map[uuid.UUID]string, bool) {
+	s := roleSeating{}
+	for _, entry := range team {
+		players := entry.Players()
+		for _, playerID := range players {
+			s.players = append(s.players, playerID)
+			s.accepts = append(s.accepts, entry.AcceptedRoles(playerID))
+		}
+
+		// players of the party not known by ID accept any role
+		for range entry.PlayerCount() - len(players) {
+			s.players = append(s.players, uuid.Nil)
+			s.accepts = append(s.accepts, nil)
+		}
+	}
+
+	reserved := 0
+	for _, quota := range l.Roles {
+		for range quota.Count {
+			s.seats = append(s.seats, roleSeat(strings.TrimSpace(quota.Role)))
+		}
+
+		reserved += quota.Count
+	}
+
+	free := len(s.players)
+	if l.MaxPlayersPerTeam > 0 {
+		free = max(0, l.MaxPlayersPerTeam-reserved)
+	}
+
+	for range free {
+		s.seats = append(s.seats, "")
+	}
+
+	if len(s.players) > len(s.seats) || (complete && len(s.players) < l.minPlayers()) {
+		return nil, false
+	}
+
+	s.seatOf = filled(len(s.players), -1)
+	s.playerOf = filled(len(s.seats), -1)
+
+	// seats reserved by quotas are taken first; seating the remaining players afterwards only moves the players
+	// already sitting to other seats, so reserved seats stay taken
+	if complete {
+		for seat := 0; seat < reserved; seat++ {
+			if !s.takeSeat(seat, make([]bool, len(s.players))) {
+				return nil, false
+			}
+		}
+	}
+
+	for player := range s.players {
+		if s.seatOf[player] == -1 && !s.seatPlayer(player, make([]bool, len(s.seats))) {
+			return nil, false
+		}
+	}
+
+	roles := make(map[uuid.UUID]string, len(s.players))
+	for player, seat := range s.seatOf {
+		switch {
+		case s.players[player] == uuid.Nil:
+		case s.seats[seat] != "":
+			roles[s.players[player]] = string(s.seats[seat])
+		case len(s.accepts[player]) > 0:
+			roles[s.players[player]] = s.accepts[player][0]
+		}
+	}
+
+	return roles, true
+}
+
+// seatsRoles reports whether the team's players can all be seated in the layout's role seats, see seatRoles. Teams
+// always can when the layout has no role quotas.
+func (l TeamLayout) seatsRoles(team []PoolEntry, complete bool) bool {
+	if len(l.Roles) == 0 {
+		return true
+	}
+
+	_, ok := l.seatRoles(team, complete)
+
+	return ok
+}
+
+// canTake reports whether the player accepts the seat
+func (s *roleSeating) canTake(player, seat int) bool {
+	if s.seats[seat] == "" || len(s.accepts[player]) == 0 {
+		return true
+	}
+
+	for _, role := range s.accepts[player] {
+		if strings.EqualFold(strings.TrimSpace(role), string(s.seats[seat])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// seatPlayer finds a seat for the standing player, moving seated players to other seats they accept when needed
+func (s *roleSeating) seatPlayer(player int, visited []bool) bool {
+	for seat := range s.seats {
+		if visited[seat] || !s.canTake(player, seat) {
+			continue
+		}
+
+		visited[seat] = true
+		if s.playerOf[seat] == -1 || s.seatPlayer(s.playerOf[seat], visited) {
+			s.seatOf[player], s.playerOf[seat] = seat, player
+			return true
+		}
+	}
+
+	return false
+}
+
+// takeSeat finds a player for the free seat, moving seated players to other seats they accept when needed
+func (s *roleSeating) takeSeat(seat int, visited []bool) bool {
+	for player := range s.players {
+		if visited[player] || !s.canTake(player, seat) {
+			continue
+		}
+
+		visited[player] = true
+		if s.seatOf[player] == -1 || s.takeSeat(s.seatOf[player], visited) {
+			s.seatOf[player], s.playerOf[seat] = seat, player
+			return true
+		}
+	}
+
+	return false
+}
+
+func filled(n, value int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = value
+	}
+
+	return values
+}
@@ -0,0 +1,128 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+)
+
+// playing returns a solo party whose player accepts the given roles
+func playing(roles ...string) pairing_entities.PoolEntry {
+	entry := teamEntry(1, 1000)
+	if len(roles) > 0 {
+		entry.Roles = map[uuid.UUID][]string{entry.PlayerIDs[0]: roles}
+	}
+
+	return entry
+}
+
+// tankHealerDPS is a layout of two teams of three, each needing a tank, a healer and a dps
+var tankHealerDPS = pairing_entities.TeamLayout{
+	NumberOfTeams:     2,
+	MaxPlayersPerTeam: 3,
+	Roles: []game_entities.RoleQuota{
+		{Role: "tank", Count: 1},
+		{Role: "healer", Count: 1},
+		{Role: "dps", Count: 1},
+	},
+}
+
+func TestFormTeams_RoleQuotas(t *testing.T) {
+	t.Run("Fills Every Quota Of Every Team", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			playing("tank"), playing("tank"), playing("healer"), playing("Healer"), playing("dps"), playing(),
+		}
+
+		teams, err := pairing_entities.FormTeams(entries, tankHealerDPS)
+
+		require.NoError(t, err)
+		require.Len(t, teams, 2)
+
+		for _, team := range teams {
+			require.Len(t, team.Roles, 3)
+
+			roles := make(map[string]int)
+			for _, role := range team.Roles {
+				roles[role]++
+			}
+
+			assert.Equal(t, map[string]int{"tank": 1, "healer": 1, "dps": 1}, roles)
+		}
+	})
+
+	t.Run("Moves Flexible Players To The Roles Nobody Else Plays", func(t *testing.T) {
+		flex := playing("healer", "tank")
+		entries := []pairing_entities.PoolEntry{flex, playing("healer"), playing("dps")}
+		layout := tankHealerDPS
+		layout.NumberOfTeams = 1
+
+		teams, err := pairing_entities.FormTeams(entries, layout)
+
+		require.NoError(t, err)
+		assert.Equal(t, "tank", teams[0].Roles[flex.PlayerIDs[0]])
+		assert.Equal(t, "healer", teams[0].Roles[entries[1].PlayerIDs[0]])
+	})
+
+	t.Run("Seats Any Role In Seats Left Over By Quotas", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{playing("healer"), playing("healer"), playing("tank")}
+		layout := pairing_entities.TeamLayout{
+			NumberOfTeams:     1,
+			MaxPlayersPerTeam: 3,
+			Roles:             []game_entities.RoleQuota{{Role: "tank", Count: 1}},
+		}
+
+		teams, err := pairing_entities.FormTeams(entries, layout)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]string{
+			entries[0].PlayerIDs[0]: "healer",
+			entries[1].PlayerIDs[0]: "healer",
+			entries[2].PlayerIDs[0]: "tank",
+		}, teams[0].Roles)
+	})
+
+	t.Run("Rejects Teams Missing A Role", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			playing("tank"), playing("tank"), playing("healer"), playing("dps"), playing("dps"), playing("dps"),
+		}
+
+		_, err := pairing_entities.FormTeams(entries, tankHealerDPS)
+
+		assert.ErrorIs(t, err, pairing_entities.ErrNoTeamArrangement)
+	})
+
+	t.Run("Leaves Roles Unset Without Quotas", func(t *testing.T) {
+		teams, err := pairing_entities.FormTeams([]pairing_entities.PoolEntry{playing("tank"), playing("healer")}, pairing_entities.TeamLayout{NumberOfTeams: 2})
+
+		require.NoError(t, err)
+		for _, team := range teams {
+			assert.Nil(t, team.Roles)
+		}
+	})
+}
+
+func TestSelectors_FillRoleQuotas(t *testing.T) {
+	rules := pairing_entities.SelectionRules{Layout: tankHealerDPS}
+
+	t.Run("Skips Parties No Seat Is Left For", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			playing("tank"), playing("tank"), playing("tank"),
+			playing("healer"), playing("healer"), playing("dps"), playing("dps"),
+		}
+
+		assert.Equal(t, []int{0, 1, 3, 4, 5, 6}, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 6))
+	})
+
+	t.Run("Waits Until Every Quota Can Be Filled", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			playing("tank"), playing("tank"), playing("healer"), playing("dps"), playing("dps"), playing("dps"),
+		}
+
+		assert.Nil(t, pairing_entities.SelectWith(pairing_entities.FIFOStrategy{Rules: rules})(entries, 6))
+	})
+}
@@ -0,0 +1,296 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+)
+
+var (
+	ErrUnknownStrategy      = errors.New("unknown match strategy")
+	ErrUnknownStrategyParam = errors.New("unknown match strategy parameter")
+)
+
+// MatchCandidate is a group of queued entries a strategy proposes to match together, by queue index
+type MatchCandidate struct {
+	Indexes []int   // in queue order
+	Quality float64 // from 0, the worst match, to 1, the best
+}
+
+// MatchStrategy proposes the groups of entries adding up to qty players that can be matched together, each with the
+// quality of the match it would make, under the criteria of the entries. Candidates come in the order the strategy
+// prefers them; a greedy matcher takes the first one, a batch matcher weighs them all.
+type MatchStrategy interface {
+	Candidates(entries []PoolEntry, qty int) []MatchCandidate
+}
+
+// SelectWith returns the selector picking the group the strategy prefers
+func SelectWith(strategy MatchStrategy) GroupSelector {
+	return func(entries []PoolEntry, qty int) []int {
+		if candidates := strategy.Candidates(entries, qty); len(candidates) > 0 {
+			return candidates[0].Indexes
+		}
+
+		return nil
+	}
+}
+
+// FIFOStrategy proposes the group each entry can be matched in with the oldest parties that fit, in queue order.
+// When the rules penalize rematches, groups without recent opponents come first.
+type FIFOStrategy struct {
+	Rules SelectionRules
+	Model QualityModel
+}
+
+func (s FIFOStrategy) Candidates(entries []PoolEntry, qty int) []MatchCandidate {
+	return fifoCandidates(entries, qty, s.Rules.at(time.Now()), s.Model)
+}
+
+// SkillStrategy proposes the groups whose skill windows all overlap, closest MMR first. Ties are broken in favor of
+// the group holding the longest-waiting entry.
+type SkillStrategy struct {
+	Rules SelectionRules
+	Model QualityModel
+}
+
+func (s SkillStrategy) Candidates(entries []PoolEntry, qty int) []MatchCandidate {
+	if qty <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rules := s.Rules.at(now)
+	rules.rematch = rules.rematch.in(entries)
+
+	window := PoolEntry.SkillWindow
+	if len(s.Rules.Steps) > 0 {
+		window = func(e PoolEntry) (int, int) {
+			return e.RelaxedSkillWindow(now, s.Rules.Steps)
+		}
+	}
+
+	groups := skillGroups(entries, qty, window, rules)
+
+	// the spread is the distance between the lowest and highest MMR of the group, plus the rematch penalty of every
+	// two recent opponents in it
+	spread := func(g groupBuilder) int {
+		return entries[g.indexes[len(g.indexes)-1]].MMR - entries[g.indexes[0]].MMR + g.rematches*rules.rematch.Penalty
+	}
+
+	sort.SliceStable(groups, func(a, b int) bool {
+		if spread(groups[a]) != spread(groups[b]) {
+			return spread(groups[a]) < spread(groups[b])
+		}
+
+		return oldest(groups[a].indexes) < oldest(groups[b].indexes)
+	})
+
+	return candidatesOf(groups, rules, s.Model)
+}
+
+// ScheduleStrategy works like FIFOStrategy, but only groups parties whose schedules overlap under the model.
+// Parties without schedule play whenever the others do.
+type ScheduleStrategy struct {
+	Rules SelectionRules
+	Model QualityModel
+}
+
+func (s ScheduleStrategy) Candidates(entries []PoolEntry, qty int) []MatchCandidate {
+	rules := s.Rules.at(time.Now())
+	if rules.schedules == nil {
+		rules.schedules = s.Model.Overlaps
+	}
+
+	return fifoCandidates(entries, qty, rules, s.Model)
+}
+
+// WeightedStrategy is a part of a CompositeStrategy, along with its weight
+type WeightedStrategy struct {
+	Strategy MatchStrategy
+	Weight   float64
+}
+
+// CompositeStrategy proposes every group its parts propose, best first. The quality of a group is the weighted
+// average of the qualities its parts rate it, a part that does not propose the group rating it 0. Ties keep the
+// order the parts proposed the groups in.
+type CompositeStrategy struct {
+	Parts []WeightedStrategy
+}
+
+func (s CompositeStrategy) Candidates(entries []PoolEntry, qty int) []MatchCandidate {
+	var candidates []MatchCandidate
+	var total float64
+	for _, part := range s.Parts {
+		if part.Weight <= 0 {
+			continue
+		}
+
+		total += part.Weight
+		for _, proposed := range part.Strategy.Candidates(entries, qty) {
+			i := slices.IndexFunc(candidates, func(c MatchCandidate) bool { return slices.Equal(c.Indexes, proposed.Indexes) })
+			if i < 0 {
+				candidates = append(candidates, MatchCandidate{Indexes: proposed.Indexes})
+				i = len(candidates) - 1
+			}
+
+			candidates[i].Quality += part.Weight * proposed.Quality
+		}
+	}
+
+	for i := range candidates {
+		candidates[i].Quality /= total
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].Quality > candidates[b].Quality
+	})
+
+	return candidates
+}
+
+// fifoCandidates returns the FIFO groups of the entries under the rules, in queue order. Groups holding recent
+// opponents are only proposed after the groups that could be formed without them, since FIFO does not rank groups.
+func fifoCandidates(entries []PoolEntry, qty int, rules groupRules, model QualityModel) []MatchCandidate {
+	if qty <= 0 {
+		return nil
+	}
+
+	rules.rematch = rules.rematch.in(entries)
+	if !rules.rematch.penalizes() {
+		return candidatesOf(fifoGroups(entries, qty, rules), rules, model)
+	}
+
+	strict := rules
+	strict.rematch.Exclude = true
+
+	return candidatesOf(append(fifoGroups(entries, qty, strict), fifoGroups(entries, qty, rules)...), rules, model)
+}
+
+// candidatesOf rates the distinct groups, in the order given
+func candidatesOf(groups []groupBuilder, rules groupRules, model QualityModel) []MatchCandidate {
+	var candidates []MatchCandidate
+	for _, group := range groups {
+		indexes := slices.Clone(group.indexes)
+		sort.Ints(indexes)
+
+		if slices.ContainsFunc(candidates, func(c MatchCandidate) bool { return slices.Equal(c.Indexes, indexes) }) {
+			continue
+		}
+
+		quality := model.rate(group.members, group.rematches*rules.rematch.Penalty, rules.now, group.pingLimit)
+		candidates = append(candidates, MatchCandidate{Indexes: indexes, Quality: quality.Total})
+	}
+
+	return candidates
+}
+
+// StrategyBase is what every strategy of a game mode is built upon: the rules its groups must follow, and the model
+// rating them before the strategy's own parameters apply
+type StrategyBase struct {
+	Rules SelectionRules
+	Model QualityModel
+}
+
+// StrategyFactory builds the strategy the settings name, upon the base. The registry builds the parts of composite
+// strategies.
+type StrategyFactory func(registry *StrategyRegistry, settings game_entities.MatchStrategySettings, base StrategyBase) (MatchStrategy, error)
+
+// StrategyRegistry knows the strategies game modes can name, by name
+type StrategyRegistry struct {
+	mu        sync.RWMutex
+	factories map[string]StrategyFactory
+}
+
+// NewStrategyRegistry returns a registry knowing the fifo, skill, schedule and composite strategies
+func NewStrategyRegistry() *StrategyRegistry {
+	registry := &StrategyRegistry{factories: make(map[string]StrategyFactory)}
+
+	registry.Register(game_entities.MatchStrategyFIFO, func(_ *StrategyRegistry, _ game_entities.MatchStrategySettings, base StrategyBase) (MatchStrategy, error) {
+		return FIFOStrategy(base), nil
+	})
+
+	registry.Register(game_entities.MatchStrategySkill, func(_ *StrategyRegistry, _ game_entities.MatchStrategySettings, base StrategyBase) (MatchStrategy, error) {
+		return SkillStrategy(base), nil
+	})
+
+	registry.Register(game_entities.MatchStrategySchedule, func(_ *StrategyRegistry, _ game_entities.MatchStrategySettings, base StrategyBase) (MatchStrategy, error) {
+		return ScheduleStrategy(base), nil
+	})
+
+	registry.Register(game_entities.MatchStrategyComposite, func(r *StrategyRegistry, settings game_entities.MatchStrategySettings, base StrategyBase) (MatchStrategy, error) {
+		composite := CompositeStrategy{Parts: make([]WeightedStrategy, 0, len(settings.Parts))}
+		for _, part := range settings.Parts {
+			strategy, err := r.Build(part.MatchStrategySettings, base)
+			if err != nil {
+				return nil, err
+			}
+
+			composite.Parts = append(composite.Parts, WeightedStrategy{Strategy: strategy, Weight: part.Weight})
+		}
+
+		return composite, nil
+	})
+
+	return registry
+}
+
+// Register makes the strategy available to game modes under the name, replacing any strategy registered under it
+func (r *StrategyRegistry) Register(name string, factory StrategyFactory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.factories[name] = factory
+}
+
+// Knows tells whether a strategy is registered under the name
+func (r *StrategyRegistry) Knows(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.factories[name]
+
+	return ok
+}
+
+// Build returns the strategy the settings name, upon the base model tuned by the settings' parameters
+func (r *StrategyRegistry) Build(settings game_entities.MatchStrategySettings, base StrategyBase) (MatchStrategy, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[settings.Name]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownStrategy, settings.Name)
+	}
+
+	model, err := base.Model.with(settings.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	base.Model = model
+
+	return factory(r, settings, base)
+}
+
+// with returns the model tuned by the parameters of a strategy
+func (m QualityModel) with(params map[string]float64) (QualityModel, error) {
+	for param, value := range params {
+		switch param {
+		case game_entities.MatchStrategyParamMMRSpread:
+			m.MMRSpread = int(value)
+		case game_entities.MatchStrategyParamWaitSeconds:
+			m.Wait = time.Duration(value * float64(time.Second))
+		case game_entities.MatchStrategyParamMaxPing:
+			m.Ping = int(value)
+		default:
+			return m, fmt.Errorf("%w: %s", ErrUnknownStrategyParam, param)
+		}
+	}
+
+	return m, nil
+}
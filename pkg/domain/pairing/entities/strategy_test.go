@@ -0,0 +1,143 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	schedule_entities "github.com/leet-gaming/match-making-api/pkg/domain/schedules/entities"
+)
+
+// indexesOf returns the groups of the candidates, in order
+func indexesOf(candidates []pairing_entities.MatchCandidate) [][]int {
+	groups := make([][]int, len(candidates))
+	for i, candidate := range candidates {
+		groups[i] = candidate.Indexes
+	}
+
+	return groups
+}
+
+func TestMatchStrategies(t *testing.T) {
+	model := pairing_entities.DefaultQualityModel
+	entries := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1400), teamEntry(1, 1010)}
+
+	t.Run("FIFO Proposes Groups In Queue Order", func(t *testing.T) {
+		candidates := pairing_entities.FIFOStrategy{Model: model}.Candidates(entries, 2)
+
+		assert.Equal(t, [][]int{{0, 1}, {0, 2}}, indexesOf(candidates))
+		assert.Equal(t, pairing_entities.SelectFIFO(entries, 2), pairing_entities.SelectWith(pairing_entities.FIFOStrategy{})(entries, 2))
+	})
+
+	t.Run("Skill Proposes The Closest Groups First", func(t *testing.T) {
+		candidates := pairing_entities.SkillStrategy{Model: model}.Candidates(entries, 2)
+
+		require.NotEmpty(t, candidates)
+		assert.Equal(t, []int{0, 2}, candidates[0].Indexes)
+		assert.Greater(t, candidates[0].Quality, candidates[len(candidates)-1].Quality)
+		assert.Equal(t, pairing_entities.SelectBySkill(entries, 2), pairing_entities.SelectWith(pairing_entities.SkillStrategy{})(entries, 2))
+	})
+
+	t.Run("Schedule Keeps Parties Whose Schedules Do Not Overlap Apart", func(t *testing.T) {
+		morning, evening := &schedule_entities.Schedule{ID: uuid.New()}, &schedule_entities.Schedule{ID: uuid.New()}
+
+		scheduled := []pairing_entities.PoolEntry{teamEntry(1, 1000), teamEntry(1, 1000), teamEntry(1, 1000)}
+		scheduled[0].Criteria.Schedule, scheduled[1].Criteria.Schedule, scheduled[2].Criteria.Schedule = morning, evening, morning
+
+		overlapping := model
+		overlapping.Overlaps = func(a, b schedule_entities.Schedule) bool { return a.ID == b.ID }
+
+		candidates := pairing_entities.ScheduleStrategy{Model: overlapping}.Candidates(scheduled, 2)
+
+		assert.Equal(t, [][]int{{0, 2}}, indexesOf(candidates))
+	})
+
+	t.Run("Composite Weighs The Groups Of Its Parts", func(t *testing.T) {
+		composite := pairing_entities.CompositeStrategy{Parts: []pairing_entities.WeightedStrategy{
+			{Strategy: pairing_entities.FIFOStrategy{Model: model}, Weight: 1},
+			{Strategy: pairing_entities.SkillStrategy{Model: model}, Weight: 3},
+		}}
+
+		fifo := pairing_entities.FIFOStrategy{Model: model}.Candidates(entries, 2)
+
+		candidates := composite.Candidates(entries, 2)
+
+		// both parts propose the closest group; only FIFO proposes the farthest one, so it rates a quarter of it
+		assert.Equal(t, [][]int{{0, 2}, {1, 2}, {0, 1}}, indexesOf(candidates))
+		assert.InDelta(t, fifo[1].Quality, candidates[0].Quality, 0.001)
+		assert.InDelta(t, fifo[0].Quality/4, candidates[2].Quality, 0.001)
+	})
+}
+
+func TestStrategyRegistry_Build(t *testing.T) {
+	registry := pairing_entities.NewStrategyRegistry()
+	base := pairing_entities.StrategyBase{Model: pairing_entities.DefaultQualityModel}
+
+	t.Run("Builds The Built-In Strategies", func(t *testing.T) {
+		for _, name := range []string{game_entities.MatchStrategyFIFO, game_entities.MatchStrategySkill, game_entities.MatchStrategySchedule} {
+			strategy, err := registry.Build(game_entities.MatchStrategySettings{Name: name}, base)
+
+			require.NoError(t, err, name)
+			assert.NotNil(t, strategy, name)
+		}
+	})
+
+	t.Run("Builds The Parts Of Composite Strategies", func(t *testing.T) {
+		strategy, err := registry.Build(game_entities.MatchStrategySettings{
+			Name: game_entities.MatchStrategyComposite,
+			Parts: []game_entities.WeightedMatchStrategy{
+				{MatchStrategySettings: game_entities.MatchStrategySettings{Name: game_entities.MatchStrategyFIFO}, Weight: 1},
+				{MatchStrategySettings: game_entities.MatchStrategySettings{Name: game_entities.MatchStrategySkill, Params: map[string]float64{"mmr_spread": 100}}, Weight: 2},
+			},
+		}, base)
+
+		require.NoError(t, err)
+
+		composite, ok := strategy.(pairing_entities.CompositeStrategy)
+		require.True(t, ok)
+		require.Len(t, composite.Parts, 2)
+		assert.Equal(t, 100, composite.Parts[1].Strategy.(pairing_entities.SkillStrategy).Model.MMRSpread)
+		assert.Equal(t, 2.0, composite.Parts[1].Weight)
+	})
+
+	t.Run("Tunes The Model With The Parameters", func(t *testing.T) {
+		strategy, err := registry.Build(game_entities.MatchStrategySettings{
+			Name:   game_entities.MatchStrategyFIFO,
+			Params: map[string]float64{"mmr_spread": 250, "wait_seconds": 30, "max_ping": 80},
+		}, base)
+
+		require.NoError(t, err)
+
+		model := strategy.(pairing_entities.FIFOStrategy).Model
+		assert.Equal(t, 250, model.MMRSpread)
+		assert.Equal(t, 80, model.Ping)
+		assert.Equal(t, 30.0, model.Wait.Seconds())
+	})
+
+	t.Run("Rejects Unknown Strategies And Parameters", func(t *testing.T) {
+		_, err := registry.Build(game_entities.MatchStrategySettings{Name: "elo"}, base)
+		assert.ErrorIs(t, err, pairing_entities.ErrUnknownStrategy)
+
+		_, err = registry.Build(game_entities.MatchStrategySettings{Name: game_entities.MatchStrategyFIFO, Params: map[string]float64{"k": 1}}, base)
+		assert.ErrorIs(t, err, pairing_entities.ErrUnknownStrategyParam)
+	})
+
+	t.Run("Builds Registered Strategies", func(t *testing.T) {
+		registry.Register("newest", func(_ *pairing_entities.StrategyRegistry, _ game_entities.MatchStrategySettings, base pairing_entities.StrategyBase) (pairing_entities.MatchStrategy, error) {
+			return pairing_entities.FIFOStrategy(base), nil
+		})
+
+		_, err := registry.Build(game_entities.MatchStrategySettings{Name: "newest"}, base)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Knows The Strategies It Builds", func(t *testing.T) {
+		assert.True(t, registry.Knows(game_entities.MatchStrategyComposite))
+		assert.True(t, registry.Knows("newest"))
+		assert.False(t, registry.Knows("elo"))
+	})
+}
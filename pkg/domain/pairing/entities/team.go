@@ -0,0 +1,407 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+)
+
+// maxExhaustiveTeamAssignments bounds the number of party-to-team assignments FormTeams tries before falling back
+// to a greedy assignment
+const maxExhaustiveTeamAssignments = 1 << 16
+
+var ErrNoTeamArrangement = errors.New("parties cannot be arranged into the requested teams")
+
+// Team is one side of a pair. Parties are never split between teams.
+type Team struct {
+	ID         uuid.UUID            `json:"id" bson:"id"`
+	Name       string               `json:"name" bson:"name"`
+	PartyIDs   []uuid.UUID          `json:"party_ids" bson:"party_ids"`
+	PlayerIDs  []uuid.UUID          `json:"player_ids" bson:"player_ids"`
+	AverageMMR int                  `json:"average_mmr" bson:"average_mmr"`         // weighted by party size
+	Roles      map[uuid.UUID]string `json:"roles,omitempty" bson:"roles,omitempty"` // role each player plays, when the game mode has role quotas
+}
+
+// TeamLayout describes how many teams a match has, how many players each one takes and the roles they must play
+type TeamLayout struct {
+	NumberOfTeams     int
+	MinPlayersPerTeam int                       // 0 means at least one player
+	MaxPlayersPerTeam int                       // 0 means no limit
+	Roles             []game_entities.RoleQuota // players each team needs in each role; empty when roles do not matter
+}
+
+// minPlayers returns the fewest players a team may have
+func (l TeamLayout) minPlayers() int {
+	return max(1, l.MinPlayersPerTeam)
+}
+
+// fits reports whether parties of the given sizes can be seated in the layout's teams without splitting any of them.
+// When complete is false, teams only need to stay within MaxPlayersPerTeam, so more parties may still be added;
+// when it is true, every team must also reach its minimum.
+func (l TeamLayout) fits(sizes []int, complete bool) bool {
+	if l.NumberOfTeams <= 0 {
+		return true
+	}
+
+	if complete && len(sizes) < l.NumberOfTeams {
+		return false
+	}
+
+	if l.MaxPlayersPerTeam <= 0 {
+		// unbounded teams: one party per team, the rest anywhere
+		return !complete || l.minPlayers() == 1 || l.fitsBounded(sizes, math.MaxInt, complete)
+	}
+
+	return l.fitsBounded(sizes, l.MaxPlayersPerTeam, complete)
+}
+
+// fitsBounded seats the biggest parties first, backtracking when a party has no team left with room for it
+func (l TeamLayout) fitsBounded(sizes []int, capacity int, complete bool) bool {
+	ordered := append([]int(nil), sizes...)
+	sort.Sort(sort.Reverse(sort.IntSlice(ordered)))
+
+	players := make([]int, l.NumberOfTeams)
+
+	var seat func(i int) bool
+	seat = func(i int) bool {
+		if i == len(ordered) {
+			if !complete {
+				return true
+			}
+
+			for _, p := range players {
+				if p < l.minPlayers() {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		tried := make(map[int]bool, len(players))
+		for team := range players {
+			// teams holding the same number of players are interchangeable
+			if tried[players[team]] || players[team] > capacity-ordered[i] {
+				continue
+			}
+
+			tried[players[team]] = true
+			players[team] += ordered[i]
+			if seat(i + 1) {
+				return true
+			}
+			players[team] -= ordered[i]
+		}
+
+		return false
+	}
+
+	return seat(0)
+}
+
+// FormTeams splits the matched entries into the layout's teams without breaking parties apart, nor putting players in
+// the same team or in opposing teams when one blocked the other as teammate or opponent. When the layout has role
+// quotas, every team must fill them with players accepting the roles, and the role of each player is set on their
+// team. Among the valid arrangements it picks the one with the most even player counts and then the smallest
+// difference between the highest and lowest team average MMR.
+func FormTeams(entries []PoolEntry, layout TeamLayout) ([]Team, error) {
+	if layout.NumberOfTeams <= 0 {
+		return nil, fmt.Errorf("FormTeams: invalid number of teams %d", layout.NumberOfTeams)
+	}
+
+	if len(entries) < layout.NumberOfTeams {
+		return nil, fmt.Errorf("FormTeams: %d parties cannot fill %d teams: %w", len(entries), layout.NumberOfTeams, ErrNoTeamArrangement)
+	}
+
+	conflicts := blockConflicts(entries)
+
+	var assignment []int
+	if math.Pow(float64(layout.NumberOfTeams), float64(len(entries)-1)) <= maxExhaustiveTeamAssignments {
+		assignment = bestTeamAssignment(entries, layout, conflicts)
+	} else {
+		assignment = greedyTeamAssignment(entries, layout, conflicts)
+	}
+
+	if assignment == nil && (conflicts != nil || len(layout.Roles) > 0) {
+		// the greedy placement knows nothing of blocks and roles; any arrangement honoring them beats none
+		assignment = layout.split(entries, true)
+	}
+
+	if assignment == nil {
+		return nil, fmt.Errorf("FormTeams: %w", ErrNoTeamArrangement)
+	}
+
+	return buildTeams(entries, assignment, layout), nil
+}
+
+// teamScore ranks arrangements: lower player count spread first, then lower average MMR spread
+type teamScore struct {
+	playerSpread int
+	mmrSpread    float64
+}
+
+func (s teamScore) betterThan(other teamScore) bool {
+	if s.playerSpread != other.playerSpread {
+		return s.playerSpread < other.playerSpread
+	}
+
+	return s.mmrSpread < other.mmrSpread
+}
+
+// scoreAssignment returns the score of a complete assignment, or false when a team is out of bounds, cannot fill
+// its role quotas or puts players together or against each other despite their blocks
+func scoreAssignment(entries []PoolEntry, assignment []int, layout TeamLayout, conflicts [][]blockConflict) (teamScore, bool) {
+	players := make([]int, layout.NumberOfTeams)
+	mmr := make([]int, layout.NumberOfTeams)
+
+	for i, team := range assignment {
+		players[team] += entries[i].PlayerCount()
+		mmr[team] += entries[i].MMR * entries[i].PlayerCount()
+	}
+
+	minPlayers := max(1, layout.MinPlayersPerTeam)
+	fewest, most := math.MaxInt, 0
+	lowest, highest := math.Inf(1), math.Inf(-1)
+
+	for team := range players {
+		if players[team] < minPlayers || (layout.MaxPlayersPerTeam > 0 && players[team] > layout.MaxPlayersPerTeam) {
+			return teamScore{}, false
+		}
+
+		average := float64(mmr[team]) / float64(players[team])
+		fewest, most = min(fewest, players[team]), max(most, players[team])
+		lowest, highest = math.Min(lowest, average), math.Max(highest, average)
+	}
+
+	for i, team := range assignment {
+		if !allowsTeam(conflicts, assignment, i, team) {
+			return teamScore{}, false
+		}
+	}
+
+	if len(layout.Roles) > 0 {
+		for _, team := range teamEntries(entries, assignment, layout.NumberOfTeams) {
+			if !layout.seatsRoles(team, true) {
+				return teamScore{}, false
+			}
+		}
+	}
+
+	return teamScore{playerSpread: most - fewest, mmrSpread: highest - lowest}, true
+}
+
+// split returns an assignment of the entries to the layout's teams that honors their blocks and lets every team seat
+// its players in its role seats, or nil when there is none. When complete is false, teams only need to stay within
+// MaxPlayersPerTeam, so more entries may still be added; when it is true, every team must also reach its minimum and
+// fill its quotas.
+func (l TeamLayout) split(entries []PoolEntry, complete bool) []int {
+	conflicts := blockConflicts(entries)
+
+	capacity := l.MaxPlayersPerTeam
+	if capacity <= 0 {
+		capacity = math.MaxInt
+	}
+
+	assignment := make([]int, len(entries))
+	teams := make([][]PoolEntry, l.NumberOfTeams)
+	players := make([]int, l.NumberOfTeams)
+
+	var seat func(i int) bool
+	seat = func(i int) bool {
+		if i == len(entries) {
+			if !complete {
+				return true
+			}
+
+			for t, team := range teams {
+				if players[t] < l.minPlayers() {
+					return false
+				}
+
+				if !l.seatsRoles(team, true) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		size := entries[i].PlayerCount()
+		for t := range teams {
+			// empty teams are interchangeable
+			if t > 0 && len(teams[t-1]) == 0 {
+				break
+			}
+
+			if players[t] > capacity-size || !allowsTeam(conflicts, assignment, i, t) {
+				continue
+			}
+
+			assignment[i] = t
+			teams[t] = append(teams[t], entries[i])
+			players[t] += size
+
+			if l.seatsRoles(teams[t], false) && seat(i+1) {
+				return true
+			}
+
+			teams[t] = teams[t][:len(teams[t])-1]
+			players[t] -= size
+		}
+
+		return false
+	}
+
+	if !seat(0) {
+		return nil
+	}
+
+	return assignment
+}
+
+// splits reports whether the entries can be split into the layout's teams honoring their blocks and role quotas,
+// see split. Layouts without role quotas always can when no entry avoids another.
+func (l TeamLayout) splits(entries []PoolEntry, complete bool) bool {
+	if l.NumberOfTeams <= 0 || (len(l.Roles) == 0 && blockConflicts(entries) == nil) {
+		return true
+	}
+
+	return l.split(entries, complete) != nil
+}
+
+// teamEntries groups the entries by the team they are assigned to
+func teamEntries(entries []PoolEntry, assignment []int, numberOfTeams int) [][]PoolEntry {
+	teams := make([][]PoolEntry, numberOfTeams)
+	for i, team := range assignment {
+		teams[team] = append(teams[team], entries[i])
+	}
+
+	return teams
+}
+
+// bestTeamAssignment tries every assignment of parties to teams. The first party always goes to the first team,
+// since team order does not matter.
+func bestTeamAssignment(entries []PoolEntry, layout TeamLayout, conflicts [][]blockConflict) []int {
+	var best []int
+	var bestScore teamScore
+
+	assignment := make([]int, len(entries))
+	players := make([]int, layout.NumberOfTeams)
+
+	var assign func(i int)
+	assign = func(i int) {
+		if i == len(entries) {
+			score, ok := scoreAssignment(entries, assignment, layout, conflicts)
+			if ok && (best == nil || score.betterThan(bestScore)) {
+				best = append([]int(nil), assignment...)
+				bestScore = score
+			}
+			return
+		}
+
+		teams := layout.NumberOfTeams
+		if i == 0 {
+			teams = 1
+		}
+
+		for team := 0; team < teams; team++ {
+			if layout.MaxPlayersPerTeam > 0 && players[team]+entries[i].PlayerCount() > layout.MaxPlayersPerTeam {
+				continue
+			}
+
+			assignment[i] = team
+			players[team] += entries[i].PlayerCount()
+			assign(i + 1)
+			players[team] -= entries[i].PlayerCount()
+		}
+	}
+
+	assign(0)
+
+	return best
+}
+
+// greedyTeamAssignment places the biggest, then highest rated, parties first, each into the team with the fewest
+// players (and lowest total MMR on ties) that still has room for it
+func greedyTeamAssignment(entries []PoolEntry, layout TeamLayout, conflicts [][]blockConflict) []int {
+	order := make([]int, len(entries))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		if entries[order[a]].PlayerCount() != entries[order[b]].PlayerCount() {
+			return entries[order[a]].PlayerCount() > entries[order[b]].PlayerCount()
+		}
+
+		return entries[order[a]].MMR > entries[order[b]].MMR
+	})
+
+	assignment := make([]int, len(entries))
+	players := make([]int, layout.NumberOfTeams)
+	mmr := make([]int, layout.NumberOfTeams)
+
+	for _, i := range order {
+		chosen := -1
+		for team := range players {
+			if layout.MaxPlayersPerTeam > 0 && players[team]+entries[i].PlayerCount() > layout.MaxPlayersPerTeam {
+				continue
+			}
+
+			if chosen == -1 || players[team] < players[chosen] || (players[team] == players[chosen] && mmr[team] < mmr[chosen]) {
+				chosen = team
+			}
+		}
+
+		if chosen == -1 {
+			return nil
+		}
+
+		assignment[i] = chosen
+		players[chosen] += entries[i].PlayerCount()
+		mmr[chosen] += entries[i].MMR * entries[i].PlayerCount()
+	}
+
+	if _, ok := scoreAssignment(entries, assignment, layout, conflicts); !ok {
+		return nil
+	}
+
+	return assignment
+}
+
+func buildTeams(entries []PoolEntry, assignment []int, layout TeamLayout) []Team {
+	teams := make([]Team, layout.NumberOfTeams)
+	players := make([]int, layout.NumberOfTeams)
+	mmr := make([]int, layout.NumberOfTeams)
+
+	for i := range teams {
+		teams[i] = Team{
+			ID:   uuid.New(),
+			Name: fmt.Sprintf("Team %d", i+1),
+		}
+	}
+
+	for i, team := range assignment {
+		entry := entries[i]
+		teams[team].PartyIDs = append(teams[team].PartyIDs, entry.PartyID)
+		teams[team].PlayerIDs = append(teams[team].PlayerIDs, entry.Players()...)
+		players[team] += entry.PlayerCount()
+		mmr[team] += entry.MMR * entry.PlayerCount()
+	}
+
+	for i := range teams {
+		teams[i].AverageMMR = int(math.Round(float64(mmr[i]) / float64(players[i])))
+	}
+
+	if len(layout.Roles) > 0 {
+		for i, team := range teamEntries(entries, assignment, layout.NumberOfTeams) {
+			teams[i].Roles, _ = layout.seatRoles(team, true)
+		}
+	}
+
+	return teams
+}
@@ -0,0 +1,123 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+)
+
+func teamEntry(players, mmr int) pairing_entities.PoolEntry {
+	entry := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: mmr}
+	for i := 0; i < players; i++ {
+		entry.PlayerIDs = append(entry.PlayerIDs, uuid.New())
+	}
+
+	return entry
+}
+
+// teamOf maps every party to the index of its team
+func teamOf(teams []pairing_entities.Team) map[uuid.UUID]int {
+	byParty := make(map[uuid.UUID]int)
+	for i, team := range teams {
+		for _, pid := range team.PartyIDs {
+			byParty[pid] = i
+		}
+	}
+
+	return byParty
+}
+
+func TestFormTeams(t *testing.T) {
+	t.Run("Minimizes The Average MMR Difference", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{
+			teamEntry(1, 2000),
+			teamEntry(1, 1900),
+			teamEntry(1, 1100),
+			teamEntry(1, 1000),
+		}
+
+		teams, err := pairing_entities.FormTeams(entries, pairing_entities.TeamLayout{NumberOfTeams: 2, MaxPlayersPerTeam: 2})
+
+		require.NoError(t, err)
+		require.Len(t, teams, 2)
+
+		byParty := teamOf(teams)
+		assert.Equal(t, byParty[entries[0].PartyID], byParty[entries[3].PartyID])
+		assert.Equal(t, byParty[entries[1].PartyID], byParty[entries[2].PartyID])
+		assert.Equal(t, 1500, teams[0].AverageMMR)
+		assert.Equal(t, 1500, teams[1].AverageMMR)
+	})
+
+	t.Run("Never Splits A Party", func(t *testing.T) {
+		stack := teamEntry(3, 1500)
+		entries := []pairing_entities.PoolEntry{stack, teamEntry(2, 1400), teamEntry(1, 1600)}
+
+		teams, err := pairing_entities.FormTeams(entries, pairing_entities.TeamLayout{NumberOfTeams: 2, MaxPlayersPerTeam: 3})
+
+		require.NoError(t, err)
+
+		stackTeam := teams[teamOf(teams)[stack.PartyID]]
+		assert.Equal(t, []uuid.UUID{stack.PartyID}, stackTeam.PartyIDs)
+		assert.Equal(t, stack.PlayerIDs, stackTeam.PlayerIDs)
+
+		for _, team := range teams {
+			assert.Len(t, team.PlayerIDs, 3)
+		}
+	})
+
+	t.Run("Weights The Average By Party Size", func(t *testing.T) {
+		duo := teamEntry(2, 1000)
+		entries := []pairing_entities.PoolEntry{duo, teamEntry(1, 1300), teamEntry(1, 1100), teamEntry(1, 1000)}
+
+		teams, err := pairing_entities.FormTeams(entries, pairing_entities.TeamLayout{NumberOfTeams: 2})
+
+		require.NoError(t, err)
+
+		// the duo counts twice: duo + 1300 averages 1100, against 1050 for the two remaining solos
+		duoTeam := teams[teamOf(teams)[duo.PartyID]]
+		assert.Equal(t, 1100, duoTeam.AverageMMR)
+		assert.Len(t, duoTeam.PlayerIDs, 3)
+	})
+
+	t.Run("Uses The Party ID For Solo Entries Without Players", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{{PartyID: uuid.New()}, {PartyID: uuid.New()}}
+
+		teams, err := pairing_entities.FormTeams(entries, pairing_entities.TeamLayout{NumberOfTeams: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, entries[0].Players(), teams[0].PlayerIDs)
+		assert.Equal(t, entries[1].Players(), teams[1].PlayerIDs)
+	})
+
+	t.Run("Falls Back To A Greedy Assignment For Large Groups", func(t *testing.T) {
+		var entries []pairing_entities.PoolEntry
+		for i := 0; i < 20; i++ {
+			entries = append(entries, teamEntry(1, 1000+i*50))
+		}
+
+		teams, err := pairing_entities.FormTeams(entries, pairing_entities.TeamLayout{NumberOfTeams: 2, MaxPlayersPerTeam: 10})
+
+		require.NoError(t, err)
+		assert.Len(t, teams[0].PlayerIDs, 10)
+		assert.Len(t, teams[1].PlayerIDs, 10)
+		assert.InDelta(t, teams[0].AverageMMR, teams[1].AverageMMR, 50)
+	})
+
+	t.Run("Fails When No Arrangement Fits The Layout", func(t *testing.T) {
+		entries := []pairing_entities.PoolEntry{teamEntry(3, 1500), teamEntry(1, 1500)}
+
+		_, err := pairing_entities.FormTeams(entries, pairing_entities.TeamLayout{NumberOfTeams: 2, MaxPlayersPerTeam: 2})
+
+		assert.ErrorIs(t, err, pairing_entities.ErrNoTeamArrangement)
+	})
+
+	t.Run("Fails With Fewer Parties Than Teams", func(t *testing.T) {
+		_, err := pairing_entities.FormTeams([]pairing_entities.PoolEntry{teamEntry(5, 1500)}, pairing_entities.TeamLayout{NumberOfTeams: 2})
+
+		assert.ErrorIs(t, err, pairing_entities.ErrNoTeamArrangement)
+	})
+}
@@ -8,8 +8,10 @@ import (
 	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
 )
 
+// PairCreator creates the pair of the parties out of the draft prepared by the matcher (teams, region, map, quality,
+// ready check...), so the pair is stored whole the first time it is saved
 type PairCreator interface {
-	Execute(ctx context.Context, pids []uuid.UUID) (*pairing_entities.Pair, error)
+	Execute(ctx context.Context, pids []uuid.UUID, draft *pairing_entities.Pair) (*pairing_entities.Pair, error)
 }
 
 type PoolInitiator interface {
@@ -2,6 +2,7 @@ package pairing_out
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
@@ -14,11 +15,16 @@ type PoolWriter interface {
 
 type PairWriter interface {
 	Save(p *pairing_entities.Pair) (*pairing_entities.Pair, error)
+	// Update saves the pair only when nobody saved it since it was read, failing with ErrPairChanged otherwise
+	Update(p *pairing_entities.Pair) (*pairing_entities.Pair, error)
 }
 
 type PairReader interface {
 	FindPairsByPartyID(ctx context.Context, partyID uuid.UUID) ([]*pairing_entities.Pair, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*pairing_entities.Pair, error)
+	FindExpiredReadyChecks(ctx context.Context, now time.Time) ([]*pairing_entities.Pair, error)
+	FindStartedBefore(ctx context.Context, gameID uuid.UUID, before time.Time) ([]*pairing_entities.Pair, error)
+	FindCreatedBetween(ctx context.Context, gameModeID *uuid.UUID, since, until time.Time) ([]*pairing_entities.Pair, error)
 }
 
 type InvitationWriter interface {
@@ -33,6 +39,7 @@ type InvitationReader interface {
 
 type PoolReader interface {
 	FindPool(criteria *pairing_value_objects.Criteria) (*pairing_entities.Pool, error)
+	ListPools() ([]*pairing_entities.Pool, error)
 }
 
 type ExternalInvitationWriter interface {
@@ -78,3 +85,27 @@ type UserNotificationPreferencesWriter interface {
 type UserNotificationPreferencesReader interface {
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*pairing_entities.UserNotificationPreferences, error)
 }
+
+type PenaltyWriter interface {
+	Save(ctx context.Context, penalty *pairing_entities.PlayerPenalty) (*pairing_entities.PlayerPenalty, error)
+	Delete(ctx context.Context, playerID uuid.UUID) error
+}
+
+type PenaltyReader interface {
+	// GetByPlayerID returns nil, without error, when the player has no penalty
+	GetByPlayerID(ctx context.Context, playerID uuid.UUID) (*pairing_entities.PlayerPenalty, error)
+	// FindCoolingDown returns the penalties still cooling down at the given time, restricted to the given players when any
+	FindCoolingDown(ctx context.Context, now time.Time, playerIDs ...uuid.UUID) ([]*pairing_entities.PlayerPenalty, error)
+	FindAll(ctx context.Context) ([]*pairing_entities.PlayerPenalty, error)
+}
+
+type PlayerBlockWriter interface {
+	Save(ctx context.Context, block *pairing_entities.PlayerBlock) (*pairing_entities.PlayerBlock, error)
+	// Delete fails with ErrPlayerBlockNotFound when the player did not block blockedID
+	Delete(ctx context.Context, playerID, blockedID uuid.UUID) error
+}
+
+type PlayerBlockReader interface {
+	// FindByPlayerIDs returns the blocks made by any of the given players
+	FindByPlayerIDs(ctx context.Context, playerIDs ...uuid.UUID) ([]*pairing_entities.PlayerBlock, error)
+}
@@ -2,9 +2,15 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	game_out "github.com/leet-gaming/match-making-api/pkg/domain/game/ports/out"
 	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
 	pairing_in "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/in"
 	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
@@ -12,6 +18,17 @@ import (
 	schedules_in_ports "github.com/leet-gaming/match-making-api/pkg/domain/schedules/ports/in"
 )
 
+const (
+	// DefaultNumberOfTeams is used when the game does not define how many teams a match has
+	DefaultNumberOfTeams = 2
+
+	// DefaultPairSize is the number of players in a match when neither the game nor the criteria define it
+	DefaultPairSize = 2
+)
+
+// defaultStrategies are the strategies game modes can name when the use case is given no registry
+var defaultStrategies = pairing_entities.NewStrategyRegistry()
+
 type AddAndFindNextPairUseCase struct {
 	PoolReader pairing_out.PoolReader
 	PoolWriter pairing_out.PoolWriter
@@ -20,14 +37,27 @@ type AddAndFindNextPairUseCase struct {
 	PoolInitiator       pairing_in.PoolInitiator
 	PairCreator         pairing_in.PairCreator
 	ScheduleMatcher     pairing_in.PartyScheduleMatcher
+	GameReader          game_out.GameReader     // Optional: if nil, parties are matched in FIFO order
+	GameModeReader      game_out.GameModeReader // Optional: if nil, skill windows are never relaxed and matches need no ready check
+	PairReader          pairing_out.PairReader  // Optional: if nil, parties who faced each other recently can be matched again right away
+
+	Strategies *pairing_entities.StrategyRegistry // Optional: if nil, game modes can only name the built-in strategies
 }
 
 type FindPairPayload struct {
-	PartyID  uuid.UUID // (always create a party, even if alone, easier to add someone to it if the user decides so in the middle of match making)
-	Criteria pairing_value_objects.Criteria
+	PartyID   uuid.UUID // (always create a party, even if alone, easier to add someone to it if the user decides so in the middle of match making)
+	PartySize int       // number of players in the party, defaults to 1
+	PlayerIDs []uuid.UUID
+	MMR       int
+	Pings     map[string]int                            // latency in ms, by region slug
+	JoinedAt  time.Time                                 // when the party started queueing, defaults to now
+	Backfill  bool                                      // the party accepts to replace players who left a match in progress
+	Roles     map[uuid.UUID][]string                    // roles each player accepts to play, by player; players missing can play any
+	Blocks    map[uuid.UUID]pairing_entities.BlockScope // players the party's players blocked, by blocked player
+	Criteria  pairing_value_objects.Criteria
 }
 
-func (uc *AddAndFindNextPairUseCase) Execute(p FindPairPayload) (*pairing_entities.Pair, *pairing_entities.Pool, int, error) {
+func (uc *AddAndFindNextPairUseCase) Execute(ctx context.Context, p FindPairPayload) (*pairing_entities.Pair, *pairing_entities.Pool, int, error) {
 	schedule := uc.PartyScheduleReader.GetScheduleByPartyID(p.PartyID)
 
 	p.Criteria.Schedule = schedule
@@ -45,27 +75,367 @@ func (uc *AddAndFindNextPairUseCase) Execute(p FindPairPayload) (*pairing_entiti
 		}
 	}
 
-	position := pool.Join(p.PartyID) // ADD: party Or peer. (Idempotent => wont dup if already enqueued)
-	uc.PoolWriter.Save(pool)
+	settings := uc.settingsFor(ctx, p.Criteria)
+
+	entry := pairing_entities.PoolEntry{
+		PartyID:   p.PartyID,
+		PlayerIDs: p.PlayerIDs,
+		JoinedAt:  p.JoinedAt,
+		Size:      p.PartySize,
+		MMR:       p.MMR,
+		Pings:     p.Pings,
+		Backfill:  p.Backfill,
+		Roles:     p.Roles,
+		Blocks:    p.Blocks,
+		Criteria:  p.Criteria,
+
+		RecentOpponents: uc.recentOpponents(ctx, p.PartyID, settings.RematchAvoidance),
+	}
+
+	position := pool.Join(entry) // ADD: party Or peer. (Idempotent => wont dup if already enqueued)
+	if _, err := uc.PoolWriter.Save(pool); err != nil {
+		return nil, nil, position, fmt.Errorf("AddAndFindNextPairUseCase.Execute: unable to save pool %v: %w", pool.Key, err)
+	}
+
+	// the party waits for the next batch of the pool, see MatchBatch
+	if settings.Batches() {
+		return nil, pool, position, nil
+	}
+
+	pair, pool, err := uc.FindNextPair(ctx, pool, p.Criteria) // FIND: equiv: pool.Dequeue(s, q)
+	if err != nil {
+		return nil, nil, position, fmt.Errorf("AddAndFindNextPairUseCase.Execute: %w", err)
+	}
+
+	return pair, pool, position, nil // send msg with position etc?
+}
+
+// FindNextPair tries to form a pair out of the parties already waiting in the pool, without adding anyone to it.
+// Returns a nil pair right away when no acceptable group is available yet; it never waits for parties to join.
+// The selected parties are split into the game's teams; when they cannot be arranged, or the pair cannot be created,
+// they go back to the pool.
+// The map is chosen by a weighted vote of the parties' preferred maps of the map pool (see ChooseMap).
+// When the game mode asks for a ready check, the pair is returned with a pending ReadyCheck and is not confirmed
+// until every player accepts it (see ReadyCheckUseCase). The pool is only saved when a pair is created.
+// Open backfill slots are not served here, see BackfillUseCase.FillSlots. Pools of game modes matching in batches
+// are not served here either, see MatchBatch.
+func (uc *AddAndFindNextPairUseCase) FindNextPair(ctx context.Context, pool *pairing_entities.Pool, c pairing_value_objects.Criteria) (*pairing_entities.Pair, *pairing_entities.Pool, error) {
+	settings := uc.settingsFor(ctx, c)
+	if settings.Batches() {
+		return nil, pool, nil
+	}
+
+	game := uc.gameFor(ctx, c)
+	layout := teamLayoutFor(game, settings)
+
+	mapPool := mapPoolFor(game, settings)
+
+	entries := pool.TryPeekWith(matchSizeFor(game, c), uc.selectorFor(ctx, game, settings, layout, mapPool))
+	if len(entries) == 0 {
+		return nil, pool, nil
+	}
+
+	pair, err := uc.pairUp(ctx, pool, c, entries, settings, layout, mapPool)
+	if err != nil {
+		pool.Requeue(entries...)
+		return nil, nil, err
+	}
+
+	if pair == nil {
+		return nil, pool, nil
+	}
+
+	pool, err = uc.PoolWriter.Save(pool)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to UPDATE pool for pair %v. Cannot update pool for parties %v, due to %w", pair, pairing_entities.PartyIDs(entries), err)
+	}
+
+	return pair, pool, nil
+}
+
+// MatchBatch matches every party waiting in the pool at once, when the game mode of the pool matches in batches and
+// the batch tick of the pool is due. The set of groups with the best total quality is taken out of the pool in one
+// go (see Pool.TakeBatch), then every group is paired up like FindNextPair does. Groups that cannot be arranged into
+// teams go back to the pool; when a pair cannot be created, neither are the groups left, which go back to the pool
+// too. The pool is saved once, after the batch. Returns the pairs created.
+func (uc *AddAndFindNextPairUseCase) MatchBatch(ctx context.Context, pool *pairing_entities.Pool) ([]*pairing_entities.Pair, *pairing_entities.Pool, error) {
+	c := pool.Criteria
+
+	settings := uc.settingsFor(ctx, c)
+	if !settings.Batches() {
+		return nil, pool, nil
+	}
+
+	game := uc.gameFor(ctx, c)
+	layout := teamLayoutFor(game, settings)
 
-	parties := pool.Peek(p.Criteria.PairSize) // FIND: equiv: pool.Dequeue(s, q)
+	mapPool := mapPoolFor(game, settings)
 
-	var pair *pairing_entities.Pair
+	groups := pool.TakeBatch(matchSizeFor(game, c), time.Now(), settings.BatchTick(), uc.batchSelectorFor(ctx, game, settings, layout, mapPool))
+	if len(groups) == 0 {
+		return nil, pool, nil
+	}
 
-	// if succesfuly dequeued
-	if len(parties) > 0 {
-		ctx := context.Background()
-		pair, err = uc.PairCreator.Execute(ctx, parties)
+	var pairs []*pairing_entities.Pair
+	var pairErr error
+	for i, entries := range groups {
+		pair, err := uc.pairUp(ctx, pool, c, entries, settings, layout, mapPool)
 		if err != nil {
-			return nil, nil, position, fmt.Errorf("AddAndFindNextPairUseCase.Execute: unable to CREATE pair. Cannot create pair for parties %v, due to %v", parties, err)
+			for _, left := range groups[i:] {
+				pool.Requeue(left...)
+			}
+
+			pairErr = fmt.Errorf("AddAndFindNextPairUseCase.MatchBatch: %w", err)
+			break
+		}
+
+		if pair != nil {
+			pairs = append(pairs, pair)
 		}
+	}
+
+	saved, err := uc.PoolWriter.Save(pool)
+	if err != nil {
+		return pairs, nil, errors.Join(pairErr, fmt.Errorf("AddAndFindNextPairUseCase.MatchBatch: unable to save pool %v: %w", pool.Key, err))
+	}
 
-		pool, err = uc.PoolWriter.Save(pool)
+	slog.InfoContext(ctx, "pool matched in batch", "pool_key", pool.Key, "groups", len(groups), "pairs", len(pairs), "waiting", saved.Len())
 
-		if err != nil {
-			return nil, nil, position, fmt.Errorf("AddAndFindNextPairUseCase.Execute: unable to UPDATE pool for pair %v. Cannot update pool for parties %v, due to %v", pair, parties, err)
+	return pairs, saved, pairErr
+}
+
+// pairUp creates the pair of the entries taken out of the pool: they are split into the teams of the layout, and
+// the pair is placed in their best region, on the map they vote for, with a ready check when the game mode asks for
+// one. The pair reports how good a match it makes (see QualityReport). Returns a nil pair, with the entries back in the pool, when they cannot be arranged into teams.
+func (uc *AddAndFindNextPairUseCase) pairUp(ctx context.Context, pool *pairing_entities.Pool, c pairing_value_objects.Criteria, entries []pairing_entities.PoolEntry, settings game_entities.MatchmakingSettings, layout pairing_entities.TeamLayout, mapPool []string) (*pairing_entities.Pair, error) {
+	parties := pairing_entities.PartyIDs(entries)
+
+	teams, err := pairing_entities.FormTeams(entries, layout)
+	if err != nil {
+		pool.Requeue(entries...)
+		slog.WarnContext(ctx, "unable to arrange parties into teams, parties returned to the pool", "pool_key", pool.Key, "parties", parties, "error", err)
+		return nil, nil
+	}
+
+	// the pair is drafted whole before it is created, so it is only saved once: a pair saved without its ready check
+	// would go ahead while its parties are matched again
+	pair := pairing_entities.NewPair(len(parties), common.ResourceOwner{})
+	pair.Teams = teams
+
+	// the pool the pair came from is where replacements are looked for, when players leave the match
+	pair.Criteria = &c
+
+	// the selector only groups parties sharing a region under their (relaxed) MaxPing, so one is always found
+	// when they reported their pings
+	now := time.Now()
+	if region, ok := pairing_entities.BestRegion(entries, func(e pairing_entities.PoolEntry) int {
+		return e.RelaxedMaxPing(now, settings.WindowExpansion)
+	}); ok {
+		pair.Region, pair.WorstPing = region.Region, region.WorstPing
+	}
+
+	if m, ok := pairing_entities.ChooseMap(entries, mapPool); ok {
+		pair.Map = m
+	}
+
+	quality := qualityModelFor(settings).Report(entries, teams, selectionRulesFor(settings, layout, mapPool), now)
+	pair.Quality = &quality
+
+	if window := settings.ReadyCheckWindow(); window > 0 {
+		pair.ReadyCheck = pairing_entities.NewReadyCheck(entries, now, window)
+	}
+
+	pair, err = uc.PairCreator.Execute(ctx, parties, pair)
+	if err != nil {
+		return nil, fmt.Errorf("unable to CREATE pair. Cannot create pair for parties %v, due to %w", parties, err)
+	}
+
+	return pair, nil
+}
+
+// recentOpponents returns the parties the party faced recently, as far back as the rematch avoidance of the game mode
+// looks. Returns nil when rematches are not avoided, or the party's matches cannot be read: it can meet anyone.
+func (uc *AddAndFindNextPairUseCase) recentOpponents(ctx context.Context, partyID uuid.UUID, rematches *game_entities.RematchAvoidance) []uuid.UUID {
+	if uc.PairReader == nil || !rematches.Enabled() {
+		return nil
+	}
+
+	pairs, err := uc.PairReader.FindPairsByPartyID(ctx, partyID)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to find recent matches of party, rematches are not avoided", "party_id", partyID, "error", err)
+		return nil
+	}
+
+	var since time.Time
+	if window := rematches.Window(); window > 0 {
+		since = time.Now().Add(-window)
+	}
+
+	return pairing_entities.RecentOpponents(partyID, pairs, rematches.LastMatches, since)
+}
+
+// gameFor resolves the criteria's game. Returns nil when there is no GameReader or the game cannot be found.
+func (uc *AddAndFindNextPairUseCase) gameFor(ctx context.Context, c pairing_value_objects.Criteria) *game_entities.Game {
+	if uc.GameReader == nil || c.GameID == nil {
+		return nil
+	}
+
+	game, err := uc.GameReader.GetByID(ctx, *c.GameID)
+	if err != nil || game == nil {
+		slog.WarnContext(ctx, "unable to resolve game, falling back to defaults", "game_id", c.GameID, "error", err)
+		return nil
+	}
+
+	return game
+}
+
+// teamLayoutFor returns the team layout of the game, or two unbounded teams when the game is unknown. Either way,
+// teams must fill the role quotas of the game mode.
+func teamLayoutFor(game *game_entities.Game, settings game_entities.MatchmakingSettings) pairing_entities.TeamLayout {
+	if game == nil || game.NumberOfTeams <= 0 {
+		return pairing_entities.TeamLayout{NumberOfTeams: DefaultNumberOfTeams, Roles: settings.RoleQuotas}
+	}
+
+	return pairing_entities.TeamLayout{
+		NumberOfTeams:     game.NumberOfTeams,
+		MinPlayersPerTeam: game.MinPlayersPerTeam,
+		MaxPlayersPerTeam: game.MaxPlayersPerTeam,
+		Roles:             settings.RoleQuotas,
+	}
+}
+
+// matchSizeFor returns how many players a match takes: every seat of the game's teams when it caps them,
+// the criteria's PairSize otherwise
+func matchSizeFor(game *game_entities.Game, c pairing_value_objects.Criteria) int {
+	if game != nil && game.NumberOfTeams > 0 && game.MaxPlayersPerTeam > 0 {
+		return game.NumberOfTeams * game.MaxPlayersPerTeam
+	}
+
+	if c.PairSize > 0 {
+		return c.PairSize
+	}
+
+	return DefaultPairSize
+}
+
+// selectorFor chooses how the next group is picked from the pool: the group the game mode's strategy prefers (see
+// strategyFor), favoring parties with a priority boost within the game mode's head start.
+func (uc *AddAndFindNextPairUseCase) selectorFor(ctx context.Context, game *game_entities.Game, settings game_entities.MatchmakingSettings, layout pairing_entities.TeamLayout, mapPool []string) pairing_entities.GroupSelector {
+	strategy := uc.strategyFor(ctx, game, settings, layout, mapPool)
+
+	return pairing_entities.WithPriority(pairing_entities.SelectWith(strategy), settings.PriorityBoostWindow())
+}
+
+// strategyFor returns the strategy the game mode names, looked up in the registry. Game modes naming none match by
+// skill when the game enables SkillBasedMatching, in FIFO order otherwise (or when the game cannot be resolved); so
+// do game modes naming a strategy the registry cannot build.
+// Whatever the strategy, only groups whose parties can be packed into the layout's teams, fill its role quotas and
+// share a region under their MaxPing are proposed.
+// Parties sharing none of the group's preferred maps of the map pool are only proposed once they waited the game
+// mode's map patience out.
+// Skill and ping windows are relaxed with wait time following the game mode's expansion curve, when it has one.
+// Parties who faced each other recently are kept apart, or penalized, following the game mode's rematch avoidance.
+// Groups are rated by closeness in skill, wait time, latency and overlap of the parties' schedules (see QualityModel),
+// as tuned by the strategy's parameters.
+func (uc *AddAndFindNextPairUseCase) strategyFor(ctx context.Context, game *game_entities.Game, settings game_entities.MatchmakingSettings, layout pairing_entities.TeamLayout, mapPool []string) pairing_entities.MatchStrategy {
+	base := pairing_entities.StrategyBase{Rules: selectionRulesFor(settings, layout, mapPool), Model: qualityModelFor(settings)}
+
+	fallback := pairing_entities.MatchStrategy(pairing_entities.FIFOStrategy(base))
+	if game != nil && game.SkillBasedMatching {
+		fallback = pairing_entities.SkillStrategy(base)
+	}
+
+	if settings.Strategy == nil {
+		return fallback
+	}
+
+	registry := uc.Strategies
+	if registry == nil {
+		registry = defaultStrategies
+	}
+
+	strategy, err := registry.Build(*settings.Strategy, base)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to build match strategy, falling back to the default strategy", "strategy", settings.Strategy.Name, "error", err)
+		return fallback
+	}
+
+	return strategy
+}
+
+// selectionRulesFor returns the rules every group picked from the pool must follow, under the game mode's settings
+func selectionRulesFor(settings game_entities.MatchmakingSettings, layout pairing_entities.TeamLayout, mapPool []string) pairing_entities.SelectionRules {
+	rules := pairing_entities.SelectionRules{
+		Layout: layout,
+		Steps:  settings.WindowExpansion,
+		Maps:   pairing_entities.MapRule{Pool: mapPool, Patience: settings.MapPatience()},
+	}
+
+	if rematches := settings.RematchAvoidance; rematches.Enabled() {
+		rules.Rematches = pairing_entities.RematchRule{
+			Exclude:     rematches.Mode == game_entities.RematchExclude,
+			Penalty:     rematches.Penalty(),
+			MinPoolSize: rematches.MinPoolSize,
 		}
 	}
 
-	return pair, pool, position, nil // send msg with position etc?
+	return rules
+}
+
+// qualityModelFor returns the model rating the matches of the game mode, before its strategy tunes it
+func qualityModelFor(settings game_entities.MatchmakingSettings) pairing_entities.QualityModel {
+	model := pairing_entities.DefaultQualityModel
+	model.HeadStart = settings.PriorityBoostWindow()
+	model.Overlaps = areSchedulesCompatible
+	model.OverlapMinutes = scheduleOverlapMinutes
+
+	return model
+}
+
+// batchSelectorFor chooses how the groups of a batch are picked from the pool: among the groups the game mode's
+// strategy proposes (see strategyFor), the ones making the best matches together
+func (uc *AddAndFindNextPairUseCase) batchSelectorFor(ctx context.Context, game *game_entities.Game, settings game_entities.MatchmakingSettings, layout pairing_entities.TeamLayout, mapPool []string) pairing_entities.BatchSelector {
+	return pairing_entities.SelectBatchWith(uc.strategyFor(ctx, game, settings, layout, mapPool))
+}
+
+// explainerFor returns what tells the parties of the pool why they are not matched yet: the strategy matching them
+// (see strategyFor), along with the expansion curve widening their windows
+func (uc *AddAndFindNextPairUseCase) explainerFor(ctx context.Context, pool *pairing_entities.Pool) pairing_entities.QueueExplainer {
+	settings := uc.settingsFor(ctx, pool.Criteria)
+	game := uc.gameFor(ctx, pool.Criteria)
+
+	return pairing_entities.QueueExplainer{
+		Strategy: uc.strategyFor(ctx, game, settings, teamLayoutFor(game, settings), mapPoolFor(game, settings)),
+		Steps:    settings.WindowExpansion,
+	}
+}
+
+// mapPoolFor returns the maps matches are played on: the game mode's map pool, or the game's when the game mode does
+// not narrow it. Returns nil when any map can be played.
+func mapPoolFor(game *game_entities.Game, settings game_entities.MatchmakingSettings) []string {
+	if len(settings.MapPool) > 0 {
+		return settings.MapPool
+	}
+
+	if game == nil {
+		return nil
+	}
+
+	return game.MapPool
+}
+
+// settingsFor returns the matchmaking settings of the criteria's game mode, or the zero settings (no window
+// expansion, no ready check) when the game mode cannot be resolved
+func (uc *AddAndFindNextPairUseCase) settingsFor(ctx context.Context, c pairing_value_objects.Criteria) game_entities.MatchmakingSettings {
+	if uc.GameModeReader == nil || c.GameModeID == nil {
+		return game_entities.MatchmakingSettings{}
+	}
+
+	gameMode, err := uc.GameModeReader.GetByID(ctx, *c.GameModeID)
+	if err != nil || gameMode == nil {
+		slog.WarnContext(ctx, "unable to resolve game mode, falling back to default matchmaking settings", "game_mode_id", c.GameModeID, "error", err)
+		return game_entities.MatchmakingSettings{}
+	}
+
+	return gameMode.Matchmaking
 }
@@ -1,6 +1,7 @@
 package usecases_test
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/leet-gaming/match-making-api/pkg/common"
 	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
@@ -52,7 +54,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -68,13 +70,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					UserID:   uuid.New(),
 				}
 				pair := pairing_entities.NewPair(2, resourceOwner)
-				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID")).Return(pair, nil)
+				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil)
 			},
@@ -83,7 +85,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 2,
 			},
 			expectedPosition: 2,
@@ -107,7 +109,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -120,13 +122,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil)
 			},
 			expectedPair:     nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 1,
@@ -175,7 +177,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -185,14 +187,14 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				// Should not be called
 			},
 			pairCreatorMock: func(m *mocks.MockPairCreator) {
-				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID")).Return(nil, assert.AnError)
+				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.Anything).Return(nil, assert.AnError)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// Pool is saved after joining, but not after pair creation failure
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New(), uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New(), uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
@@ -216,7 +218,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -232,7 +234,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					UserID:   uuid.New(),
 				}
 				pair := pairing_entities.NewPair(2, resourceOwner)
-				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID")).Return(pair, nil)
+				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				m.On("Save", mock.Anything).Return(nil, assert.AnError)
@@ -257,7 +259,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -270,13 +272,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
 			expectedPair:     nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 1,
@@ -300,7 +302,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -313,13 +315,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil)
 			},
 			expectedPair:     nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 1,
@@ -340,7 +342,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -356,7 +358,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					UserID:   uuid.New(),
 				}
 				pair := pairing_entities.NewPair(2, resourceOwner)
-				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID")).Return(pair, nil)
+				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save fails but doesn't stop execution
@@ -385,7 +387,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{partyID}
+				pool.Entries = newPoolEntries(partyID)
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -401,26 +403,26 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					UserID:   uuid.New(),
 				}
 				pair := pairing_entities.NewPair(2, resourceOwner)
-				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID")).Return(pair, nil)
+				m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				poolAfterJoin := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				poolAfterJoin.Parties = []uuid.UUID{uuid.New(), uuid.New()}
+				poolAfterJoin.Entries = newPoolEntries(uuid.New(), uuid.New())
 				poolAfterJoin.PartySize = 2
 
 				// Second save after pair creation
 				poolAfterPair := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				poolAfterPair.Parties = []uuid.UUID{}
+				poolAfterPair.Entries = []pairing_entities.PoolEntry{}
 				poolAfterPair.PartySize = 2
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 2
+					return len(p.Entries) == 2
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 0
+					return len(p.Entries) == 0
 				})).Return(poolAfterPair, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -428,7 +430,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 2,
 			},
 			expectedPosition: 2,
@@ -452,7 +454,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -465,13 +467,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
 			expectedPair:     nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 1,
@@ -493,7 +495,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{partyID1}
+				pool.Entries = newPoolEntries(partyID1)
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -511,26 +513,26 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(2, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 2
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				poolAfterJoin := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				poolAfterJoin.Parties = []uuid.UUID{uuid.New(), uuid.New()}
+				poolAfterJoin.Entries = newPoolEntries(uuid.New(), uuid.New())
 				poolAfterJoin.PartySize = 2
 
 				// Second save after pair creation (pool should be empty)
 				poolAfterPair := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				poolAfterPair.Parties = []uuid.UUID{}
+				poolAfterPair.Entries = []pairing_entities.PoolEntry{}
 				poolAfterPair.PartySize = 2
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 2
+					return len(p.Entries) == 2
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 0
+					return len(p.Entries) == 0
 				})).Return(poolAfterPair, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -538,7 +540,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 2,
 			},
 			expectedPosition: 2,
@@ -559,7 +561,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{} // Empty after previous pair creation
+				pool.Entries = []pairing_entities.PoolEntry{} // Empty after previous pair creation
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -575,13 +577,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
 			expectedPair:     nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 1,
@@ -605,7 +607,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 3})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 3
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -618,13 +620,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 3})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 3
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
 			expectedPair:     nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 3,
 			},
 			expectedPosition: 1,
@@ -646,7 +648,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 3})
 				// Pool has 2 parties, but we need 3 for a match
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 3
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -662,13 +664,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 3})
-				pool.Parties = []uuid.UUID{uuid.New(), uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New(), uuid.New())
 				pool.PartySize = 3
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
 			expectedPair:     nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New(), uuid.New()},
+				Entries:   newPoolEntries(uuid.New(), uuid.New()),
 				PartySize: 3,
 			},
 			expectedPosition: 2,
@@ -691,7 +693,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = []uuid.UUID{party1, party2, party3}
+				pool.Entries = newPoolEntries(party1, party2, party3)
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -709,26 +711,26 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(2, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 2
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join (now 4 players)
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				poolAfterJoin := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				poolAfterJoin.Parties = []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+				poolAfterJoin.Entries = newPoolEntries(uuid.New(), uuid.New(), uuid.New(), uuid.New())
 				poolAfterJoin.PartySize = 2
 
 				// Second save after pair creation (2 players removed, 2 remain)
 				poolAfterPair := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				poolAfterPair.Parties = []uuid.UUID{uuid.New(), uuid.New()}
+				poolAfterPair.Entries = newPoolEntries(uuid.New(), uuid.New())
 				poolAfterPair.PartySize = 2
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 4
+					return len(p.Entries) == 4
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 2
+					return len(p.Entries) == 2
 				})).Return(poolAfterPair, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -736,7 +738,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New(), uuid.New()},
+				Entries:   newPoolEntries(uuid.New(), uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 4,
@@ -759,7 +761,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 3})
-				pool.Parties = []uuid.UUID{party1, party2}
+				pool.Entries = newPoolEntries(party1, party2)
 				pool.PartySize = 3
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -777,26 +779,26 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(3, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 3
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join (now 3 players)
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				poolAfterJoin := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 3})
-				poolAfterJoin.Parties = []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+				poolAfterJoin.Entries = newPoolEntries(uuid.New(), uuid.New(), uuid.New())
 				poolAfterJoin.PartySize = 3
 
 				// Second save after team creation (pool emptied)
 				poolAfterTeam := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 3})
-				poolAfterTeam.Parties = []uuid.UUID{}
+				poolAfterTeam.Entries = []pairing_entities.PoolEntry{}
 				poolAfterTeam.PartySize = 3
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 3
+					return len(p.Entries) == 3
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 0
+					return len(p.Entries) == 0
 				})).Return(poolAfterTeam, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -804,7 +806,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 3,
 			},
 			expectedPosition: 3,
@@ -835,7 +837,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					PairSize: 2,
 					Region:   &game_entities.Region{Name: "North America"},
 				})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -851,13 +853,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					PairSize: 2,
 					Region:   &game_entities.Region{Name: "North America"},
 				})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
 			expectedPair: nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 1,
@@ -889,7 +891,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					PairSize: 2,
 					TenantID: &tenantID,
 				})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -906,13 +908,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					PairSize: 2,
 					TenantID: &tenantID,
 				})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
 			expectedPair: nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 1,
@@ -931,14 +933,14 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 			},
 			poolReaderMock: func(m *mocks.MockPoolReader) {
 				// Simulate a busy pool with many players already waiting
-				parties := make([]uuid.UUID, 10)
+				parties := make([]pairing_entities.PoolEntry, 10)
 				for i := range parties {
-					parties[i] = uuid.New()
+					parties[i].PartyID = uuid.New()
 				}
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				pool := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				pool.Parties = parties
+				pool.Entries = parties
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -956,32 +958,32 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(2, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 2
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join (now 11 players)
 				mutex := &sync.Mutex{}
 				cond := sync.NewCond(mutex)
 				poolAfterJoin := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				poolAfterJoin.Parties = make([]uuid.UUID, 11)
-				for i := range poolAfterJoin.Parties {
-					poolAfterJoin.Parties[i] = uuid.New()
+				poolAfterJoin.Entries = make([]pairing_entities.PoolEntry, 11)
+				for i := range poolAfterJoin.Entries {
+					poolAfterJoin.Entries[i].PartyID = uuid.New()
 				}
 				poolAfterJoin.PartySize = 2
 
 				// Second save after pair creation (9 players remain)
 				poolAfterPair := pairing_entities.NewPool(mutex, cond, pairing_value_objects.Criteria{PairSize: 2})
-				poolAfterPair.Parties = make([]uuid.UUID, 9)
-				for i := range poolAfterPair.Parties {
-					poolAfterPair.Parties[i] = uuid.New()
+				poolAfterPair.Entries = make([]pairing_entities.PoolEntry, 9)
+				for i := range poolAfterPair.Entries {
+					poolAfterPair.Entries[i].PartyID = uuid.New()
 				}
 				poolAfterPair.PartySize = 2
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 11
+					return len(p.Entries) == 11
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 9
+					return len(p.Entries) == 9
 				})).Return(poolAfterPair, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -989,7 +991,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   make([]uuid.UUID, 9),
+				Entries:   make([]pairing_entities.PoolEntry, 9),
 				PartySize: 2,
 			},
 			expectedPosition: 11,
@@ -1019,7 +1021,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					PairSize: 2,
 					ClientID: &clientID,
 				})
-				pool.Parties = []uuid.UUID{party1}
+				pool.Entries = newPoolEntries(party1)
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -1037,7 +1039,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(2, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 2
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join
@@ -1048,7 +1050,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					PairSize: 2,
 					ClientID: &clientID,
 				})
-				poolAfterJoin.Parties = []uuid.UUID{uuid.New(), uuid.New()}
+				poolAfterJoin.Entries = newPoolEntries(uuid.New(), uuid.New())
 				poolAfterJoin.PartySize = 2
 
 				// Second save after pair creation
@@ -1056,14 +1058,14 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					PairSize: 2,
 					ClientID: &clientID,
 				})
-				poolAfterPair.Parties = []uuid.UUID{}
+				poolAfterPair.Entries = []pairing_entities.PoolEntry{}
 				poolAfterPair.PartySize = 2
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 2
+					return len(p.Entries) == 2
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 0
+					return len(p.Entries) == 0
 				})).Return(poolAfterPair, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -1071,7 +1073,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 2,
 			},
 			expectedPosition: 2,
@@ -1118,7 +1120,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 						MaxMMR: 1600,
 					},
 				})
-				pool.Parties = []uuid.UUID{party1, party2, party3, party4}
+				pool.Entries = newPoolEntries(party1, party2, party3, party4)
 				pool.PartySize = 5
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -1136,7 +1138,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(5, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 5
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join (now 5 players)
@@ -1149,9 +1151,9 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					GameID:   &cs2GameID,
 					GameModeID: &competitiveModeID,
 				})
-				poolAfterJoin.Parties = make([]uuid.UUID, 5)
-				for i := range poolAfterJoin.Parties {
-					poolAfterJoin.Parties[i] = uuid.New()
+				poolAfterJoin.Entries = make([]pairing_entities.PoolEntry, 5)
+				for i := range poolAfterJoin.Entries {
+					poolAfterJoin.Entries[i].PartyID = uuid.New()
 				}
 				poolAfterJoin.PartySize = 5
 
@@ -1161,14 +1163,14 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					GameID:   &cs2GameID,
 					GameModeID: &competitiveModeID,
 				})
-				poolAfterTeam.Parties = []uuid.UUID{}
+				poolAfterTeam.Entries = []pairing_entities.PoolEntry{}
 				poolAfterTeam.PartySize = 5
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 5
+					return len(p.Entries) == 5
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 0
+					return len(p.Entries) == 0
 				})).Return(poolAfterTeam, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -1176,7 +1178,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 5,
 			},
 			expectedPosition: 5,
@@ -1223,7 +1225,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 						MaxMMR: 1200,
 					},
 				})
-				pool.Parties = []uuid.UUID{party1, party2, party3, party4}
+				pool.Entries = newPoolEntries(party1, party2, party3, party4)
 				pool.PartySize = 5
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -1241,7 +1243,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(5, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 5
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join
@@ -1254,9 +1256,9 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					GameID:   &valorantGameID,
 					GameModeID: &casualModeID,
 				})
-				poolAfterJoin.Parties = make([]uuid.UUID, 5)
-				for i := range poolAfterJoin.Parties {
-					poolAfterJoin.Parties[i] = uuid.New()
+				poolAfterJoin.Entries = make([]pairing_entities.PoolEntry, 5)
+				for i := range poolAfterJoin.Entries {
+					poolAfterJoin.Entries[i].PartyID = uuid.New()
 				}
 				poolAfterJoin.PartySize = 5
 
@@ -1266,14 +1268,14 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					GameID:   &valorantGameID,
 					GameModeID: &casualModeID,
 				})
-				poolAfterTeam.Parties = []uuid.UUID{}
+				poolAfterTeam.Entries = []pairing_entities.PoolEntry{}
 				poolAfterTeam.PartySize = 5
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 5
+					return len(p.Entries) == 5
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 0
+					return len(p.Entries) == 0
 				})).Return(poolAfterTeam, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -1281,7 +1283,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 5,
 			},
 			expectedPosition: 5,
@@ -1330,7 +1332,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					Tier:          "premium",
 					PriorityBoost: true,
 				})
-				pool.Parties = []uuid.UUID{}
+				pool.Entries = []pairing_entities.PoolEntry{}
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -1348,13 +1350,13 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					GameModeID: &[]uuid.UUID{uuid.New()}[0],
 					Tier:     "premium",
 				})
-				pool.Parties = []uuid.UUID{uuid.New()}
+				pool.Entries = newPoolEntries(uuid.New())
 				pool.PartySize = 2
 				m.On("Save", mock.Anything).Return(pool, nil).Once()
 			},
 			expectedPair: nil,
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{uuid.New()},
+				Entries:   newPoolEntries(uuid.New()),
 				PartySize: 2,
 			},
 			expectedPosition: 1,
@@ -1400,7 +1402,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 						MaxMMR: 1400,
 					},
 				})
-				pool.Parties = []uuid.UUID{party1}
+				pool.Entries = newPoolEntries(party1)
 				pool.PartySize = 2
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -1418,7 +1420,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(2, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 2
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join
@@ -1430,7 +1432,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					GameModeID: &[]uuid.UUID{uuid.New()}[0],
 					MapPreferences: []string{"dust2"},
 				})
-				poolAfterJoin.Parties = []uuid.UUID{uuid.New(), uuid.New()}
+				poolAfterJoin.Entries = newPoolEntries(uuid.New(), uuid.New())
 				poolAfterJoin.PartySize = 2
 
 				// Second save after pair creation
@@ -1440,14 +1442,14 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					GameModeID: &[]uuid.UUID{uuid.New()}[0],
 					MapPreferences: []string{"dust2"},
 				})
-				poolAfterPair.Parties = []uuid.UUID{}
+				poolAfterPair.Entries = []pairing_entities.PoolEntry{}
 				poolAfterPair.PartySize = 2
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 2
+					return len(p.Entries) == 2
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 0
+					return len(p.Entries) == 0
 				})).Return(poolAfterPair, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -1455,7 +1457,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 2,
 			},
 			expectedPosition: 2,
@@ -1497,7 +1499,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					MaxPing:  20,
 					Tier:     "pro",
 				})
-				pool.Parties = []uuid.UUID{party1, party2, party3}
+				pool.Entries = newPoolEntries(party1, party2, party3)
 				pool.PartySize = 4
 				pool.CreatedAt = time.Now()
 				pool.UpdatedAt = time.Now()
@@ -1515,7 +1517,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				pair := pairing_entities.NewPair(4, resourceOwner)
 				m.On("Execute", mock.Anything, mock.MatchedBy(func(parties []uuid.UUID) bool {
 					return len(parties) == 4
-				})).Return(pair, nil)
+				}), mock.Anything).Return(pair, nil)
 			},
 			poolWriterMock: func(m *mocks.MockPoolWriter) {
 				// First save after join
@@ -1528,9 +1530,9 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					MaxPing:  20,
 					Tier:     "pro",
 				})
-				poolAfterJoin.Parties = make([]uuid.UUID, 4)
-				for i := range poolAfterJoin.Parties {
-					poolAfterJoin.Parties[i] = uuid.New()
+				poolAfterJoin.Entries = make([]pairing_entities.PoolEntry, 4)
+				for i := range poolAfterJoin.Entries {
+					poolAfterJoin.Entries[i].PartyID = uuid.New()
 				}
 				poolAfterJoin.PartySize = 4
 
@@ -1542,14 +1544,14 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 					MaxPing:  20,
 					Tier:     "pro",
 				})
-				poolAfterTeam.Parties = []uuid.UUID{}
+				poolAfterTeam.Entries = []pairing_entities.PoolEntry{}
 				poolAfterTeam.PartySize = 4
 
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 4
+					return len(p.Entries) == 4
 				})).Return(poolAfterJoin, nil).Once()
 				m.On("Save", mock.MatchedBy(func(p *pairing_entities.Pool) bool {
-					return len(p.Parties) == 0
+					return len(p.Entries) == 0
 				})).Return(poolAfterTeam, nil).Once()
 			},
 			expectedPair: &pairing_entities.Pair{
@@ -1557,7 +1559,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				ConflictStatus: pairing_entities.ConflictStatusNone,
 			},
 			expectedPool: &pairing_entities.Pool{
-				Parties:   []uuid.UUID{},
+				Entries:   []pairing_entities.PoolEntry{},
 				PartySize: 4,
 			},
 			expectedPosition: 4,
@@ -1590,7 +1592,7 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 				PairCreator:        pairCreatorMock,
 			}
 
-			pair, pool, position, err := uc.Execute(usecases.FindPairPayload{
+			pair, pool, position, err := uc.Execute(context.Background(), usecases.FindPairPayload{
 				PartyID:  tc.partyID,
 				Criteria: tc.criteria,
 			})
@@ -1620,3 +1622,1020 @@ func TestAddAndFindNextPairUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestAddAndFindNextPairUseCase_Execute_KeepsEntryData(t *testing.T) {
+	criteria := pairing_value_objects.Criteria{PairSize: 2}
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	scheduleMock := &mocks.MockPartyScheduleReader{}
+	scheduleMock.On("GetScheduleByPartyID", mock.Anything).Return(&schedule_entities.Schedule{ID: uuid.New()})
+
+	poolReaderMock := &mocks.MockPoolReader{}
+	poolReaderMock.On("FindPool", mock.Anything).Return(pool, nil)
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolReader:          poolReaderMock,
+		PoolWriter:          poolWriterMock,
+		PartyScheduleReader: scheduleMock,
+	}
+
+	partyID := uuid.New()
+	joinedAt := time.Now().Add(-3 * time.Minute)
+
+	pair, _, position, err := uc.Execute(context.Background(), usecases.FindPairPayload{
+		PartyID:   partyID,
+		PartySize: 3,
+		MMR:       1850,
+		Pings:     map[string]int{"sa-east": 35},
+		JoinedAt:  joinedAt,
+		Criteria:  criteria,
+	})
+
+	assert.NoError(t, err)
+	assert.Nil(t, pair)
+	assert.Equal(t, 1, position)
+
+	if assert.Len(t, pool.Entries, 1) {
+		entry := pool.Entries[0]
+		assert.Equal(t, partyID, entry.PartyID)
+		assert.Equal(t, 3, entry.Size)
+		assert.Equal(t, 1850, entry.MMR)
+		assert.Equal(t, 35, entry.Pings["sa-east"])
+		assert.True(t, joinedAt.Equal(entry.JoinedAt))
+		assert.GreaterOrEqual(t, entry.WaitTime(time.Now()), 3*time.Minute)
+		assert.Equal(t, 2, entry.Criteria.PairSize)
+	}
+
+	// joining again keeps the original entry and position
+	assert.Equal(t, 1, pool.Join(pairing_entities.PoolEntry{PartyID: partyID, MMR: 100}))
+	assert.Len(t, pool.Entries, 1)
+	assert.Equal(t, 1850, pool.Entries[0].MMR)
+}
+
+func TestAddAndFindNextPairUseCase_Execute_SkillBasedSelection(t *testing.T) {
+	gameID := uuid.New()
+
+	skillEntry := func(mmr int) pairing_entities.PoolEntry {
+		return pairing_entities.PoolEntry{
+			PartyID:  uuid.New(),
+			JoinedAt: time.Now(),
+			Size:     1,
+			MMR:      mmr,
+			Criteria: pairing_value_objects.Criteria{
+				SkillRange: &pairing_value_objects.SkillRange{MinMMR: mmr - 200, MaxMMR: mmr + 200},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		skillBasedMatching bool
+		queuedMMRs         []int
+		joiningMMR         int
+		expectedMMRs       []int // MMRs of the matched parties; nil when no pair is expected
+	}{
+		{
+			name:               "Picks The Closest Skill Group Instead Of The Oldest Entries",
+			skillBasedMatching: true,
+			queuedMMRs:         []int{1000, 1800, 1500},
+			joiningMMR:         1550,
+			expectedMMRs:       []int{1500, 1550},
+		},
+		{
+			name:               "Waits When No Skill Windows Overlap",
+			skillBasedMatching: true,
+			queuedMMRs:         []int{1000, 2000},
+			joiningMMR:         3000,
+			expectedMMRs:       nil,
+		},
+		{
+			name:               "Breaks Spread Ties In Favor Of The Longest Waiting Entry",
+			skillBasedMatching: true,
+			queuedMMRs:         []int{1300, 1100},
+			joiningMMR:         1200,
+			expectedMMRs:       []int{1300, 1200},
+		},
+		{
+			name:               "Falls Back To FIFO When Skill Based Matching Is Disabled",
+			skillBasedMatching: false,
+			queuedMMRs:         []int{1000, 2500},
+			joiningMMR:         2550,
+			expectedMMRs:       []int{1000, 2500},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			criteria := pairing_value_objects.Criteria{GameID: &gameID, PairSize: 2}
+			mutex := &sync.Mutex{}
+			pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+			mmrByParty := make(map[uuid.UUID]int)
+			for _, mmr := range tc.queuedMMRs {
+				entry := skillEntry(mmr)
+				mmrByParty[entry.PartyID] = mmr
+				pool.Join(entry)
+			}
+
+			scheduleMock := &mocks.MockPartyScheduleReader{}
+			scheduleMock.On("GetScheduleByPartyID", mock.Anything).Return(&schedule_entities.Schedule{ID: uuid.New()})
+
+			poolReaderMock := &mocks.MockPoolReader{}
+			poolReaderMock.On("FindPool", mock.Anything).Return(pool, nil)
+
+			poolWriterMock := &mocks.MockPoolWriter{}
+			poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+			gameReaderMock := &mocks.MockPortGameReader{}
+			gameReaderMock.On("GetByID", mock.Anything, gameID).Return(&game_entities.Game{SkillBasedMatching: tc.skillBasedMatching}, nil)
+
+			var matched []uuid.UUID
+			pairCreatorMock := &mocks.MockPairCreator{}
+			pairCreatorMock.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.Anything).
+				Run(func(args mock.Arguments) { matched = args.Get(1).([]uuid.UUID) }).
+				Return(pairing_entities.NewPair(2, common.ResourceOwner{}), nil)
+
+			uc := usecases.AddAndFindNextPairUseCase{
+				PoolReader:          poolReaderMock,
+				PoolWriter:          poolWriterMock,
+				PartyScheduleReader: scheduleMock,
+				PairCreator:         pairCreatorMock,
+				GameReader:          gameReaderMock,
+			}
+
+			joining := skillEntry(tc.joiningMMR)
+			mmrByParty[joining.PartyID] = tc.joiningMMR
+
+			pair, _, _, err := uc.Execute(context.Background(), usecases.FindPairPayload{
+				PartyID:  joining.PartyID,
+				MMR:      joining.MMR,
+				Criteria: pairing_value_objects.Criteria{GameID: &gameID, PairSize: 2, SkillRange: joining.Criteria.SkillRange},
+			})
+
+			assert.NoError(t, err)
+
+			if tc.expectedMMRs == nil {
+				assert.Nil(t, pair)
+				assert.Len(t, pool.Entries, len(tc.queuedMMRs)+1)
+				pairCreatorMock.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NotNil(t, pair)
+
+			matchedMMRs := make([]int, len(matched))
+			for i, pid := range matched {
+				matchedMMRs[i] = mmrByParty[pid]
+			}
+
+			assert.Equal(t, tc.expectedMMRs, matchedMMRs)
+			assert.Len(t, pool.Entries, len(tc.queuedMMRs)+1-len(tc.expectedMMRs))
+		})
+	}
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_RelaxesWindowsWithWaitTime(t *testing.T) {
+	gameID, gameModeID := uuid.New(), uuid.New()
+	criteria := pairing_value_objects.Criteria{GameID: &gameID, GameModeID: &gameModeID, PairSize: 2}
+
+	expansion := []game_entities.WindowExpansionStep{
+		{AfterSeconds: 30, MMRDelta: 25, MaxPing: 80},
+		{AfterSeconds: 60, MMRDelta: 100, MaxPing: 120},
+	}
+
+	waitingEntry := func(mmr int, waited time.Duration) pairing_entities.PoolEntry {
+		return pairing_entities.PoolEntry{
+			PartyID:  uuid.New(),
+			JoinedAt: time.Now().Add(-waited),
+			MMR:      mmr,
+			Criteria: pairing_value_objects.Criteria{
+				MaxPing:    60,
+				SkillRange: &pairing_value_objects.SkillRange{MinMMR: mmr - 200, MaxMMR: mmr + 200},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		waited      time.Duration
+		expectPair  bool
+		expectedMax int // relaxed MaxPing of the entries
+	}{
+		{name: "Windows Too Narrow Right After Joining", waited: 0, expectPair: false, expectedMax: 60},
+		{name: "First Step Is Not Enough", waited: 45 * time.Second, expectPair: false, expectedMax: 80},
+		{name: "Last Step Widens The Windows Enough To Overlap", waited: 2 * time.Minute, expectPair: true, expectedMax: 120},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mutex := &sync.Mutex{}
+			pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+			// [800, 1200] and [1300, 1700] need 50 extra MMR on each side to overlap
+			low, high := waitingEntry(1000, tc.waited), waitingEntry(1500, tc.waited)
+			pool.Join(low)
+			pool.Join(high)
+
+			assert.Equal(t, tc.expectedMax, low.RelaxedMaxPing(time.Now(), expansion))
+
+			gameReaderMock := &mocks.MockPortGameReader{}
+			gameReaderMock.On("GetByID", mock.Anything, gameID).Return(&game_entities.Game{SkillBasedMatching: true}, nil)
+
+			gameModeReaderMock := &mocks.MockPortGameModeReader{}
+			gameModeReaderMock.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+				Matchmaking: game_entities.MatchmakingSettings{WindowExpansion: expansion},
+			}, nil)
+
+			pairCreatorMock := &mocks.MockPairCreator{}
+			pairCreatorMock.On("Execute", mock.Anything, []uuid.UUID{low.PartyID, high.PartyID}, mock.Anything).Return(pairing_entities.NewPair(2, common.ResourceOwner{}), nil)
+
+			poolWriterMock := &mocks.MockPoolWriter{}
+			poolWriterMock.On("Save", pool).Return(pool, nil)
+
+			uc := usecases.AddAndFindNextPairUseCase{
+				PoolWriter:     poolWriterMock,
+				PairCreator:    pairCreatorMock,
+				GameReader:     gameReaderMock,
+				GameModeReader: gameModeReaderMock,
+			}
+
+			pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+			assert.NoError(t, err)
+
+			if tc.expectPair {
+				assert.NotNil(t, pair)
+				assert.Empty(t, pool.Entries)
+			} else {
+				assert.Nil(t, pair)
+				assert.Len(t, pool.Entries, 2)
+				pairCreatorMock.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_EmptyPoolDoesNotBlock(t *testing.T) {
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), pairing_value_objects.Criteria{PairSize: 2})
+
+	uc := usecases.AddAndFindNextPairUseCase{}
+
+	pair, returnedPool, err := uc.FindNextPair(context.Background(), pool, pool.Criteria)
+
+	assert.NoError(t, err)
+	assert.Nil(t, pair)
+	assert.Same(t, pool, returnedPool)
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_ReturnsThePartiesWhenThePairCannotBeCreated(t *testing.T) {
+	t.Run("Pair Creator Failing", func(t *testing.T) {
+		pool := newTestPool(uuid.New(), uuid.New())
+
+		pairCreatorMock := &mocks.MockPairCreator{}
+		pairCreatorMock.On("Execute", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+		uc := usecases.AddAndFindNextPairUseCase{PairCreator: pairCreatorMock}
+
+		_, _, err := uc.FindNextPair(context.Background(), pool, pool.Criteria)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 2, pool.Len())
+	})
+
+	t.Run("Pair Writer Failing", func(t *testing.T) {
+		pool := newTestPool(uuid.New(), uuid.New())
+
+		partyReaderMock := &mocks.MockPortPartyReader{}
+		partyReaderMock.On("GetByID", mock.Anything).Return(&parties_entities.Party{}, nil)
+
+		// the pair is saved once, whole: nothing of it is left behind in the store when it cannot be saved
+		pairWriterMock := &mocks.MockPortPairWriter{}
+		pairWriterMock.On("Save", mock.MatchedBy(func(p *pairing_entities.Pair) bool {
+			return len(p.Match) == 2 && p.Criteria != nil && p.Quality != nil
+		})).Return(nil, assert.AnError).Once()
+
+		uc := usecases.AddAndFindNextPairUseCase{PairCreator: &usecases.CreatePairUseCase{PartyReader: partyReaderMock, PairWriter: pairWriterMock}}
+
+		_, _, err := uc.FindNextPair(newTenantContext(), pool, pool.Criteria)
+
+		assert.ErrorContains(t, err, assert.AnError.Error())
+		assert.Equal(t, 2, pool.Len())
+		pairWriterMock.AssertExpectations(t)
+	})
+}
+
+func TestAddAndFindNextPairUseCase_Execute_FailsWhenThePoolCannotBeSaved(t *testing.T) {
+	pool := newTestPool()
+
+	scheduleReaderMock := &mocks.MockPartyScheduleReader{}
+	scheduleReaderMock.On("GetScheduleByPartyID", mock.Anything).Return(nil)
+
+	poolReaderMock := &mocks.MockPoolReader{}
+	poolReaderMock.On("FindPool", mock.Anything).Return(pool, nil)
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", pool).Return(nil, assert.AnError)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PartyScheduleReader: scheduleReaderMock,
+		PoolReader:          poolReaderMock,
+		PoolWriter:          poolWriterMock,
+	}
+
+	_, _, _, err := uc.Execute(context.Background(), usecases.FindPairPayload{PartyID: uuid.New(), PartySize: 1, Criteria: pool.Criteria})
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_PlacesTheMatchInTheRegionWithTheBestWorstPing(t *testing.T) {
+	criteria := pairing_value_objects.Criteria{PairSize: 2}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New(), Pings: map[string]int{"eu-west": 25, "eu-central": 40, "us-east": 90}, Criteria: pairing_value_objects.Criteria{MaxPing: 100}})
+	pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New(), Pings: map[string]int{"eu-west": 70, "eu-central": 35, "us-east": 20}, Criteria: pairing_value_objects.Criteria{MaxPing: 100}})
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	pairCreatorMock := &mocks.MockPairCreator{}
+	createsDrafts(pairCreatorMock)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolWriter:  poolWriterMock,
+		PairCreator: pairCreatorMock,
+	}
+
+	pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	assert.Equal(t, "eu-central", pair.Region)
+	assert.Equal(t, 40, pair.WorstPing)
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_FormsBalancedTeams(t *testing.T) {
+	gameID := uuid.New()
+	criteria := pairing_value_objects.Criteria{GameID: &gameID, PairSize: 4}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	duo := pairing_entities.PoolEntry{PartyID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New(), uuid.New()}, MMR: 1500}
+	otherDuo := pairing_entities.PoolEntry{PartyID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New(), uuid.New()}, MMR: 1300}
+	high := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: 1900}
+	low := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: 1100}
+
+	for _, entry := range []pairing_entities.PoolEntry{duo, otherDuo, high, low} {
+		pool.Join(entry)
+	}
+
+	gameReaderMock := &mocks.MockPortGameReader{}
+	gameReaderMock.On("GetByID", mock.Anything, gameID).Return(&game_entities.Game{NumberOfTeams: 2, MaxPlayersPerTeam: 3}, nil)
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	var teams []pairing_entities.Team
+	pairCreatorMock := &mocks.MockPairCreator{}
+	pairCreatorMock.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.AnythingOfType("*entities.Pair")).
+		Run(func(args mock.Arguments) { teams = args.Get(2).(*pairing_entities.Pair).Teams }).
+		Return(pairing_entities.NewPair(4, common.ResourceOwner{}), nil)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolWriter:  poolWriterMock,
+		PairCreator: pairCreatorMock,
+		GameReader:  gameReaderMock,
+	}
+
+	pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	require.Len(t, teams, 2)
+
+	// the duos cannot share a team: duo + low averages 1367 against 1500 for the other duo + high
+	byParty := make(map[uuid.UUID]int)
+	for i, team := range teams {
+		assert.Len(t, team.PlayerIDs, 3)
+		for _, pid := range team.PartyIDs {
+			byParty[pid] = i
+		}
+	}
+
+	assert.Equal(t, byParty[duo.PartyID], byParty[low.PartyID])
+	assert.Equal(t, byParty[otherDuo.PartyID], byParty[high.PartyID])
+	assert.Subset(t, teams[byParty[duo.PartyID]].PlayerIDs, duo.PlayerIDs)
+	assert.Zero(t, pool.Len())
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_PacksPartiesIntoFullTeams(t *testing.T) {
+	gameID := uuid.New()
+	criteria := pairing_value_objects.Criteria{GameID: &gameID, PairSize: 2}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	for _, size := range []int{3, 2, 2, 2, 1} {
+		entry := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: 1500}
+		for i := 0; i < size; i++ {
+			entry.PlayerIDs = append(entry.PlayerIDs, uuid.New())
+		}
+
+		pool.Join(entry)
+	}
+
+	gameReaderMock := &mocks.MockPortGameReader{}
+	gameReaderMock.On("GetByID", mock.Anything, gameID).Return(&game_entities.Game{NumberOfTeams: 2, MinPlayersPerTeam: 5, MaxPlayersPerTeam: 5}, nil)
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	var teams []pairing_entities.Team
+	pairCreatorMock := &mocks.MockPairCreator{}
+	pairCreatorMock.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.AnythingOfType("*entities.Pair")).
+		Run(func(args mock.Arguments) { teams = args.Get(2).(*pairing_entities.Pair).Teams }).
+		Return(pairing_entities.NewPair(5, common.ResourceOwner{}), nil)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolWriter:  poolWriterMock,
+		PairCreator: pairCreatorMock,
+		GameReader:  gameReaderMock,
+	}
+
+	pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	require.Len(t, teams, 2)
+
+	// 3 + 2 against 2 + 2 + 1, whatever PairSize says
+	for _, team := range teams {
+		assert.Len(t, team.PlayerIDs, 5)
+	}
+
+	assert.Zero(t, pool.Len())
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_WaitsForPartiesThatFitTheTeams(t *testing.T) {
+	gameID := uuid.New()
+	criteria := pairing_value_objects.Criteria{GameID: &gameID}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	stack := pairing_entities.PoolEntry{PartyID: uuid.New(), Size: 3, JoinedAt: time.Now().Add(-time.Minute)}
+	solo := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now()}
+	pool.Join(stack)
+	pool.Join(solo)
+
+	gameReaderMock := &mocks.MockPortGameReader{}
+	gameReaderMock.On("GetByID", mock.Anything, gameID).Return(&game_entities.Game{NumberOfTeams: 2, MaxPlayersPerTeam: 2}, nil)
+
+	pairCreatorMock := &mocks.MockPairCreator{}
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PairCreator: pairCreatorMock,
+		GameReader:  gameReaderMock,
+	}
+
+	pair, returnedPool, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+	assert.NoError(t, err)
+	assert.Nil(t, pair)
+	assert.Same(t, pool, returnedPool)
+	assert.Equal(t, []uuid.UUID{stack.PartyID, solo.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+	pairCreatorMock.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_StartsReadyCheck(t *testing.T) {
+	gameModeID := uuid.New()
+	criteria := pairing_value_objects.Criteria{GameModeID: &gameModeID, PairSize: 2}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+	for _, entry := range newPoolEntries(uuid.New(), uuid.New()) {
+		pool.Join(entry)
+	}
+
+	gameModeReaderMock := &mocks.MockPortGameModeReader{}
+	gameModeReaderMock.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+		Matchmaking: game_entities.MatchmakingSettings{ReadyCheckSeconds: 15},
+	}, nil)
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	// the ready check is created along with the pair, never saved on its own
+	created := &pairing_entities.Pair{}
+
+	partyReaderMock := &mocks.MockPortPartyReader{}
+	partyReaderMock.On("GetByID", mock.Anything).Return(&parties_entities.Party{}, nil)
+
+	pairWriterMock := &mocks.MockPortPairWriter{}
+	pairWriterMock.On("Save", mock.MatchedBy(func(p *pairing_entities.Pair) bool {
+		return p.ReadyCheck != nil && len(p.ReadyCheck.Entries) == 2 && p.Criteria != nil
+	})).Run(func(args mock.Arguments) { *created = *args.Get(0).(*pairing_entities.Pair) }).Return(created, nil).Once()
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolWriter:     poolWriterMock,
+		PairCreator:    &usecases.CreatePairUseCase{PartyReader: partyReaderMock, PairWriter: pairWriterMock},
+		GameModeReader: gameModeReaderMock,
+	}
+
+	pair, _, err := uc.FindNextPair(newTenantContext(), pool, criteria)
+
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	assert.False(t, pair.IsConfirmed())
+	assert.Equal(t, pairing_entities.ReadyCheckPending, pair.ReadyCheck.Status)
+	assert.WithinDuration(t, time.Now().Add(15*time.Second), pair.ReadyCheck.ExpiresAt, time.Second)
+	pairWriterMock.AssertExpectations(t)
+}
+
+// createsDrafts has the mock create pairs out of the drafts it is given, like CreatePairUseCase does
+func createsDrafts(m *mocks.MockPairCreator) {
+	created := &pairing_entities.Pair{}
+	m.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.AnythingOfType("*entities.Pair")).
+		Run(func(args mock.Arguments) { *created = *args.Get(2).(*pairing_entities.Pair) }).
+		Return(created, nil)
+}
+
+// newTenantContext returns a context holding the resource owner pairs are created for
+func newTenantContext() context.Context {
+	ctx := context.WithValue(context.Background(), common.TenantIDKey, uuid.New())
+	ctx = context.WithValue(ctx, common.ClientIDKey, uuid.New())
+
+	return context.WithValue(ctx, common.UserIDKey, uuid.New())
+}
+
+// newPoolEntries builds solo-party pool entries in the given order, as if each party had just joined
+func newPoolEntries(partyIDs ...uuid.UUID) []pairing_entities.PoolEntry {
+	entries := make([]pairing_entities.PoolEntry, len(partyIDs))
+	for i, pid := range partyIDs {
+		entries[i] = pairing_entities.PoolEntry{
+			PartyID:  pid,
+			JoinedAt: time.Now(),
+			Size:     1,
+		}
+	}
+
+	return entries
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_ChoosesTheMapByVote(t *testing.T) {
+	gameID, gameModeID := uuid.New(), uuid.New()
+	criteria := pairing_value_objects.Criteria{GameID: &gameID, GameModeID: &gameModeID, PairSize: 2}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	// dust2 is out of the game mode's pool, so the only map both parties can vote for is inferno
+	pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New(), Criteria: pairing_value_objects.Criteria{MapPreferences: []string{"dust2", "inferno"}}})
+	pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New(), Criteria: pairing_value_objects.Criteria{MapPreferences: []string{"inferno"}}})
+
+	gameReaderMock := &mocks.MockPortGameReader{}
+	gameReaderMock.On("GetByID", mock.Anything, gameID).Return(&game_entities.Game{MapPool: []string{"dust2", "inferno", "nuke"}}, nil)
+
+	gameModeReaderMock := &mocks.MockPortGameModeReader{}
+	gameModeReaderMock.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+		Matchmaking: game_entities.MatchmakingSettings{MapPool: []string{"inferno", "nuke"}},
+	}, nil)
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	pairCreatorMock := &mocks.MockPairCreator{}
+	createsDrafts(pairCreatorMock)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolWriter:     poolWriterMock,
+		PairCreator:    pairCreatorMock,
+		GameReader:     gameReaderMock,
+		GameModeReader: gameModeReaderMock,
+	}
+
+	pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	assert.Equal(t, "inferno", pair.Map)
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_BoostsPriorityAsTheGameModeSays(t *testing.T) {
+	gameID, gameModeID := uuid.New(), uuid.New()
+	criteria := pairing_value_objects.Criteria{GameID: &gameID, GameModeID: &gameModeID, PairSize: 2}
+
+	// the boosted party joined last, but within the default head start of the others
+	newPool := func() (*pairing_entities.Pool, uuid.UUID) {
+		mutex := &sync.Mutex{}
+		pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+		boosted := uuid.New()
+		pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now().Add(-20 * time.Second)})
+		pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now().Add(-10 * time.Second)})
+		pool.Join(pairing_entities.PoolEntry{PartyID: boosted, JoinedAt: time.Now(), Criteria: pairing_value_objects.Criteria{PriorityBoost: true}})
+
+		return pool, boosted
+	}
+
+	findNextPair := func(pool *pairing_entities.Pool, boostSeconds *int) {
+		gameReaderMock := &mocks.MockPortGameReader{}
+		gameReaderMock.On("GetByID", mock.Anything, gameID).Return(&game_entities.Game{}, nil)
+
+		gameModeReaderMock := &mocks.MockPortGameModeReader{}
+		gameModeReaderMock.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+			Matchmaking: game_entities.MatchmakingSettings{PriorityBoostSeconds: boostSeconds},
+		}, nil)
+
+		poolWriterMock := &mocks.MockPoolWriter{}
+		poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+		pairCreatorMock := &mocks.MockPairCreator{}
+		pairCreatorMock.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.Anything).Return(pairing_entities.NewPair(2, common.ResourceOwner{}), nil)
+
+		uc := usecases.AddAndFindNextPairUseCase{
+			PoolWriter:     poolWriterMock,
+			PairCreator:    pairCreatorMock,
+			GameReader:     gameReaderMock,
+			GameModeReader: gameModeReaderMock,
+		}
+
+		pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+		require.NoError(t, err)
+		require.NotNil(t, pair)
+	}
+
+	t.Run("Boosted Parties Overtake The Others By Default", func(t *testing.T) {
+		pool, boosted := newPool()
+
+		findNextPair(pool, nil)
+
+		assert.NotContains(t, pairing_entities.PartyIDs(pool.QueuedEntries()), boosted)
+	})
+
+	t.Run("Boosted Parties Wait Their Turn When The Game Mode Disables The Boost", func(t *testing.T) {
+		pool, boosted := newPool()
+		disabled := 0
+
+		findNextPair(pool, &disabled)
+
+		assert.Equal(t, []uuid.UUID{boosted}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+	})
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_FillsTheRoleQuotasOfTheGameMode(t *testing.T) {
+	gameID, gameModeID := uuid.New(), uuid.New()
+	criteria := pairing_value_objects.Criteria{GameID: &gameID, GameModeID: &gameModeID}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	solo := func(roles ...string) pairing_entities.PoolEntry {
+		playerID := uuid.New()
+		return pairing_entities.PoolEntry{PartyID: uuid.New(), PlayerIDs: []uuid.UUID{playerID}, Roles: map[uuid.UUID][]string{playerID: roles}}
+	}
+
+	// the second tank has no team left to tank for, so the healer waiting behind them takes their place
+	tank, otherTank, healer := solo("tank"), solo("tank"), solo("healer")
+	for _, entry := range []pairing_entities.PoolEntry{tank, otherTank, healer} {
+		pool.Join(entry)
+	}
+
+	gameReaderMock := &mocks.MockPortGameReader{}
+	gameReaderMock.On("GetByID", mock.Anything, gameID).Return(&game_entities.Game{NumberOfTeams: 1, MaxPlayersPerTeam: 2}, nil)
+
+	gameModeReaderMock := &mocks.MockPortGameModeReader{}
+	gameModeReaderMock.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+		Matchmaking: game_entities.MatchmakingSettings{RoleQuotas: []game_entities.RoleQuota{{Role: "tank", Count: 1}, {Role: "healer", Count: 1}}},
+	}, nil)
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	var teams []pairing_entities.Team
+	pairCreatorMock := &mocks.MockPairCreator{}
+	pairCreatorMock.On("Execute", mock.Anything, []uuid.UUID{tank.PartyID, healer.PartyID}, mock.AnythingOfType("*entities.Pair")).
+		Run(func(args mock.Arguments) { teams = args.Get(2).(*pairing_entities.Pair).Teams }).
+		Return(pairing_entities.NewPair(2, common.ResourceOwner{}), nil)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolWriter:     poolWriterMock,
+		PairCreator:    pairCreatorMock,
+		GameReader:     gameReaderMock,
+		GameModeReader: gameModeReaderMock,
+	}
+
+	pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	require.Len(t, teams, 1)
+	assert.Equal(t, map[uuid.UUID]string{tank.PlayerIDs[0]: "tank", healer.PlayerIDs[0]: "healer"}, teams[0].Roles)
+	assert.Equal(t, []uuid.UUID{otherTank.PartyID}, pairing_entities.PartyIDs(pool.Entries))
+}
+
+func TestAddAndFindNextPairUseCase_Execute_KeepsRecentOpponentsApart(t *testing.T) {
+	gameModeID := uuid.New()
+	criteria := pairing_value_objects.Criteria{GameModeID: &gameModeID, PairSize: 2}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	// the waiting party faced the joining one in its last match
+	opponent, joining := uuid.New(), uuid.New()
+	pool.Join(pairing_entities.PoolEntry{PartyID: opponent, RecentOpponents: []uuid.UUID{joining}})
+
+	lastMatch := pairing_entities.NewPair(2, common.ResourceOwner{})
+	lastMatch.Match[opponent] = &parties_entities.Party{}
+	lastMatch.Match[joining] = &parties_entities.Party{}
+
+	scheduleMock := &mocks.MockPartyScheduleReader{}
+	scheduleMock.On("GetScheduleByPartyID", mock.Anything).Return(&schedule_entities.Schedule{ID: uuid.New()})
+
+	poolReaderMock := &mocks.MockPoolReader{}
+	poolReaderMock.On("FindPool", mock.Anything).Return(pool, nil)
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	gameModeReaderMock := &mocks.MockPortGameModeReader{}
+	gameModeReaderMock.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+		Matchmaking: game_entities.MatchmakingSettings{
+			RematchAvoidance: &game_entities.RematchAvoidance{Mode: game_entities.RematchExclude, LastMatches: 1},
+		},
+	}, nil)
+
+	pairReaderMock := &mocks.MockPortPairReader{}
+	pairReaderMock.On("FindPairsByPartyID", mock.Anything, joining).Return([]*pairing_entities.Pair{lastMatch}, nil)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolReader:          poolReaderMock,
+		PoolWriter:          poolWriterMock,
+		PartyScheduleReader: scheduleMock,
+		GameModeReader:      gameModeReaderMock,
+		PairReader:          pairReaderMock,
+	}
+
+	pair, _, position, err := uc.Execute(context.Background(), usecases.FindPairPayload{PartyID: joining, Criteria: criteria})
+
+	require.NoError(t, err)
+	assert.Nil(t, pair)
+	assert.Equal(t, 2, position)
+	require.Len(t, pool.Entries, 2)
+	assert.Equal(t, []uuid.UUID{opponent}, pool.Entries[1].RecentOpponents)
+	pairReaderMock.AssertExpectations(t)
+}
+
+func TestAddAndFindNextPairUseCase_MatchBatch(t *testing.T) {
+	gameModeID := uuid.New()
+	criteria := pairing_value_objects.Criteria{GameModeID: &gameModeID, PairSize: 2}
+
+	gameModeReaderMock := &mocks.MockPortGameModeReader{}
+	gameModeReaderMock.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+		Matchmaking: game_entities.MatchmakingSettings{Matcher: game_entities.MatcherBatch},
+	}, nil)
+
+	newBatchPool := func(entries ...pairing_entities.PoolEntry) *pairing_entities.Pool {
+		mutex := &sync.Mutex{}
+		pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+		for _, entry := range entries {
+			pool.Join(entry)
+		}
+
+		return pool
+	}
+
+	t.Run("Leaves Joining Parties Waiting For The Batch", func(t *testing.T) {
+		pool := newBatchPool(pairing_entities.PoolEntry{PartyID: uuid.New()})
+
+		scheduleMock := &mocks.MockPartyScheduleReader{}
+		scheduleMock.On("GetScheduleByPartyID", mock.Anything).Return(&schedule_entities.Schedule{ID: uuid.New()})
+
+		poolReaderMock := &mocks.MockPoolReader{}
+		poolReaderMock.On("FindPool", mock.Anything).Return(pool, nil)
+
+		poolWriterMock := &mocks.MockPoolWriter{}
+		poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+		uc := usecases.AddAndFindNextPairUseCase{
+			PoolReader:          poolReaderMock,
+			PoolWriter:          poolWriterMock,
+			PartyScheduleReader: scheduleMock,
+			PairCreator:         &mocks.MockPairCreator{},
+			GameModeReader:      gameModeReaderMock,
+		}
+
+		pair, _, position, err := uc.Execute(context.Background(), usecases.FindPairPayload{PartyID: uuid.New(), Criteria: criteria})
+
+		require.NoError(t, err)
+		assert.Nil(t, pair)
+		assert.Equal(t, 2, position)
+		assert.Equal(t, 2, pool.Len())
+	})
+
+	t.Run("Pairs Up Every Group Of The Batch", func(t *testing.T) {
+		entries := newPoolEntries(uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New())
+		pool := newBatchPool(entries...)
+
+		poolWriterMock := &mocks.MockPoolWriter{}
+		poolWriterMock.On("Save", pool).Return(pool, nil).Once()
+
+		pairCreatorMock := &mocks.MockPairCreator{}
+		pairCreatorMock.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.AnythingOfType("*entities.Pair")).
+			Return(pairing_entities.NewPair(2, common.ResourceOwner{}), nil).Twice()
+
+		uc := usecases.AddAndFindNextPairUseCase{
+			PoolWriter:     poolWriterMock,
+			PairCreator:    pairCreatorMock,
+			GameModeReader: gameModeReaderMock,
+		}
+
+		pairs, _, err := uc.MatchBatch(context.Background(), pool)
+
+		require.NoError(t, err)
+		assert.Len(t, pairs, 2)
+		assert.Equal(t, 1, pool.Len())
+		pairCreatorMock.AssertExpectations(t)
+		poolWriterMock.AssertExpectations(t)
+
+		// the next batch is only due after the tick of the game mode
+		pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New()})
+
+		pairs, _, err = uc.MatchBatch(context.Background(), pool)
+
+		require.NoError(t, err)
+		assert.Empty(t, pairs)
+		assert.Equal(t, 2, pool.Len())
+	})
+
+	t.Run("Returns The Groups Left To The Pool When A Pair Cannot Be Created", func(t *testing.T) {
+		pool := newBatchPool(newPoolEntries(uuid.New(), uuid.New(), uuid.New(), uuid.New())...)
+
+		poolWriterMock := &mocks.MockPoolWriter{}
+		poolWriterMock.On("Save", pool).Return(pool, nil).Once()
+
+		pairCreatorMock := &mocks.MockPairCreator{}
+		pairCreatorMock.On("Execute", mock.Anything, mock.Anything, mock.Anything).Return(pairing_entities.NewPair(2, common.ResourceOwner{}), nil).Once()
+		pairCreatorMock.On("Execute", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+
+		uc := usecases.AddAndFindNextPairUseCase{
+			PoolWriter:     poolWriterMock,
+			PairCreator:    pairCreatorMock,
+			GameModeReader: gameModeReaderMock,
+		}
+
+		pairs, _, err := uc.MatchBatch(context.Background(), pool)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Len(t, pairs, 1)
+		assert.Equal(t, 2, pool.Len())
+		poolWriterMock.AssertExpectations(t)
+	})
+
+	t.Run("Leaves Greedy Game Modes To FindNextPair", func(t *testing.T) {
+		pool := newBatchPool(newPoolEntries(uuid.New(), uuid.New())...)
+		pool.Criteria = pairing_value_objects.Criteria{PairSize: 2}
+
+		uc := usecases.AddAndFindNextPairUseCase{GameModeReader: gameModeReaderMock}
+
+		pairs, _, err := uc.MatchBatch(context.Background(), pool)
+
+		require.NoError(t, err)
+		assert.Empty(t, pairs)
+		assert.Equal(t, 2, pool.Len())
+	})
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_UsesTheStrategyOfTheGameMode(t *testing.T) {
+	gameModeID := uuid.New()
+	criteria := pairing_value_objects.Criteria{GameModeID: &gameModeID, PairSize: 2}
+
+	// newest proposes the parties that joined last, unlike every built-in strategy
+	strategies := pairing_entities.NewStrategyRegistry()
+	strategies.Register("newest", func(_ *pairing_entities.StrategyRegistry, _ game_entities.MatchStrategySettings, _ pairing_entities.StrategyBase) (pairing_entities.MatchStrategy, error) {
+		return newestStrategy{}, nil
+	})
+
+	tests := []struct {
+		name     string
+		strategy string
+		waiting  int // entry left in the pool
+	}{
+		{name: "Looks The Strategy Up In The Registry", strategy: "newest", waiting: 0},
+		{name: "Falls Back To The Default Strategy When Unknown", strategy: "unknown", waiting: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutex := &sync.Mutex{}
+			pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+			entries := newPoolEntries(uuid.New(), uuid.New(), uuid.New())
+			for _, entry := range entries {
+				pool.Join(entry)
+			}
+
+			gameModeReaderMock := &mocks.MockPortGameModeReader{}
+			gameModeReaderMock.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+				Matchmaking: game_entities.MatchmakingSettings{Strategy: &game_entities.MatchStrategySettings{Name: tt.strategy}},
+			}, nil)
+
+			poolWriterMock := &mocks.MockPoolWriter{}
+			poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+			pairCreatorMock := &mocks.MockPairCreator{}
+			pairCreatorMock.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.Anything).Return(pairing_entities.NewPair(2, common.ResourceOwner{}), nil)
+
+			uc := usecases.AddAndFindNextPairUseCase{
+				PoolWriter:     poolWriterMock,
+				PairCreator:    pairCreatorMock,
+				GameModeReader: gameModeReaderMock,
+				Strategies:     strategies,
+			}
+
+			pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+			require.NoError(t, err)
+			require.NotNil(t, pair)
+			require.Len(t, pool.Entries, 1)
+			assert.Equal(t, entries[tt.waiting].PartyID, pool.Entries[0].PartyID)
+		})
+	}
+}
+
+// newestStrategy proposes the entries that joined last
+type newestStrategy struct{}
+
+func (newestStrategy) Candidates(entries []pairing_entities.PoolEntry, qty int) []pairing_entities.MatchCandidate {
+	if len(entries) < qty {
+		return nil
+	}
+
+	indexes := make([]int, 0, qty)
+	for i := len(entries) - qty; i < len(entries); i++ {
+		indexes = append(indexes, i)
+	}
+
+	return []pairing_entities.MatchCandidate{{Indexes: indexes, Quality: 1}}
+}
+
+func TestAddAndFindNextPairUseCase_FindNextPair_ReportsTheQualityOfTheMatch(t *testing.T) {
+	criteria := pairing_value_objects.Criteria{PairSize: 2}
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	// both parties are available on mondays, sharing the hour from 19:00 to 20:00
+	evening := func(from, to int) *schedule_entities.Schedule {
+		day := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return &schedule_entities.Schedule{ID: uuid.New(), Options: map[int]schedule_entities.DateOption{0: {
+			Weekdays:   []time.Weekday{time.Monday},
+			Days:       []int{0},
+			TimeFrames: []schedule_entities.TimeFrame{{Start: day.Add(time.Duration(from) * time.Hour), End: day.Add(time.Duration(to) * time.Hour)}},
+		}}}
+	}
+
+	entries := newPoolEntries(uuid.New(), uuid.New())
+	entries[0].MMR, entries[1].MMR = 1000, 1150
+	entries[0].JoinedAt = time.Now().Add(-time.Minute)
+	entries[0].Criteria.Schedule, entries[1].Criteria.Schedule = evening(18, 20), evening(19, 22)
+	for _, entry := range entries {
+		pool.Join(entry)
+	}
+
+	poolWriterMock := &mocks.MockPoolWriter{}
+	poolWriterMock.On("Save", mock.Anything).Return(pool, nil)
+
+	// the quality is reported on the draft the pair is created from
+	created := &pairing_entities.Pair{}
+	pairCreatorMock := &mocks.MockPairCreator{}
+	pairCreatorMock.On("Execute", mock.Anything, mock.AnythingOfType("[]uuid.UUID"), mock.MatchedBy(func(p *pairing_entities.Pair) bool { return p.Quality != nil })).
+		Run(func(args mock.Arguments) { *created = *args.Get(2).(*pairing_entities.Pair) }).
+		Return(created, nil)
+
+	uc := usecases.AddAndFindNextPairUseCase{
+		PoolWriter:  poolWriterMock,
+		PairCreator: pairCreatorMock,
+	}
+
+	pair, _, err := uc.FindNextPair(context.Background(), pool, criteria)
+
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	require.NotNil(t, pair.Quality)
+	assert.Equal(t, 150, pair.Quality.MMRSpread)
+	require.NotNil(t, pair.Quality.ScheduleOverlapMinutes)
+	assert.Equal(t, 60, *pair.Quality.ScheduleOverlapMinutes)
+	require.Len(t, pair.Quality.LongestWaitSeconds, 2)
+	assert.GreaterOrEqual(t, pair.Quality.LongestWaitSeconds[0], 60)
+	assert.Positive(t, pair.Quality.Score.Total)
+	pairCreatorMock.AssertExpectations(t)
+}
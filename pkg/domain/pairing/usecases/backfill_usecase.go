@@ -0,0 +1,207 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	parties_entities "github.com/leet-gaming/match-making-api/pkg/domain/parties/entities"
+	parties_out "github.com/leet-gaming/match-making-api/pkg/domain/parties/ports/out"
+)
+
+// DefaultBackfillWindow is how long a match waits for replacements when no window is configured
+const DefaultBackfillWindow = 2 * time.Minute
+
+var ErrBackfillUnavailable = errors.New("pair cannot be backfilled: the pool it was matched in is unknown")
+
+// BackfillUseCase replaces the players who leave a match in progress with queued parties that opted into backfill.
+// The seats left are posted as backfill slots in the pool the pair was matched in, where they are served before
+// regular matches.
+type BackfillUseCase struct {
+	PairReader  pairing_out.PairReader
+	PairWriter  pairing_out.PairWriter
+	PoolReader  pairing_out.PoolReader
+	PoolWriter  pairing_out.PoolWriter
+	PartyReader parties_out.PartyReader // Optional: if nil, backfilled parties are added to the pair without their details
+	Window      time.Duration           // Optional: if zero, DefaultBackfillWindow is used
+}
+
+// BackfilledPair is a pair whose open seats were taken by the given parties
+type BackfilledPair struct {
+	Pair     *pairing_entities.Pair
+	PartyIDs []uuid.UUID
+}
+
+// Request takes the players off the pair and opens a slot, targeting the team's average MMR, for every team they
+// left. Returns the updated pair and the pool holding the slots; the pool is nil when none of the players was in
+// the pair. Fails with ErrBackfillUnavailable when the pair does not know its pool.
+func (uc *BackfillUseCase) Request(ctx context.Context, pairID uuid.UUID, playerIDs ...uuid.UUID) (*pairing_entities.Pair, *pairing_entities.Pool, error) {
+	pair, err := uc.PairReader.GetByID(ctx, pairID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("BackfillUseCase.Request: unable to get pair %v: %w", pairID, err)
+	}
+
+	if pair == nil {
+		return nil, nil, fmt.Errorf("BackfillUseCase.Request: pair %v not found", pairID)
+	}
+
+	if pair.Criteria == nil {
+		return nil, nil, fmt.Errorf("BackfillUseCase.Request: pair %v: %w", pairID, ErrBackfillUnavailable)
+	}
+
+	seats := pair.RemovePlayers(playerIDs...)
+	if len(seats) == 0 {
+		return pair, nil, nil
+	}
+
+	pool, err := uc.PoolReader.FindPool(pair.Criteria)
+	if err != nil {
+		return nil, nil, fmt.Errorf("BackfillUseCase.Request: unable to find pool of pair %v: %w", pairID, err)
+	}
+
+	if pool == nil {
+		return nil, nil, fmt.Errorf("BackfillUseCase.Request: pool %v of pair %v: %w", pair.Criteria.PoolKey(), pairID, ErrBackfillUnavailable)
+	}
+
+	pair, err = uc.PairWriter.Save(pair)
+	if err != nil {
+		return nil, nil, fmt.Errorf("BackfillUseCase.Request: unable to save pair %v: %w", pairID, err)
+	}
+
+	now := time.Now()
+
+	slots := make([]pairing_entities.BackfillSlot, 0, len(seats))
+	for _, team := range pair.Teams {
+		if seats[team.ID] == 0 {
+			continue
+		}
+
+		slots = append(slots, pairing_entities.BackfillSlot{
+			ID:          uuid.New(),
+			PairID:      pair.ID,
+			TeamID:      team.ID,
+			Seats:       seats[team.ID],
+			TargetMMR:   team.AverageMMR,
+			RequestedAt: now,
+			ExpiresAt:   now.Add(uc.window()),
+		})
+	}
+
+	pool.RequestBackfill(slots...)
+
+	pool, err = uc.PoolWriter.Save(pool)
+	if err != nil {
+		return nil, nil, fmt.Errorf("BackfillUseCase.Request: unable to save pool %v: %w", pair.Criteria.PoolKey(), err)
+	}
+
+	slog.InfoContext(ctx, "backfill requested", "pair_id", pair.ID, "player_ids", playerIDs, "pool_key", pool.Key, "slots", len(slots))
+
+	return pair, pool, nil
+}
+
+// FillSlots seats the queued parties that opted into backfill in the open slots of the pool. Returns the pairs
+// that got new players. Parties whose pair cannot be updated go back to the pool at their original position, and
+// the seats they took open again in their slot.
+func (uc *BackfillUseCase) FillSlots(ctx context.Context, pool *pairing_entities.Pool) ([]BackfilledPair, error) {
+	opened := len(pool.OpenSlots())
+	if opened == 0 {
+		return nil, nil
+	}
+
+	fills := pool.TakeBackfill(time.Now())
+	if len(fills) == 0 && len(pool.OpenSlots()) == opened {
+		return nil, nil
+	}
+
+	// fills come slot by slot, so every pair is updated once with all of its new parties
+	var pairIDs []uuid.UUID
+	byPair := make(map[uuid.UUID][]pairing_entities.BackfillFill)
+	for _, fill := range fills {
+		if _, ok := byPair[fill.Slot.PairID]; !ok {
+			pairIDs = append(pairIDs, fill.Slot.PairID)
+		}
+
+		byPair[fill.Slot.PairID] = append(byPair[fill.Slot.PairID], fill)
+	}
+
+	backfilled := make([]BackfilledPair, 0, len(pairIDs))
+	for _, pairID := range pairIDs {
+		pair, err := uc.backfill(ctx, pairID, byPair[pairID])
+		if err != nil {
+			entries := make([]pairing_entities.PoolEntry, 0, len(byPair[pairID]))
+			for _, fill := range byPair[pairID] {
+				entries = append(entries, fill.Entry)
+			}
+
+			pool.ReturnBackfill(byPair[pairID]...)
+
+			slog.ErrorContext(ctx, "unable to backfill pair, parties and seats returned to the pool", "pair_id", pairID, "party_ids", pairing_entities.PartyIDs(entries), "error", err)
+			continue
+		}
+
+		backfilled = append(backfilled, *pair)
+	}
+
+	if _, err := uc.PoolWriter.Save(pool); err != nil {
+		return nil, fmt.Errorf("BackfillUseCase.FillSlots: unable to save pool %v: %w", pool.Key, err)
+	}
+
+	return backfilled, nil
+}
+
+// backfill seats the parties of the fills in the pair and saves it
+func (uc *BackfillUseCase) backfill(ctx context.Context, pairID uuid.UUID, fills []pairing_entities.BackfillFill) (*BackfilledPair, error) {
+	pair, err := uc.PairReader.GetByID(ctx, pairID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pair %v: %w", pairID, err)
+	}
+
+	if pair == nil {
+		return nil, fmt.Errorf("pair %v not found", pairID)
+	}
+
+	partyIDs := make([]uuid.UUID, 0, len(fills))
+	for _, fill := range fills {
+		if err := pair.Backfill(fill.Slot.TeamID, fill.Entry, uc.partyOf(fill.Entry.PartyID)); err != nil {
+			return nil, fmt.Errorf("unable to seat party %v in team %v: %w", fill.Entry.PartyID, fill.Slot.TeamID, err)
+		}
+
+		partyIDs = append(partyIDs, fill.Entry.PartyID)
+	}
+
+	pair, err = uc.PairWriter.Save(pair)
+	if err != nil {
+		return nil, fmt.Errorf("unable to save pair %v: %w", pairID, err)
+	}
+
+	slog.InfoContext(ctx, "pair backfilled", "pair_id", pairID, "party_ids", partyIDs)
+
+	return &BackfilledPair{Pair: pair, PartyIDs: partyIDs}, nil
+}
+
+// partyOf returns the party details, or nil when they cannot be read
+func (uc *BackfillUseCase) partyOf(partyID uuid.UUID) *parties_entities.Party {
+	if uc.PartyReader == nil {
+		return nil
+	}
+
+	party, err := uc.PartyReader.GetByID(partyID)
+	if err != nil {
+		return nil
+	}
+
+	return party
+}
+
+func (uc *BackfillUseCase) window() time.Duration {
+	if uc.Window <= 0 {
+		return DefaultBackfillWindow
+	}
+
+	return uc.Window
+}
@@ -0,0 +1,229 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+// newLivePair builds a pair of two solo players per team, matched in a pool with the given criteria
+func newLivePair(criteria pairing_value_objects.Criteria) (*pairing_entities.Pair, *pairing_entities.Pool) {
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), criteria)
+
+	pair := pairing_entities.NewPair(4, common.ResourceOwner{})
+	pair.Criteria = &criteria
+
+	for i, mmr := range []int{1500, 1200} {
+		players := []uuid.UUID{uuid.New(), uuid.New()}
+		pair.Teams = append(pair.Teams, pairing_entities.Team{
+			ID:         uuid.New(),
+			Name:       []string{"Team A", "Team B"}[i],
+			PartyIDs:   players,
+			PlayerIDs:  players,
+			AverageMMR: mmr,
+		})
+
+		for _, playerID := range players {
+			pair.Match[playerID] = nil
+		}
+	}
+
+	return pair, pool
+}
+
+func TestBackfillUseCase_Request(t *testing.T) {
+	ctx := context.Background()
+	criteria := pairing_value_objects.Criteria{PairSize: 4}
+
+	t.Run("Opens A Slot Targeting The MMR Of The Team Left", func(t *testing.T) {
+		pair, pool := newLivePair(criteria)
+		leaver := pair.Teams[1].PlayerIDs[0]
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Save", pair).Return(pair, nil)
+
+		poolReader := &mocks.MockPoolReader{}
+		poolReader.On("FindPool", pair.Criteria).Return(pool, nil)
+
+		poolWriter := &mocks.MockPoolWriter{}
+		poolWriter.On("Save", pool).Return(pool, nil)
+
+		uc := usecases.BackfillUseCase{PairReader: pairReader, PairWriter: pairWriter, PoolReader: poolReader, PoolWriter: poolWriter}
+
+		updated, slotted, err := uc.Request(ctx, pair.ID, leaver)
+
+		require.NoError(t, err)
+		assert.NotContains(t, updated.Teams[1].PlayerIDs, leaver)
+		assert.Same(t, pool, slotted)
+
+		slots := pool.OpenSlots()
+		require.Len(t, slots, 1)
+		assert.Equal(t, pair.Teams[1].ID, slots[0].TeamID)
+		assert.Equal(t, 1, slots[0].Seats)
+		assert.Equal(t, 1200, slots[0].TargetMMR)
+		assert.WithinDuration(t, time.Now().Add(usecases.DefaultBackfillWindow), slots[0].ExpiresAt, time.Second)
+		pairWriter.AssertExpectations(t)
+		poolWriter.AssertExpectations(t)
+	})
+
+	t.Run("Does Nothing When The Players Are Not In The Pair", func(t *testing.T) {
+		pair, _ := newLivePair(criteria)
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		uc := usecases.BackfillUseCase{PairReader: pairReader}
+
+		_, pool, err := uc.Request(ctx, pair.ID, uuid.New())
+
+		require.NoError(t, err)
+		assert.Nil(t, pool)
+	})
+
+	t.Run("Fails When The Pair Does Not Know Its Pool", func(t *testing.T) {
+		pair, _ := newLivePair(criteria)
+		pair.Criteria = nil
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		uc := usecases.BackfillUseCase{PairReader: pairReader}
+
+		_, _, err := uc.Request(ctx, pair.ID, pair.Teams[0].PlayerIDs[0])
+
+		assert.ErrorIs(t, err, usecases.ErrBackfillUnavailable)
+	})
+}
+
+func TestBackfillUseCase_FillSlots(t *testing.T) {
+	ctx := context.Background()
+	criteria := pairing_value_objects.Criteria{PairSize: 4}
+
+	openSlot := func(pair *pairing_entities.Pair, pool *pairing_entities.Pool) pairing_entities.BackfillSlot {
+		team := pair.Teams[0]
+		pair.RemovePlayers(team.PlayerIDs[0])
+
+		slot := pairing_entities.BackfillSlot{
+			ID:        uuid.New(),
+			PairID:    pair.ID,
+			TeamID:    team.ID,
+			Seats:     1,
+			TargetMMR: team.AverageMMR,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		pool.RequestBackfill(slot)
+
+		return slot
+	}
+
+	t.Run("Seats The Replacement In The Pair", func(t *testing.T) {
+		pair, pool := newLivePair(criteria)
+		openSlot(pair, pool)
+
+		replacement := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: 1500, Backfill: true}
+		pool.Join(replacement)
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Save", pair).Return(pair, nil)
+
+		poolWriter := &mocks.MockPoolWriter{}
+		poolWriter.On("Save", pool).Return(pool, nil)
+
+		uc := usecases.BackfillUseCase{PairReader: pairReader, PairWriter: pairWriter, PoolWriter: poolWriter}
+
+		backfilled, err := uc.FillSlots(ctx, pool)
+
+		require.NoError(t, err)
+		require.Len(t, backfilled, 1)
+		assert.Equal(t, []uuid.UUID{replacement.PartyID}, backfilled[0].PartyIDs)
+		assert.Contains(t, backfilled[0].Pair.Teams[0].PlayerIDs, replacement.PartyID)
+		assert.Equal(t, 0, pool.Len())
+		assert.Empty(t, pool.OpenSlots())
+		poolWriter.AssertExpectations(t)
+	})
+
+	t.Run("Returns The Replacement To The Pool When The Pair Cannot Be Updated", func(t *testing.T) {
+		pair, pool := newLivePair(criteria)
+		slot := openSlot(pair, pool)
+
+		replacement := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: 1500, Backfill: true}
+		pool.Join(replacement)
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(nil, errors.New("db down"))
+
+		poolWriter := &mocks.MockPoolWriter{}
+		poolWriter.On("Save", pool).Return(pool, nil)
+
+		uc := usecases.BackfillUseCase{PairReader: pairReader, PoolWriter: poolWriter}
+
+		backfilled, err := uc.FillSlots(ctx, pool)
+
+		require.NoError(t, err)
+		assert.Empty(t, backfilled)
+		assert.Equal(t, []uuid.UUID{replacement.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+		assert.Equal(t, []pairing_entities.BackfillSlot{slot}, pool.OpenSlots())
+	})
+
+	t.Run("Reopens The Seats When The Pair Cannot Be Saved", func(t *testing.T) {
+		pair, pool := newLivePair(criteria)
+		slot := openSlot(pair, pool)
+
+		replacement := pairing_entities.PoolEntry{PartyID: uuid.New(), MMR: 1500, Backfill: true}
+		pool.Join(replacement)
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Save", pair).Return(nil, errors.New("db down"))
+
+		poolWriter := &mocks.MockPoolWriter{}
+		poolWriter.On("Save", pool).Return(pool, nil)
+
+		uc := usecases.BackfillUseCase{PairReader: pairReader, PairWriter: pairWriter, PoolWriter: poolWriter}
+
+		backfilled, err := uc.FillSlots(ctx, pool)
+
+		require.NoError(t, err)
+		assert.Empty(t, backfilled)
+		assert.Equal(t, []uuid.UUID{replacement.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+		assert.Equal(t, []pairing_entities.BackfillSlot{slot}, pool.OpenSlots())
+		poolWriter.AssertExpectations(t)
+	})
+
+	t.Run("Leaves The Pool Untouched Without Open Slots", func(t *testing.T) {
+		_, pool := newLivePair(criteria)
+		pool.Join(pairing_entities.PoolEntry{PartyID: uuid.New(), Backfill: true})
+
+		poolWriter := &mocks.MockPoolWriter{}
+
+		uc := usecases.BackfillUseCase{PoolWriter: poolWriter}
+
+		backfilled, err := uc.FillSlots(ctx, pool)
+
+		require.NoError(t, err)
+		assert.Empty(t, backfilled)
+		poolWriter.AssertNotCalled(t, "Save", mock.Anything)
+	})
+}
@@ -23,9 +23,16 @@ type CreatePairUseCase struct {
 	ConflictVerifier ConflictVerifier // Optional: if nil, conflict checking is skipped
 }
 
-func (uc *CreatePairUseCase) Execute(ctx context.Context, partyIDs []uuid.UUID) (*pairing_entities.Pair, error) {
+// Execute adds the parties to the draft and saves it, owned by the resource owner of the context. A nil draft creates
+// a bare pair.
+func (uc *CreatePairUseCase) Execute(ctx context.Context, partyIDs []uuid.UUID, draft *pairing_entities.Pair) (*pairing_entities.Pair, error) {
 	resourceOwner := common.GetResourceOwner(ctx)
-	pair := pairing_entities.NewPair(len(partyIDs), resourceOwner)
+
+	pair := draft
+	if pair == nil {
+		pair = pairing_entities.NewPair(len(partyIDs), resourceOwner)
+	}
+	pair.ResourceOwner = resourceOwner
 
 	for _, partyID := range partyIDs {
 		party, err := uc.PartyReader.GetByID(partyID)
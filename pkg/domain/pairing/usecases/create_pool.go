@@ -1,19 +1,28 @@
 package usecases
 
 import (
+	"sync"
+
 	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_in "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/in"
 	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
 )
 
 type CreatePoolUseCase struct{}
 
-type CreatePoolPayload struct {
-	Criteria *pairing_value_objects.Criteria
+// NewCreatePoolUseCase creates a new instance of CreatePoolUseCase
+func NewCreatePoolUseCase() pairing_in.PoolInitiator {
+	return &CreatePoolUseCase{}
 }
 
-func (uc *CreatePoolUseCase) Execute(p *CreatePoolPayload) (*pairing_entities.Pool, error) {
+// Execute creates an empty pool keyed by the given criteria (see Criteria.PoolKey).
+// The pool is not persisted here; callers save it through a PoolWriter once a party joins.
+func (uc *CreatePoolUseCase) Execute(c pairing_value_objects.Criteria) (*pairing_entities.Pool, error) {
 
 	// definir estrategia de sharding (ie, daily pool, week range pool, onlinepool)
 
-	return nil, nil
+	mutex := &sync.Mutex{}
+	cond := sync.NewCond(mutex)
+
+	return pairing_entities.NewPool(mutex, cond, c), nil
 }
@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/kafka"
+)
+
+var ErrMatchForbidden = errors.New("only administrators can move matches through their lifecycle")
+
+// MatchLifecyclePublisher defines the interface for publishing the transitions of matches
+type MatchLifecyclePublisher interface {
+	PublishMatchLifecycle(ctx context.Context, event *kafka.MatchEvent) error
+}
+
+// matchLifecycleEvents maps the statuses a match moves to with the event published for the transition
+var matchLifecycleEvents = map[pairing_entities.MatchStatus]string{
+	pairing_entities.MatchStarted:   kafka.EventTypeMatchStarted,
+	pairing_entities.MatchCompleted: kafka.EventTypeMatchCompleted,
+	pairing_entities.MatchCancelled: kafka.EventTypeMatchCancelled,
+}
+
+// MatchLifecycleUseCase moves matches from created to started, and then to completed or cancelled. Every transition
+// is published as a MatchEvent; transitions the lifecycle does not allow are rejected with ErrInvalidTransition.
+type MatchLifecycleUseCase struct {
+	PairReader pairing_out.PairReader
+	PairWriter pairing_out.PairWriter
+	Publisher  MatchLifecyclePublisher // Optional: if nil, transitions are not published
+}
+
+// Get returns the match. Players can only see the matches they play in.
+func (uc *MatchLifecycleUseCase) Get(ctx context.Context, pairID uuid.UUID) (*pairing_entities.Pair, error) {
+	pair, err := uc.PairReader.GetByID(ctx, pairID)
+	if err != nil {
+		return nil, fmt.Errorf("MatchLifecycleUseCase.Get: unable to get pair %v: %w", pairID, err)
+	}
+
+	currentUserID, _ := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !slices.Contains(playersOf(pair), currentUserID) && !common.IsAdmin(ctx) {
+		return nil, fmt.Errorf("MatchLifecycleUseCase.Get: %w", ErrMatchForbidden)
+	}
+
+	return pair, nil
+}
+
+// Start marks the match as started. Matches waiting on their ready check cannot start.
+func (uc *MatchLifecycleUseCase) Start(ctx context.Context, pairID uuid.UUID) (*pairing_entities.Pair, error) {
+	if !common.IsAdmin(ctx) {
+		return nil, fmt.Errorf("MatchLifecycleUseCase.Start: %w", ErrMatchForbidden)
+	}
+
+	pair, _, err := uc.Apply(ctx, pairID, pairing_entities.MatchStarted, "")
+
+	return pair, err
+}
+
+// Complete marks the started match as completed
+func (uc *MatchLifecycleUseCase) Complete(ctx context.Context, pairID uuid.UUID) (*pairing_entities.Pair, error) {
+	if !common.IsAdmin(ctx) {
+		return nil, fmt.Errorf("MatchLifecycleUseCase.Complete: %w", ErrMatchForbidden)
+	}
+
+	pair, _, err := uc.Apply(ctx, pairID, pairing_entities.MatchCompleted, "")
+
+	return pair, err
+}
+
+// Cancel cancels the match, started or not, for the given reason
+func (uc *MatchLifecycleUseCase) Cancel(ctx context.Context, pairID uuid.UUID, reason string) (*pairing_entities.Pair, error) {
+	if !common.IsAdmin(ctx) {
+		return nil, fmt.Errorf("MatchLifecycleUseCase.Cancel: %w", ErrMatchForbidden)
+	}
+
+	pair, _, err := uc.Apply(ctx, pairID, pairing_entities.MatchCancelled, reason)
+
+	return pair, err
+}
+
+// Apply moves the match to the status, on behalf of the game servers reporting it through the match results. The
+// reason is only kept for cancellations. Moving a match to the status it already has changes nothing and publishes
+// nothing, so results delivered twice are harmless. Returns whether the status changed, so callers can skip the side
+// effects of a transition they already handled.
+func (uc *MatchLifecycleUseCase) Apply(ctx context.Context, pairID uuid.UUID, status pairing_entities.MatchStatus, reason string) (*pairing_entities.Pair, bool, error) {
+	pair, err := uc.PairReader.GetByID(ctx, pairID)
+	if err != nil {
+		return nil, false, fmt.Errorf("MatchLifecycleUseCase.Apply: unable to get pair %v: %w", pairID, err)
+	}
+
+	from := pair.Lifecycle()
+	now := time.Now()
+
+	var changed bool
+	switch status {
+	case pairing_entities.MatchStarted:
+		changed, err = pair.Start(now)
+	case pairing_entities.MatchCompleted:
+		changed, err = pair.Complete(now)
+	case pairing_entities.MatchCancelled:
+		changed, err = pair.Cancel(reason, now)
+	default:
+		err = fmt.Errorf("pair %v cannot go from %s to %s: %w", pairID, from, status, pairing_entities.ErrInvalidTransition)
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("MatchLifecycleUseCase.Apply: %w", err)
+	}
+
+	if !changed {
+		return pair, false, nil
+	}
+
+	saved, err := uc.PairWriter.Save(pair)
+	if err != nil {
+		return nil, false, fmt.Errorf("MatchLifecycleUseCase.Apply: unable to save pair %v: %w", pairID, err)
+	}
+
+	slog.InfoContext(ctx, "match status changed", "pair_id", pairID, "from", from, "to", status, "reason", reason)
+
+	uc.publish(ctx, saved)
+
+	return saved, true, nil
+}
+
+// publish publishes the event of the status the match just moved to. Failures are logged only, so the transition,
+// already saved, is not failed.
+func (uc *MatchLifecycleUseCase) publish(ctx context.Context, pair *pairing_entities.Pair) {
+	if uc.Publisher == nil {
+		return
+	}
+
+	var gameType, region string
+	if pair.Criteria != nil {
+		gameType, region = describeCriteria(*pair.Criteria)
+	}
+
+	var metadata map[string]string
+	if pair.CancelReason != "" {
+		metadata = map[string]string{kafka.MetadataCancelReason: pair.CancelReason}
+	}
+
+	event := &kafka.MatchEvent{
+		MatchID:   pair.ID,
+		LobbyID:   pair.ID, // Assuming lobby ID is the pair ID for now
+		EventType: matchLifecycleEvents[pair.Lifecycle()],
+		GameType:  gameType,
+		GameMode:  gameModeOf(pair),
+		Region:    regionOf(pair, region),
+		PlayerIDs: playersOf(pair),
+		Teams:     teamsOf(pair),
+		Metadata:  metadata,
+	}
+	if err := uc.Publisher.PublishMatchLifecycle(ctx, event); err != nil {
+		slog.ErrorContext(ctx, "Failed to publish match lifecycle event", "error", err, "event_type", event.EventType, "pair_id", pair.ID)
+	}
+}
@@ -0,0 +1,132 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	"github.com/leet-gaming/match-making-api/pkg/infra/kafka"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+func TestMatchLifecycleUseCase(t *testing.T) {
+	ctx := context.Background()
+	admin := context.WithValue(ctx, common.AudienceKey, common.TenantAudienceIDKey)
+
+	newUseCase := func(pair *pairing_entities.Pair) (*usecases.MatchLifecycleUseCase, *mocks.MockPortPairWriter, *MockEventPublisher) {
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", mock.Anything, pair.ID).Return(pair, nil)
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Save", pair).Return(pair, nil)
+
+		publisher := &MockEventPublisher{}
+
+		return &usecases.MatchLifecycleUseCase{PairReader: pairReader, PairWriter: pairWriter, Publisher: publisher}, pairWriter, publisher
+	}
+
+	newPair := func() *pairing_entities.Pair {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+		pair.Teams = []pairing_entities.Team{{ID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}, {ID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}}
+
+		return pair
+	}
+
+	t.Run("Publishes Every Transition", func(t *testing.T) {
+		pair := newPair()
+		uc, pairWriter, publisher := newUseCase(pair)
+
+		publisher.On("PublishMatchLifecycle", admin, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			return e.EventType == kafka.EventTypeMatchStarted && e.MatchID == pair.ID && len(e.PlayerIDs) == 2 && len(e.Teams) == 2
+		})).Return(nil).Once()
+		publisher.On("PublishMatchLifecycle", admin, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			return e.EventType == kafka.EventTypeMatchCompleted && e.MatchID == pair.ID
+		})).Return(nil).Once()
+
+		started, err := uc.Start(admin, pair.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pairing_entities.MatchStarted, started.Lifecycle())
+
+		completed, err := uc.Complete(admin, pair.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pairing_entities.MatchCompleted, completed.Lifecycle())
+
+		pairWriter.AssertNumberOfCalls(t, "Save", 2)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("Publishes The Cancel Reason", func(t *testing.T) {
+		pair := newPair()
+		uc, _, publisher := newUseCase(pair)
+
+		publisher.On("PublishMatchLifecycle", admin, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			return e.EventType == kafka.EventTypeMatchCancelled && e.Metadata[kafka.MetadataCancelReason] == "server crashed"
+		})).Return(nil).Once()
+
+		cancelled, err := uc.Cancel(admin, pair.ID, "server crashed")
+		require.NoError(t, err)
+		assert.Equal(t, "server crashed", cancelled.CancelReason)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("Rejects Invalid Transitions", func(t *testing.T) {
+		pair := newPair()
+		uc, pairWriter, publisher := newUseCase(pair)
+
+		_, err := uc.Complete(admin, pair.ID)
+
+		assert.ErrorIs(t, err, pairing_entities.ErrInvalidTransition)
+		pairWriter.AssertNotCalled(t, "Save", mock.Anything)
+		publisher.AssertNotCalled(t, "PublishMatchLifecycle", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Publishes Nothing When The Status Does Not Change", func(t *testing.T) {
+		pair := newPair()
+		_, err := pair.Start(pair.CreatedAt)
+		require.NoError(t, err)
+
+		uc, pairWriter, publisher := newUseCase(pair)
+
+		updated, changed, err := uc.Apply(ctx, pair.ID, pairing_entities.MatchStarted, "")
+
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, pair, updated)
+		pairWriter.AssertNotCalled(t, "Save", mock.Anything)
+		publisher.AssertNotCalled(t, "PublishMatchLifecycle", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Only Lets Administrators Move Matches", func(t *testing.T) {
+		pair := newPair()
+		uc, pairWriter, _ := newUseCase(pair)
+
+		_, err := uc.Start(ctx, pair.ID)
+		assert.ErrorIs(t, err, usecases.ErrMatchForbidden)
+
+		_, err = uc.Cancel(ctx, pair.ID, "no show")
+		assert.ErrorIs(t, err, usecases.ErrMatchForbidden)
+		pairWriter.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	t.Run("Only Shows Matches To Their Players", func(t *testing.T) {
+		pair := newPair()
+		uc, _, _ := newUseCase(pair)
+
+		player := context.WithValue(ctx, common.UserIDKey, pair.Teams[0].PlayerIDs[0])
+		stranger := context.WithValue(ctx, common.UserIDKey, uuid.New())
+
+		found, err := uc.Get(player, pair.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pair.ID, found.ID)
+
+		_, err = uc.Get(stranger, pair.ID)
+		assert.ErrorIs(t, err, usecases.ErrMatchForbidden)
+	})
+}
@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+)
+
+var ErrMatchQualityForbidden = errors.New("only administrators can query the quality of matches")
+
+// MatchQualityQuery selects the matches whose quality is summed up
+type MatchQualityQuery struct {
+	GameModeID *uuid.UUID // Optional: if nil, matches of every game mode are summed up
+	Since      time.Time  // matches created from then on; zero does not bound the query
+	Until      time.Time  // matches created before then; zero does not bound the query
+}
+
+// MatchQualityUseCase sums up the quality reports of the matches made (see QualityReport), so matchmaking parameters
+// can be tuned from data
+type MatchQualityUseCase struct {
+	PairReader pairing_out.PairReader
+}
+
+// Summarize sums up the quality of the matches the query selects. Admin only.
+func (uc *MatchQualityUseCase) Summarize(ctx context.Context, query MatchQualityQuery) (pairing_entities.QualitySummary, error) {
+	if !common.IsAdmin(ctx) {
+		return pairing_entities.QualitySummary{}, fmt.Errorf("MatchQualityUseCase.Summarize: %w", ErrMatchQualityForbidden)
+	}
+
+	pairs, err := uc.PairReader.FindCreatedBetween(ctx, query.GameModeID, query.Since, query.Until)
+	if err != nil {
+		return pairing_entities.QualitySummary{}, fmt.Errorf("MatchQualityUseCase.Summarize: unable to find matches: %w", err)
+	}
+
+	return pairing_entities.SummarizeQuality(pairs), nil
+}
@@ -0,0 +1,51 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+func TestMatchQualityUseCase_Summarize(t *testing.T) {
+	admin := context.WithValue(context.Background(), common.AudienceKey, common.TenantAudienceIDKey)
+	player := context.WithValue(context.Background(), common.UserIDKey, uuid.New())
+
+	gameModeID := uuid.New()
+	query := usecases.MatchQualityQuery{GameModeID: &gameModeID, Since: time.Now().Add(-24 * time.Hour)}
+
+	t.Run("Sums Up The Quality Of The Matches Selected", func(t *testing.T) {
+		reader := &mocks.MockPortPairReader{}
+		reader.On("FindCreatedBetween", admin, &gameModeID, query.Since, time.Time{}).Return([]*pairing_entities.Pair{
+			{Quality: &pairing_entities.QualityReport{MMRSpread: 100}},
+			{Quality: &pairing_entities.QualityReport{MMRSpread: 200}},
+		}, nil)
+
+		uc := usecases.MatchQualityUseCase{PairReader: reader}
+
+		summary, err := uc.Summarize(admin, query)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.Matches)
+		assert.InDelta(t, 150, summary.AverageMMRSpread, 0.001)
+	})
+
+	t.Run("Only Admins Query The Quality Of Matches", func(t *testing.T) {
+		reader := &mocks.MockPortPairReader{}
+
+		uc := usecases.MatchQualityUseCase{PairReader: reader}
+
+		_, err := uc.Summarize(player, query)
+
+		assert.ErrorIs(t, err, usecases.ErrMatchQualityForbidden)
+		reader.AssertNotCalled(t, "FindCreatedBetween")
+	})
+}
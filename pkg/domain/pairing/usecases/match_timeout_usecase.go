@@ -0,0 +1,149 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	game_out "github.com/leet-gaming/match-making-api/pkg/domain/game/ports/out"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+)
+
+// DefaultMatchTimeoutInterval is how often RunTimeouts looks for matches running past their MaxDuration when no
+// interval is given
+const DefaultMatchTimeoutInterval = time.Minute
+
+// MatchNotifier defines the interface for notifying players about their matches
+type MatchNotifier interface {
+	Execute(ctx context.Context, payload SendNotificationPayload) (*pairing_entities.Notification, error)
+}
+
+// MatchTimeoutUseCase enforces the MaxDuration of games: matches still running past it are cancelled as timed out,
+// which publishes MATCH_CANCELLED, and their players are notified.
+type MatchTimeoutUseCase struct {
+	GameReader game_out.GameReader
+	PairReader pairing_out.PairReader
+	Lifecycle  MatchLifecycleExecutor
+	Notifier   MatchNotifier // Optional: if nil, players are not told their match timed out
+}
+
+// TimeOutMatches cancels every match running past the MaxDuration of its game. Returns the matches it cancelled.
+func (uc *MatchTimeoutUseCase) TimeOutMatches(ctx context.Context) ([]*pairing_entities.Pair, error) {
+	now := time.Now()
+
+	games, err := uc.GameReader.Search(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("MatchTimeoutUseCase.TimeOutMatches: unable to find games: %w", err)
+	}
+
+	var timedOut []*pairing_entities.Pair
+	for _, game := range games {
+		if game.MaxDuration <= 0 {
+			continue
+		}
+
+		pairs, err := uc.PairReader.FindStartedBefore(ctx, game.ID, now.Add(-game.MaxDuration))
+		if err != nil {
+			return timedOut, fmt.Errorf("MatchTimeoutUseCase.TimeOutMatches: unable to find matches of game %v: %w", game.ID, err)
+		}
+
+		for _, pair := range pairs {
+			if !pair.HasTimedOut(game.MaxDuration, now) {
+				continue
+			}
+
+			cancelled, changed, err := uc.Lifecycle.Apply(ctx, pair.ID, pairing_entities.MatchCancelled, pairing_entities.CancelReasonTimedOut)
+			if errors.Is(err, pairing_entities.ErrInvalidTransition) {
+				// the match ended while we were looking at it
+				continue
+			}
+
+			if err != nil {
+				slog.ErrorContext(ctx, "unable to time out match", "pair_id", pair.ID, "error", err)
+				continue
+			}
+
+			if !changed {
+				// the match was cancelled while we were looking at it
+				continue
+			}
+
+			slog.InfoContext(ctx, "match timed out", "pair_id", pair.ID, "game_id", game.ID, "started_at", pair.StartedAt, "max_duration", game.MaxDuration)
+
+			uc.notify(ctx, cancelled, game)
+			timedOut = append(timedOut, cancelled)
+		}
+	}
+
+	return timedOut, nil
+}
+
+// RunTimeouts calls TimeOutMatches on every tick of the given interval until the context is done
+func (uc *MatchTimeoutUseCase) RunTimeouts(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultMatchTimeoutInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.TimeOutMatches(ctx); err != nil {
+				slog.ErrorContext(ctx, "Failed to time out matches", "error", err)
+			}
+		}
+	}
+}
+
+// notify tells every player of the match it was cancelled for running too long. Notifications are sent on behalf of
+// the owner of the match; failures, such as players having disabled the notification, are logged only.
+func (uc *MatchTimeoutUseCase) notify(ctx context.Context, pair *pairing_entities.Pair, game *game_entities.Game) {
+	if uc.Notifier == nil {
+		return
+	}
+
+	ownerCtx := ownerContext(ctx, pair.ResourceOwner)
+
+	for _, playerID := range playersOf(pair) {
+		_, err := uc.Notifier.Execute(ownerCtx, SendNotificationPayload{
+			UserID:  playerID,
+			Channel: pairing_entities.NotificationChannelInApp,
+			Type:    pairing_entities.NotificationTypeEventCancellation,
+			Title:   "Match cancelled",
+			Message: fmt.Sprintf("Your %s match was cancelled after running longer than %v.", game.Name, game.MaxDuration),
+			Metadata: map[string]interface{}{
+				"match_id": pair.ID.String(),
+				"reason":   pairing_entities.CancelReasonTimedOut,
+			},
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "unable to notify player of match timeout", "pair_id", pair.ID, "player_id", playerID, "error", err)
+		}
+	}
+}
+
+// ownerContext returns the context of the owner of a resource, for work done in the background on their behalf.
+// Resources without owner are handled on behalf of the default tenant, as requests without one are.
+func ownerContext(ctx context.Context, owner common.ResourceOwner) context.Context {
+	if owner.IsMissingTenant() {
+		owner.TenantID = common.TeamPROTenantID
+		owner.ClientID = common.TeamPROAppClientID
+	}
+
+	ctx = context.WithValue(ctx, common.TenantIDKey, owner.TenantID)
+	if owner.ClientID != uuid.Nil {
+		ctx = context.WithValue(ctx, common.ClientIDKey, owner.ClientID)
+	}
+
+	return ctx
+}
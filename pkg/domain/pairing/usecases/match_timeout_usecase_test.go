@@ -0,0 +1,109 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+// MockNotifier is a mock implementation of MatchNotifier
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Execute(ctx context.Context, payload usecases.SendNotificationPayload) (*pairing_entities.Notification, error) {
+	args := m.Called(ctx, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pairing_entities.Notification), args.Error(1)
+}
+
+func TestMatchTimeoutUseCase_TimeOutMatches(t *testing.T) {
+	ctx := context.Background()
+
+	game := &game_entities.Game{BaseEntity: common.BaseEntity{ID: uuid.New()}, Name: "Arena", MaxDuration: 30 * time.Minute}
+	unlimited := &game_entities.Game{BaseEntity: common.BaseEntity{ID: uuid.New()}, Name: "Sandbox"}
+
+	startedAgo := func(d time.Duration) *pairing_entities.Pair {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{TenantID: uuid.New()})
+		pair.Teams = []pairing_entities.Team{{ID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}, {ID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}}
+
+		_, err := pair.Start(time.Now().Add(-d))
+		require.NoError(t, err)
+
+		return pair
+	}
+
+	newUseCase := func(pairs ...*pairing_entities.Pair) (*usecases.MatchTimeoutUseCase, *MockLifecycle, *MockNotifier) {
+		gameReader := &mocks.MockPortGameReader{}
+		gameReader.On("Search", ctx, nil).Return([]*game_entities.Game{game, unlimited}, nil)
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("FindStartedBefore", ctx, game.ID, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) >= game.MaxDuration
+		})).Return(pairs, nil)
+
+		lifecycle, notifier := &MockLifecycle{}, &MockNotifier{}
+
+		return &usecases.MatchTimeoutUseCase{GameReader: gameReader, PairReader: pairReader, Lifecycle: lifecycle, Notifier: notifier}, lifecycle, notifier
+	}
+
+	t.Run("Cancels Matches Running Past The MaxDuration Of Their Game", func(t *testing.T) {
+		pair := startedAgo(time.Hour)
+		uc, lifecycle, notifier := newUseCase(pair)
+
+		lifecycle.On("Apply", ctx, pair.ID, pairing_entities.MatchCancelled, pairing_entities.CancelReasonTimedOut).Return(pair, true, nil).Once()
+		notifier.On("Execute", mock.MatchedBy(func(ctx context.Context) bool {
+			return common.GetResourceOwner(ctx).TenantID == pair.ResourceOwner.TenantID
+		}), mock.MatchedBy(func(payload usecases.SendNotificationPayload) bool {
+			return payload.Type == pairing_entities.NotificationTypeEventCancellation && payload.Metadata["match_id"] == pair.ID.String()
+		})).Return(&pairing_entities.Notification{}, nil).Twice()
+
+		timedOut, err := uc.TimeOutMatches(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*pairing_entities.Pair{pair}, timedOut)
+		lifecycle.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("Skips Matches That Ended Meanwhile", func(t *testing.T) {
+		pair := startedAgo(time.Hour)
+		uc, lifecycle, notifier := newUseCase(pair)
+
+		lifecycle.On("Apply", ctx, pair.ID, pairing_entities.MatchCancelled, pairing_entities.CancelReasonTimedOut).Return(nil, false, pairing_entities.ErrInvalidTransition).Once()
+
+		timedOut, err := uc.TimeOutMatches(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, timedOut)
+		notifier.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Keeps Timing Out When A Player Cannot Be Notified", func(t *testing.T) {
+		first, second := startedAgo(time.Hour), startedAgo(2*time.Hour)
+		uc, lifecycle, notifier := newUseCase(first, second)
+
+		lifecycle.On("Apply", ctx, first.ID, pairing_entities.MatchCancelled, pairing_entities.CancelReasonTimedOut).Return(first, true, nil).Once()
+		lifecycle.On("Apply", ctx, second.ID, pairing_entities.MatchCancelled, pairing_entities.CancelReasonTimedOut).Return(second, true, nil).Once()
+		notifier.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.New("notifications disabled"))
+
+		timedOut, err := uc.TimeOutMatches(ctx)
+
+		require.NoError(t, err)
+		assert.Len(t, timedOut, 2)
+	})
+}
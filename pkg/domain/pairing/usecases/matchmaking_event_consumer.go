@@ -2,25 +2,87 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
 	game_out "github.com/leet-gaming/match-making-api/pkg/domain/game/ports/out"
 	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
 	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
 	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
 	"github.com/leet-gaming/match-making-api/pkg/infra/kafka"
 )
 
 // AddAndFindNextPairExecutor defines the interface for adding and finding pairs
 type AddAndFindNextPairExecutor interface {
-	Execute(payload FindPairPayload) (*pairing_entities.Pair, *pairing_entities.Pool, int, error)
+	Execute(ctx context.Context, payload FindPairPayload) (*pairing_entities.Pair, *pairing_entities.Pool, int, error)
+	FindNextPair(ctx context.Context, pool *pairing_entities.Pool, c pairing_value_objects.Criteria) (*pairing_entities.Pair, *pairing_entities.Pool, error)
+}
+
+// BatchMatchExecutor defines the interface for matching the parties of a pool in batches
+type BatchMatchExecutor interface {
+	MatchBatch(ctx context.Context, pool *pairing_entities.Pool) ([]*pairing_entities.Pair, *pairing_entities.Pool, error)
+}
+
+// DefaultPoolReevaluationInterval is how often waiting parties are re-evaluated when no interval is configured
+const DefaultPoolReevaluationInterval = 5 * time.Second
+
+// ReadyCheckExecutor defines the interface for answering and expiring ready checks
+type ReadyCheckExecutor interface {
+	Respond(ctx context.Context, pairID uuid.UUID, playerID uuid.UUID, accepted bool) (*pairing_entities.Pair, error)
+	ExpireReadyChecks(ctx context.Context) ([]*pairing_entities.Pair, error)
+	Leave(ctx context.Context, partyID uuid.UUID, playerID uuid.UUID) (*pairing_entities.Pair, error)
+}
+
+// PenaltyExecutor defines the interface for recording offenses and enforcing queue cooldowns
+type PenaltyExecutor interface {
+	RecordOffense(ctx context.Context, offense pairing_entities.OffenseType, pairID uuid.UUID, playerIDs ...uuid.UUID) error
+	EnsureCanQueue(ctx context.Context, playerIDs ...uuid.UUID) error
+}
+
+// BackfillExecutor defines the interface for opening backfill slots and seating queued parties in them
+type BackfillExecutor interface {
+	Request(ctx context.Context, pairID uuid.UUID, playerIDs ...uuid.UUID) (*pairing_entities.Pair, *pairing_entities.Pool, error)
+	FillSlots(ctx context.Context, pool *pairing_entities.Pool) ([]BackfilledPair, error)
+}
+
+// SubscriptionPriorityExecutor defines the interface for setting the subscription tier and priority of a party
+type SubscriptionPriorityExecutor interface {
+	Apply(ctx context.Context, playerID uuid.UUID, c *pairing_value_objects.Criteria) error
+}
+
+// RatingExecutor defines the interface for rating players from match results and reading their MMR
+type RatingExecutor interface {
+	RecordMatch(ctx context.Context, outcome ratings_entities.MatchOutcome) ([]*ratings_entities.PlayerRating, error)
+	MMR(ctx context.Context, gameID uuid.UUID, gameModeID *uuid.UUID, playerIDs ...uuid.UUID) (int, error)
+}
+
+// PlayerRolesExecutor defines the interface for resolving the roles players accept to play
+type PlayerRolesExecutor interface {
+	Resolve(ctx context.Context, queued map[uuid.UUID][]string, playerIDs ...uuid.UUID) (map[uuid.UUID][]string, error)
+}
+
+// BlockListExecutor defines the interface for reading the players the players of a party blocked
+type BlockListExecutor interface {
+	Blocked(ctx context.Context, playerIDs ...uuid.UUID) (map[uuid.UUID]pairing_entities.BlockScope, error)
+}
+
+// MatchLifecycleExecutor defines the interface for moving matches through their lifecycle
+type MatchLifecycleExecutor interface {
+	Apply(ctx context.Context, pairID uuid.UUID, status pairing_entities.MatchStatus, reason string) (*pairing_entities.Pair, bool, error)
 }
 
 // EventPublisherInterface defines the interface for publishing events
 type EventPublisherInterface interface {
 	PublishMatchCreated(ctx context.Context, event *kafka.MatchEvent) error
+	PublishLobbyEvent(ctx context.Context, event *kafka.LobbyEvent) error
 }
 
 // MatchmakingEventConsumer consumes events from replay-api and processes them
@@ -30,6 +92,15 @@ type MatchmakingEventConsumer struct {
 	regionReader       game_out.RegionReader
 	poolReader         pairing_out.PoolReader
 	poolWriter         pairing_out.PoolWriter
+	readyCheck         ReadyCheckExecutor           // Optional: if nil, ready check answers are ignored
+	penalties          PenaltyExecutor              // Optional: if nil, offenses go unpunished
+	backfill           BackfillExecutor             // Optional: if nil, players leaving a match are not replaced
+	priority           SubscriptionPriorityExecutor // Optional: if nil, parties queue without tier nor priority boost
+	ratings            RatingExecutor               // Optional: if nil, match results are not rated and parties queue with the MMR of their event
+	lifecycle          MatchLifecycleExecutor       // Optional: if nil, match results do not move pairs through their lifecycle
+	roles              PlayerRolesExecutor          // Optional: if nil, players queue with the roles of their event only
+	blocks             BlockListExecutor            // Optional: if nil, parties queue without their players' avoid lists
+	batch              BatchMatchExecutor           // Optional: if nil, pools of game modes matching in batches are never matched
 }
 
 // NewMatchmakingEventConsumer creates a new consumer for matchmaking events
@@ -49,6 +120,60 @@ func NewMatchmakingEventConsumer(
 	}
 }
 
+// WithReadyCheck sets the use case handling READY_STATUS_CHANGED lobby events and expiring ready checks
+func (c *MatchmakingEventConsumer) WithReadyCheck(readyCheck ReadyCheckExecutor) *MatchmakingEventConsumer {
+	c.readyCheck = readyCheck
+	return c
+}
+
+// WithPenalties sets the use case punishing dodges and abandons, and rejecting queue joins during a cooldown
+func (c *MatchmakingEventConsumer) WithPenalties(penalties PenaltyExecutor) *MatchmakingEventConsumer {
+	c.penalties = penalties
+	return c
+}
+
+// WithBackfill sets the use case replacing the players who leave a match in progress with queued parties
+func (c *MatchmakingEventConsumer) WithBackfill(backfill BackfillExecutor) *MatchmakingEventConsumer {
+	c.backfill = backfill
+	return c
+}
+
+// WithSubscriptionPriority sets the use case giving parties the tier and priority boost of their subscription
+func (c *MatchmakingEventConsumer) WithSubscriptionPriority(priority SubscriptionPriorityExecutor) *MatchmakingEventConsumer {
+	c.priority = priority
+	return c
+}
+
+// WithRatings sets the use case rating players from match results, and giving an MMR to parties queueing without one
+func (c *MatchmakingEventConsumer) WithRatings(ratings RatingExecutor) *MatchmakingEventConsumer {
+	c.ratings = ratings
+	return c
+}
+
+// WithPlayerRoles sets the use case resolving the roles of the players queueing without them
+func (c *MatchmakingEventConsumer) WithPlayerRoles(roles PlayerRolesExecutor) *MatchmakingEventConsumer {
+	c.roles = roles
+	return c
+}
+
+// WithBlockList sets the use case reading the avoid lists parties queue with
+func (c *MatchmakingEventConsumer) WithBlockList(blocks BlockListExecutor) *MatchmakingEventConsumer {
+	c.blocks = blocks
+	return c
+}
+
+// WithBatchMatcher sets the use case matching the pools of game modes matching in batches, on pool re-evaluation
+func (c *MatchmakingEventConsumer) WithBatchMatcher(batch BatchMatchExecutor) *MatchmakingEventConsumer {
+	c.batch = batch
+	return c
+}
+
+// WithLifecycle sets the use case moving pairs through their lifecycle as the match results report them
+func (c *MatchmakingEventConsumer) WithLifecycle(lifecycle MatchLifecycleExecutor) *MatchmakingEventConsumer {
+	c.lifecycle = lifecycle
+	return c
+}
+
 // HandleQueueEvent processes queue join/leave events
 func (c *MatchmakingEventConsumer) HandleQueueEvent(ctx context.Context, event *kafka.QueueEvent) error {
 	slog.InfoContext(ctx, "Processing queue event",
@@ -86,6 +211,10 @@ func (c *MatchmakingEventConsumer) HandleLobbyEvent(ctx context.Context, event *
 			"game_type", event.GameType,
 			"region", event.Region,
 			"avg_mmr", event.AvgMMR)
+	case kafka.EventTypePlayerLeft:
+		return c.handlePlayerLeft(ctx, event)
+	case kafka.EventTypeReadyStatusChanged:
+		return c.handleReadyStatusChanged(ctx, event)
 	default:
 		slog.WarnContext(ctx, "Unknown lobby event type", "event_type", event.EventType)
 	}
@@ -107,32 +236,76 @@ func (c *MatchmakingEventConsumer) handleQueueJoined(ctx context.Context, event
 		return err
 	}
 
-	// Lookup region by slug
-	regions, err := c.regionReader.Search(ctx, map[string]interface{}{"slug": event.Region})
+	region, err := c.queueRegion(ctx, event)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to lookup region", "region_slug", event.Region, "error", err)
 		return err
 	}
-	if len(regions) == 0 {
-		slog.ErrorContext(ctx, "Region not found", "region_slug", event.Region)
-		return fmt.Errorf("region not found: %s", event.Region)
+
+	gameModeID, err := parseGameModeID(event)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid game mode UUID", "game_mode", event.GameMode, "error", err)
+		return err
 	}
-	region := regions[0]
-	
+
+	partyID, players := partyOf(event)
+
+	if c.penalties != nil {
+		err := c.penalties.EnsureCanQueue(ctx, players...)
+		if errors.Is(err, pairing_entities.ErrQueueCooldown) {
+			slog.WarnContext(ctx, "Queue join rejected, party is serving a cooldown", "player_id", event.PlayerID, "party_id", partyID, "reason", err)
+			return nil
+		}
+
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to check queue cooldowns", "error", err, "player_id", event.PlayerID)
+			return err
+		}
+	}
+
+	var blocked map[uuid.UUID]pairing_entities.BlockScope
+	if c.blocks != nil {
+		// queueing without the avoid lists could match players with the ones they blocked, so the join is retried
+		blocked, err = c.blocks.Blocked(ctx, players...)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to read avoid lists", "error", err, "player_id", event.PlayerID)
+			return err
+		}
+	}
+
+	mmr := c.queueMMR(ctx, event, gameID, gameModeID, players)
+
 	payload := FindPairPayload{
-		PartyID: event.PlayerID,
+		PartyID:   partyID,
+		PartySize: len(players),
+		PlayerIDs: players,
+		MMR:       mmr,
+		Pings:     event.Pings,
+		JoinedAt:  queuedAt(event),
+		Backfill:  event.Backfill,
+		Roles:     c.queueRoles(ctx, event, players),
+		Blocks:    blocked,
 		Criteria: pairing_value_objects.Criteria{
-			GameID: &gameID,
-			Region: region,
-			PairSize: 2, // Default to 1v1 for now
+			GameID:         &gameID,
+			GameModeID:     gameModeID,
+			Region:         region,
+			PairSize:       DefaultPairSize, // only used when the game does not cap its teams
+			MaxPing:        event.MaxPing,
+			MapPreferences: event.Maps,
 			SkillRange: &pairing_value_objects.SkillRange{
-				MinMMR: event.MMR - 200,
-				MaxMMR: event.MMR + 200,
+				MinMMR: mmr - 200,
+				MaxMMR: mmr + 200,
 			},
 		},
 	}
 
-	pair, pool, position, err := c.addAndFindNextPair.Execute(payload)
+	// the subscription of the player queueing the party sets its priority; billing being down must not block the queue
+	if c.priority != nil {
+		if err := c.priority.Apply(ctx, event.PlayerID, &payload.Criteria); err != nil {
+			slog.WarnContext(ctx, "Unable to resolve subscription, queueing without priority", "player_id", event.PlayerID, "error", err)
+		}
+	}
+
+	pair, pool, position, err := c.addAndFindNextPair.Execute(ctx, payload)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to add player to matchmaking pool", "error", err, "player_id", event.PlayerID)
 		return err
@@ -144,67 +317,141 @@ func (c *MatchmakingEventConsumer) handleQueueJoined(ctx context.Context, event
 			"players", pair.Match,
 			"player_id", event.PlayerID)
 
-		// Extract player IDs from the pair
-		playerIDs := make([]uuid.UUID, 0, len(pair.Match))
-		for playerID := range pair.Match {
-			playerIDs = append(playerIDs, playerID)
-		}
-
-		// Publish match created event
-		matchEvent := &kafka.MatchEvent{
-			MatchID:   pair.ID,
-			LobbyID:   pair.ID, // Assuming lobby ID is the pair ID for now
-			EventType: kafka.EventTypeMatchCreated,
-			GameType:  event.GameType,
-			Region:    event.Region,
-			PlayerIDs: playerIDs,
-		}
-		if err := c.eventPublisher.PublishMatchCreated(ctx, matchEvent); err != nil {
-			slog.ErrorContext(ctx, "Failed to publish match created event", "error", err, "pair_id", pair.ID)
-			// Don't return error to avoid failing the matchmaking process
-		}
+		c.announcePair(ctx, pair, event.GameType, event.Region)
 	} else {
 		slog.InfoContext(ctx, "Player added to pool",
-			"pool_size", len(pool.Parties),
+			"pool_size", pool.Len(),
 			"position", position,
 			"player_id", event.PlayerID)
+
+		// the party may be the replacement an open backfill slot is waiting for
+		if event.Backfill {
+			c.fillSlots(ctx, pool)
+		}
 	}
 
 	return nil
 }
 
-// handleQueueLeft processes a player leaving the matchmaking queue
-func (c *MatchmakingEventConsumer) handleQueueLeft(ctx context.Context, event *kafka.QueueEvent) error {
-	slog.InfoContext(ctx, "Player left matchmaking queue",
-		"player_id", event.PlayerID,
-		"game_type", event.GameType)
+// queueMMR returns the MMR the party queues with: the one of the event, or the rating of its players when the event
+// carries none. Ratings being unavailable must not block the queue.
+func (c *MatchmakingEventConsumer) queueMMR(ctx context.Context, event *kafka.QueueEvent, gameID uuid.UUID, gameModeID *uuid.UUID, players []uuid.UUID) int {
+	if event.MMR != 0 || c.ratings == nil {
+		return event.MMR
+	}
 
-	gameID, err := uuid.Parse(event.GameType)
+	mmr, err := c.ratings.MMR(ctx, gameID, gameModeID, players...)
 	if err != nil {
-		slog.ErrorContext(ctx, "Invalid game type UUID", "game_type", event.GameType, "error", err)
-		return err
+		slog.WarnContext(ctx, "Unable to resolve ratings, queueing without MMR", "player_id", event.PlayerID, "error", err)
+		return event.MMR
+	}
+
+	return mmr
+}
+
+// queueRoles returns the roles each player queues with: the ones of the event, or the roles of their profile when the
+// event carries none for them. Profiles being unavailable must not block the queue.
+func (c *MatchmakingEventConsumer) queueRoles(ctx context.Context, event *kafka.QueueEvent, players []uuid.UUID) map[uuid.UUID][]string {
+	if c.roles == nil {
+		return event.Roles
+	}
+
+	roles, err := c.roles.Resolve(ctx, event.Roles, players...)
+	if err != nil {
+		slog.WarnContext(ctx, "Unable to resolve player roles, queueing with the roles of the event", "player_id", event.PlayerID, "error", err)
+		return event.Roles
+	}
+
+	return roles
+}
+
+// queueRegion looks up the region the party queues in by its slug. Parties reporting their pings queue in the
+// region-agnostic pool instead, where the region of each match is chosen by latency; nil is returned for them.
+func (c *MatchmakingEventConsumer) queueRegion(ctx context.Context, event *kafka.QueueEvent) (*game_entities.Region, error) {
+	if len(event.Pings) > 0 {
+		return nil, nil
 	}
 
-	// Lookup region by slug
 	regions, err := c.regionReader.Search(ctx, map[string]interface{}{"slug": event.Region})
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to lookup region", "region_slug", event.Region, "error", err)
-		return err
+		return nil, err
 	}
 	if len(regions) == 0 {
 		slog.ErrorContext(ctx, "Region not found", "region_slug", event.Region)
-		return fmt.Errorf("region not found: %s", event.Region)
+		return nil, fmt.Errorf("region not found: %s", event.Region)
+	}
+
+	return regions[0], nil
+}
+
+// parseGameModeID returns the game mode of the event, or nil when the queue is not mode specific
+func parseGameModeID(event *kafka.QueueEvent) (*uuid.UUID, error) {
+	if event.GameMode == "" {
+		return nil, nil
+	}
+
+	gameModeID, err := uuid.Parse(event.GameMode)
+	if err != nil {
+		return nil, err
 	}
-	region := regions[0]
 
-	criteria := pairing_value_objects.Criteria{
-		GameID:   &gameID,
-		Region:   region,
-		PairSize: 2, // Assuming same pair size
+	return &gameModeID, nil
+}
+
+// partyOf returns the party queueing with the event and its players. Players queueing alone are a party of one,
+// identified by their own ID.
+func partyOf(event *kafka.QueueEvent) (uuid.UUID, []uuid.UUID) {
+	if event.PartyID == uuid.Nil {
+		return event.PlayerID, []uuid.UUID{event.PlayerID}
+	}
+
+	players := event.PartyMembers
+	if !slices.Contains(players, event.PlayerID) {
+		players = append([]uuid.UUID{event.PlayerID}, players...)
 	}
 
-	// Find the pool
-	pool, err := c.poolReader.FindPool(&criteria)
+	return event.PartyID, players
+}
+
+// queuedAt returns when the player started queueing according to the event, or now when the event has no queue time
+func queuedAt(event *kafka.QueueEvent) time.Time {
+	if event.QueueTime <= 0 {
+		return time.Now()
+	}
+
+	return time.UnixMilli(event.QueueTime)
+}
+
+// handleQueueLeft processes a player leaving the matchmaking queue
+func (c *MatchmakingEventConsumer) handleQueueLeft(ctx context.Context, event *kafka.QueueEvent) error {
+	slog.InfoContext(ctx, "Player left matchmaking queue",
+		"player_id", event.PlayerID,
+		"game_type", event.GameType)
+
+	partyID, _ := partyOf(event)
+
+	// leaving while the match found waits on the ready check declines it
+	if c.readyCheck != nil {
+		pair, err := c.readyCheck.Leave(ctx, partyID, event.PlayerID)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to leave the ready check", "error", err, "player_id", event.PlayerID)
+			return err
+		}
+
+		if pair != nil {
+			slog.WarnContext(ctx, "Player left the queue after a match was found", "pair_id", pair.ID, "player_id", event.PlayerID)
+
+			c.penalize(ctx, pairing_entities.OffenseQueueLeftAfterMatchFound, pair.ID, event.PlayerID)
+			c.publishReadyCheckOutcome(ctx, pair)
+
+			return nil
+		}
+	}
+
+	// the pool is looked up by party: the region the event names may differ from the one the party was queued in,
+	// e.g. when its pings changed since it joined
+	pool, err := c.queuedPool(partyID)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to find pool for removal", "error", err, "player_id", event.PlayerID)
 		return err
@@ -214,8 +461,8 @@ func (c *MatchmakingEventConsumer) handleQueueLeft(ctx context.Context, event *k
 		return nil // Player not in any pool
 	}
 
-	// Remove the player from the pool
-	_, err = pool.Remove(event.PlayerID)
+	// Remove the player's party from the pool
+	_, err = pool.Remove(partyID)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to remove player from pool", "error", err, "player_id", event.PlayerID)
 		return err
@@ -231,4 +478,586 @@ func (c *MatchmakingEventConsumer) handleQueueLeft(ctx context.Context, event *k
 	slog.InfoContext(ctx, "Player removed from matchmaking pool", "player_id", event.PlayerID)
 
 	return nil
-}
\ No newline at end of file
+}
+
+// queuedPool returns the pool the party waits in, or nil when it is not queued
+func (c *MatchmakingEventConsumer) queuedPool(partyID uuid.UUID) (*pairing_entities.Pool, error) {
+	pools, err := c.poolReader.ListPools()
+	if err != nil {
+		return nil, fmt.Errorf("MatchmakingEventConsumer.queuedPool: unable to list pools: %w", err)
+	}
+
+	for _, pool := range pools {
+		if _, queued := pool.IsQueued(partyID); queued {
+			return pool, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ReevaluatePools tries to form pairs out of the parties already waiting in every pool, so that relaxed
+// skill and ping windows are applied even when nobody new joins. Pools of game modes matching in batches are matched
+// here too, when their batch tick is due. Returns how many pairs were created.
+func (c *MatchmakingEventConsumer) ReevaluatePools(ctx context.Context) (int, error) {
+	pools, err := c.poolReader.ListPools()
+	if err != nil {
+		return 0, fmt.Errorf("MatchmakingEventConsumer.ReevaluatePools: unable to list pools: %w", err)
+	}
+
+	created := 0
+	for _, pool := range pools {
+		// backfill slots are served first, before their candidates are matched with each other
+		c.fillSlots(ctx, pool)
+
+		created += c.matchBatch(ctx, pool)
+
+		for {
+			pair, _, err := c.addAndFindNextPair.FindNextPair(ctx, pool, pool.Criteria)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to re-evaluate pool", "error", err, "pool_key", pool.Key)
+				break
+			}
+
+			if pair == nil {
+				break
+			}
+
+			created++
+
+			slog.InfoContext(ctx, "Match found on pool re-evaluation", "pair_id", pair.ID, "pool_key", pool.Key)
+
+			gameType, region := describeCriteria(pool.Criteria)
+			c.announcePair(ctx, pair, gameType, region)
+		}
+	}
+
+	return created, nil
+}
+
+// matchBatch matches the pool in a batch, when its game mode matches in batches and its tick is due, announcing every
+// pair created. Returns how many pairs were created; failures are logged only.
+func (c *MatchmakingEventConsumer) matchBatch(ctx context.Context, pool *pairing_entities.Pool) int {
+	if c.batch == nil {
+		return 0
+	}
+
+	pairs, _, err := c.batch.MatchBatch(ctx, pool)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to match pool in batch", "error", err, "pool_key", pool.Key)
+	}
+
+	gameType, region := describeCriteria(pool.Criteria)
+	for _, pair := range pairs {
+		slog.InfoContext(ctx, "Match found in batch", "pair_id", pair.ID, "pool_key", pool.Key)
+
+		c.announcePair(ctx, pair, gameType, region)
+	}
+
+	return len(pairs)
+}
+
+// handlePlayerLeft opens backfill slots for the seats the players left in the lobby's match, and tries to fill
+// them right away with the parties already queued
+func (c *MatchmakingEventConsumer) handlePlayerLeft(ctx context.Context, event *kafka.LobbyEvent) error {
+	slog.InfoContext(ctx, "Players left lobby",
+		"lobby_id", event.LobbyID,
+		"player_ids", event.PlayerIDs,
+		"game_type", event.GameType,
+		"region", event.Region)
+
+	if c.backfill == nil || len(event.PlayerIDs) == 0 {
+		return nil
+	}
+
+	_, pool, err := c.backfill.Request(ctx, event.LobbyID, event.PlayerIDs...)
+	if errors.Is(err, ErrBackfillUnavailable) {
+		slog.WarnContext(ctx, "Match cannot be backfilled", "lobby_id", event.LobbyID, "reason", err)
+		return nil
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to request backfill", "error", err, "lobby_id", event.LobbyID)
+		return err
+	}
+
+	if pool != nil {
+		c.fillSlots(ctx, pool)
+	}
+
+	return nil
+}
+
+// fillSlots seats queued parties in the open backfill slots of the pool, publishing LOBBY_UPDATED with the new
+// roster of every pair backfilled. Failures are logged only, so matchmaking is not failed.
+func (c *MatchmakingEventConsumer) fillSlots(ctx context.Context, pool *pairing_entities.Pool) {
+	if c.backfill == nil || pool == nil {
+		return
+	}
+
+	backfilled, err := c.backfill.FillSlots(ctx, pool)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to fill backfill slots", "error", err, "pool_key", pool.Key)
+		return
+	}
+
+	for _, b := range backfilled {
+		gameType, region := describeCriteria(pool.Criteria)
+		c.publishLobbyEvent(ctx, b.Pair, kafka.EventTypeLobbyUpdated, gameType, region, map[string]string{
+			kafka.MetadataBackfilledPartyIDs: joinUUIDs(b.PartyIDs),
+		})
+	}
+}
+
+// RunPoolReevaluation calls ReevaluatePools on every tick of the given interval until the context is done.
+// Expired ready checks are closed first, so the parties they return to the pools are matched on the same tick.
+func (c *MatchmakingEventConsumer) RunPoolReevaluation(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultPoolReevaluationInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.ExpireReadyChecks(ctx); err != nil {
+				slog.ErrorContext(ctx, "Failed to expire ready checks", "error", err)
+			}
+
+			if _, err := c.ReevaluatePools(ctx); err != nil {
+				slog.ErrorContext(ctx, "Failed to re-evaluate matchmaking pools", "error", err)
+			}
+		}
+	}
+}
+
+// announcePair publishes MatchCreated for a confirmed pair, or asks its players to accept it when the pair is
+// waiting on a ready check
+func (c *MatchmakingEventConsumer) announcePair(ctx context.Context, pair *pairing_entities.Pair, gameType, region string) {
+	if pair.IsConfirmed() {
+		c.publishMatchCreated(ctx, pair, gameType, region)
+		return
+	}
+
+	c.publishLobbyEvent(ctx, pair, kafka.EventTypeLobbyCreated, gameType, region, map[string]string{
+		kafka.MetadataReadyCheckExpiresAt: strconv.FormatInt(pair.ReadyCheck.ExpiresAt.UnixMilli(), 10),
+	})
+}
+
+// handleReadyStatusChanged records the players' answer to the ready check of the lobby's pair
+func (c *MatchmakingEventConsumer) handleReadyStatusChanged(ctx context.Context, event *kafka.LobbyEvent) error {
+	if c.readyCheck == nil {
+		slog.WarnContext(ctx, "Ready check is not enabled, ignoring ready status", "lobby_id", event.LobbyID)
+		return nil
+	}
+
+	accepted, err := strconv.ParseBool(event.Metadata[kafka.MetadataReady])
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid ready status", "lobby_id", event.LobbyID, "ready", event.Metadata[kafka.MetadataReady], "error", err)
+		return fmt.Errorf("invalid ready status %q: %w", event.Metadata[kafka.MetadataReady], err)
+	}
+
+	var pair *pairing_entities.Pair
+	for _, playerID := range event.PlayerIDs {
+		pair, err = c.readyCheck.Respond(ctx, event.LobbyID, playerID, accepted)
+		if errors.Is(err, pairing_entities.ErrReadyCheckClosed) {
+			slog.WarnContext(ctx, "Ready check already closed", "lobby_id", event.LobbyID, "player_id", playerID)
+			return nil
+		}
+
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to record ready status", "error", err, "lobby_id", event.LobbyID, "player_id", playerID)
+			return err
+		}
+	}
+
+	if pair != nil {
+		c.penalizeDodgers(ctx, pair)
+		c.publishReadyCheckOutcome(ctx, pair)
+	}
+
+	return nil
+}
+
+// ExpireReadyChecks cancels the pairs whose ready check was not completed in time. Returns how many were cancelled.
+func (c *MatchmakingEventConsumer) ExpireReadyChecks(ctx context.Context) (int, error) {
+	if c.readyCheck == nil {
+		return 0, nil
+	}
+
+	pairs, err := c.readyCheck.ExpireReadyChecks(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("MatchmakingEventConsumer.ExpireReadyChecks: %w", err)
+	}
+
+	for _, pair := range pairs {
+		c.penalizeDodgers(ctx, pair)
+		c.publishReadyCheckOutcome(ctx, pair)
+	}
+
+	return len(pairs), nil
+}
+
+// matchResultStatuses maps the events of the match results with the status they move the match to
+var matchResultStatuses = map[string]pairing_entities.MatchStatus{
+	kafka.EventTypeMatchStarted:   pairing_entities.MatchStarted,
+	kafka.EventTypeMatchCompleted: pairing_entities.MatchCompleted,
+	kafka.EventTypeMatchCancelled: pairing_entities.MatchCancelled,
+}
+
+// HandleMatchEvent processes match result events. The match is moved through its lifecycle, players reported as
+// having abandoned it are penalized, and the players of completed matches are rated from their result. Results the
+// lifecycle of the match rejects, such as the completion of a cancelled match, are ignored.
+func (c *MatchmakingEventConsumer) HandleMatchEvent(ctx context.Context, event *kafka.MatchEvent) error {
+	slog.InfoContext(ctx, "Processing match event",
+		"event_type", event.EventType,
+		"match_id", event.MatchID,
+		"game_type", event.GameType,
+		"region", event.Region)
+
+	if ok, err := c.advanceMatch(ctx, event); !ok || err != nil {
+		return err
+	}
+
+	abandoned, err := parseUUIDs(event.Metadata[kafka.MetadataAbandonedPlayerIDs])
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid abandoned player IDs", "match_id", event.MatchID, "error", err)
+		return err
+	}
+
+	if len(abandoned) > 0 && c.penalties != nil {
+		if err := c.penalties.RecordOffense(ctx, pairing_entities.OffenseMatchAbandoned, event.MatchID, abandoned...); err != nil {
+			slog.ErrorContext(ctx, "Failed to penalize players abandoning the match", "error", err, "match_id", event.MatchID)
+			return err
+		}
+	}
+
+	return c.rateMatch(ctx, event)
+}
+
+// advanceMatch moves the match of the event to the status the event reports. Returns false when the lifecycle of the
+// match rejects the event, or the match already has the status (ie, the event was delivered twice), which must then
+// be ignored. Matches this service did not create have no lifecycle to follow.
+func (c *MatchmakingEventConsumer) advanceMatch(ctx context.Context, event *kafka.MatchEvent) (bool, error) {
+	status, ok := matchResultStatuses[event.EventType]
+	if c.lifecycle == nil || !ok {
+		return true, nil
+	}
+
+	_, changed, err := c.lifecycle.Apply(ctx, event.MatchID, status, event.Metadata[kafka.MetadataCancelReason])
+	switch {
+	case err == nil && !changed:
+		slog.InfoContext(ctx, "Match already has the status of the event, skipping it", "match_id", event.MatchID, "event_type", event.EventType)
+		return false, nil
+	case err == nil:
+		return true, nil
+	case errors.Is(err, pairing_entities.ErrPairNotFound):
+		slog.WarnContext(ctx, "Match has no pair, skipping its lifecycle", "match_id", event.MatchID, "event_type", event.EventType)
+		return true, nil
+	case errors.Is(err, pairing_entities.ErrInvalidTransition), errors.Is(err, pairing_entities.ErrMatchNotConfirmed):
+		slog.WarnContext(ctx, "Match event rejected by the match lifecycle", "match_id", event.MatchID, "event_type", event.EventType, "reason", err)
+		return false, nil
+	default:
+		slog.ErrorContext(ctx, "Failed to move match through its lifecycle", "error", err, "match_id", event.MatchID, "event_type", event.EventType)
+		return false, err
+	}
+}
+
+// rateMatch rates the players of the match from its result. Matches without a result, or whose result cannot be
+// rated, are skipped.
+func (c *MatchmakingEventConsumer) rateMatch(ctx context.Context, event *kafka.MatchEvent) error {
+	if c.ratings == nil || event.Result == nil {
+		return nil
+	}
+
+	outcome, err := matchOutcomeOf(event)
+	if err != nil {
+		slog.WarnContext(ctx, "Match result cannot be rated", "match_id", event.MatchID, "error", err)
+		return nil
+	}
+
+	if _, err := c.ratings.RecordMatch(ctx, outcome); err != nil {
+		slog.ErrorContext(ctx, "Failed to rate match", "error", err, "match_id", event.MatchID)
+		return err
+	}
+
+	return nil
+}
+
+// matchOutcomeOf ranks the teams of the match from its result: every team draws when the match is a draw, teams are
+// ranked by score when every team has one, and the winner finishes ahead of everyone else otherwise
+func matchOutcomeOf(event *kafka.MatchEvent) (ratings_entities.MatchOutcome, error) {
+	gameID, err := uuid.Parse(event.GameType)
+	if err != nil {
+		return ratings_entities.MatchOutcome{}, fmt.Errorf("invalid game type %q: %w", event.GameType, err)
+	}
+
+	var gameModeID *uuid.UUID
+	if event.GameMode != "" {
+		id, err := uuid.Parse(event.GameMode)
+		if err != nil {
+			return ratings_entities.MatchOutcome{}, fmt.Errorf("invalid game mode %q: %w", event.GameMode, err)
+		}
+
+		gameModeID = &id
+	}
+
+	result := event.Result
+	if !result.IsDraw && len(result.Scores) == 0 && result.WinnerTeamID == nil {
+		return ratings_entities.MatchOutcome{}, errors.New("the result has no winner, scores nor draw")
+	}
+
+	scored := len(result.Scores) > 0
+	for _, team := range event.Teams {
+		_, ok := result.Scores[team.TeamID.String()]
+		scored = scored && ok
+	}
+
+	teams := make([]ratings_entities.TeamOutcome, 0, len(event.Teams))
+	for _, team := range event.Teams {
+		rank := 0
+		switch {
+		case result.IsDraw:
+		case scored:
+			for _, other := range event.Teams {
+				if result.Scores[other.TeamID.String()] > result.Scores[team.TeamID.String()] {
+					rank++
+				}
+			}
+		case result.WinnerTeamID != nil:
+			if *result.WinnerTeamID != team.TeamID {
+				rank = 1
+			}
+		}
+
+		teams = append(teams, ratings_entities.TeamOutcome{PlayerIDs: team.PlayerIDs, Rank: rank})
+	}
+
+	playedAt := time.Now()
+	if result.CompletedAt > 0 {
+		playedAt = time.UnixMilli(result.CompletedAt)
+	}
+
+	outcome := ratings_entities.MatchOutcome{
+		MatchID:    event.MatchID,
+		GameID:     gameID,
+		GameModeID: gameModeID,
+		Region:     event.Region,
+		Teams:      teams,
+		PlayedAt:   playedAt,
+	}
+
+	return outcome, outcome.Validate()
+}
+
+// penalizeDodgers records an offense for the players who made the pair's ready check fail
+func (c *MatchmakingEventConsumer) penalizeDodgers(ctx context.Context, pair *pairing_entities.Pair) {
+	c.penalize(ctx, pairing_entities.OffenseReadyCheckDeclined, pair.ID, pair.ReadyCheck.Offenders()...)
+}
+
+// penalize records the offense for the players. Failures are logged only, so matchmaking is not failed.
+func (c *MatchmakingEventConsumer) penalize(ctx context.Context, offense pairing_entities.OffenseType, pairID uuid.UUID, playerIDs ...uuid.UUID) {
+	if c.penalties == nil || len(playerIDs) == 0 {
+		return
+	}
+
+	if err := c.penalties.RecordOffense(ctx, offense, pairID, playerIDs...); err != nil {
+		slog.ErrorContext(ctx, "Failed to record offense", "error", err, "offense", offense, "pair_id", pairID, "player_ids", playerIDs)
+	}
+}
+
+// publishReadyCheckOutcome publishes LOBBY_READY and MatchCreated once everyone accepted the pair, or
+// LOBBY_CANCELLED when the ready check failed. Nothing is published while answers are pending.
+func (c *MatchmakingEventConsumer) publishReadyCheckOutcome(ctx context.Context, pair *pairing_entities.Pair) {
+	var gameType, region string
+	if len(pair.ReadyCheck.Entries) > 0 {
+		gameType, region = describeCriteria(pair.ReadyCheck.Entries[0].Criteria)
+	}
+
+	switch pair.ReadyCheck.Status {
+	case pairing_entities.ReadyCheckAccepted:
+		c.publishLobbyEvent(ctx, pair, kafka.EventTypeLobbyReady, gameType, region, nil)
+		c.publishMatchCreated(ctx, pair, gameType, region)
+	case pairing_entities.ReadyCheckFailed:
+		c.publishLobbyEvent(ctx, pair, kafka.EventTypeLobbyCancelled, gameType, region, map[string]string{
+			kafka.MetadataDroppedPartyIDs: joinUUIDs(pairing_entities.PartyIDs(pair.ReadyCheck.Dropped())),
+		})
+	}
+}
+
+// publishLobbyEvent publishes a lobby event for the pair. Failures are logged only, so matchmaking is not failed.
+func (c *MatchmakingEventConsumer) publishLobbyEvent(ctx context.Context, pair *pairing_entities.Pair, eventType, gameType, region string, metadata map[string]string) {
+	lobbyEvent := &kafka.LobbyEvent{
+		LobbyID:   pair.ID, // Assuming lobby ID is the pair ID for now
+		EventType: eventType,
+		PlayerIDs: playersOf(pair),
+		GameType:  gameType,
+		Region:    regionOf(pair, region),
+		Metadata:  metadata,
+	}
+	if err := c.eventPublisher.PublishLobbyEvent(ctx, lobbyEvent); err != nil {
+		slog.ErrorContext(ctx, "Failed to publish lobby event", "error", err, "event_type", eventType, "pair_id", pair.ID)
+	}
+}
+
+// publishMatchCreated publishes MatchCreated for the pair. Failures are logged only, so matchmaking is not failed.
+func (c *MatchmakingEventConsumer) publishMatchCreated(ctx context.Context, pair *pairing_entities.Pair, gameType, region string) {
+	playerIDs := playersOf(pair)
+
+	var metadata map[string]string
+	if pair.Region != "" || pair.Map != "" || pair.Quality != nil {
+		metadata = make(map[string]string, 2)
+	}
+
+	if pair.Region != "" {
+		metadata[kafka.MetadataWorstPing] = strconv.Itoa(pair.WorstPing)
+	}
+
+	if pair.Map != "" {
+		metadata[kafka.MetadataMap] = pair.Map
+	}
+
+	if pair.Quality != nil {
+		addQualityMetadata(metadata, pair.Quality)
+	}
+
+	// Publish match created event
+	matchEvent := &kafka.MatchEvent{
+		MatchID:   pair.ID,
+		LobbyID:   pair.ID, // Assuming lobby ID is the pair ID for now
+		EventType: kafka.EventTypeMatchCreated,
+		GameType:  gameType,
+		GameMode:  gameModeOf(pair),
+		Region:    regionOf(pair, region),
+		PlayerIDs: playerIDs,
+		Teams:     teamsOf(pair),
+		Metadata:  metadata,
+	}
+	if err := c.eventPublisher.PublishMatchCreated(ctx, matchEvent); err != nil {
+		slog.ErrorContext(ctx, "Failed to publish match created event", "error", err, "pair_id", pair.ID)
+	}
+}
+
+// addQualityMetadata adds the quality report of a match to the metadata of its MatchCreated event
+func addQualityMetadata(metadata map[string]string, report *pairing_entities.QualityReport) {
+	metadata[kafka.MetadataQualityScore] = strconv.FormatFloat(report.Score.Total, 'f', 3, 64)
+	metadata[kafka.MetadataMMRSpread] = strconv.Itoa(report.MMRSpread)
+	metadata[kafka.MetadataTeamBalance] = strconv.Itoa(report.TeamBalance)
+
+	if report.ScheduleOverlapMinutes != nil {
+		metadata[kafka.MetadataScheduleOverlapMinutes] = strconv.Itoa(*report.ScheduleOverlapMinutes)
+	}
+
+	waits := make([]string, len(report.LongestWaitSeconds))
+	for i, wait := range report.LongestWaitSeconds {
+		waits[i] = strconv.Itoa(wait)
+	}
+	metadata[kafka.MetadataLongestWaitSeconds] = strings.Join(waits, ",")
+
+	if len(report.Relaxations) > 0 {
+		relaxations := make([]string, len(report.Relaxations))
+		for i, relaxation := range report.Relaxations {
+			relaxations[i] = string(relaxation)
+		}
+		metadata[kafka.MetadataRelaxations] = strings.Join(relaxations, ",")
+	}
+}
+
+// teamsOf returns the teams of the pair as reported in match events
+func teamsOf(pair *pairing_entities.Pair) []kafka.TeamInfo {
+	teams := make([]kafka.TeamInfo, 0, len(pair.Teams))
+	for _, team := range pair.Teams {
+		teams = append(teams, kafka.TeamInfo{
+			TeamID:    team.ID,
+			Name:      team.Name,
+			PlayerIDs: team.PlayerIDs,
+			Roles:     team.Roles,
+		})
+	}
+
+	return teams
+}
+
+// gameModeOf returns the ID of the game mode the pair was matched in, or "" when its queue was not mode specific
+func gameModeOf(pair *pairing_entities.Pair) string {
+	if pair.Criteria == nil || pair.Criteria.GameModeID == nil {
+		return ""
+	}
+
+	return pair.Criteria.GameModeID.String()
+}
+
+// playersOf returns every player of the pair. Teams carry every player, pre-made parties included; pairs without
+// teams only know their parties.
+func playersOf(pair *pairing_entities.Pair) []uuid.UUID {
+	if len(pair.Teams) == 0 {
+		playerIDs := make([]uuid.UUID, 0, len(pair.Match))
+		for playerID := range pair.Match {
+			playerIDs = append(playerIDs, playerID)
+		}
+
+		return playerIDs
+	}
+
+	var playerIDs []uuid.UUID
+	for _, team := range pair.Teams {
+		playerIDs = append(playerIDs, team.PlayerIDs...)
+	}
+
+	return playerIDs
+}
+
+// regionOf returns the region the pair plays in: the one chosen by latency, or the given one (the region of the
+// pool it was matched in) when its parties did not report their pings
+func regionOf(pair *pairing_entities.Pair, region string) string {
+	if pair.Region != "" {
+		return pair.Region
+	}
+
+	return region
+}
+
+// describeCriteria returns the game type and region slug reported in events about pairs matched under the criteria
+func describeCriteria(c pairing_value_objects.Criteria) (gameType string, region string) {
+	if c.GameID != nil {
+		gameType = c.GameID.String()
+	}
+
+	if c.Region != nil {
+		region = c.Region.Slug
+	}
+
+	return gameType, region
+}
+
+func joinUUIDs(ids []uuid.UUID) string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+
+	return strings.Join(values, ",")
+}
+
+func parseUUIDs(value string) ([]uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	values := strings.Split(value, ",")
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, v := range values {
+		id, err := uuid.Parse(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -2,18 +2,26 @@ package usecases_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	kafkago "github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"github.com/leet-gaming/match-making-api/pkg/common"
 	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
 	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
 	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
 	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
 	parties_entities "github.com/leet-gaming/match-making-api/pkg/domain/parties/entities"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
 	"github.com/leet-gaming/match-making-api/pkg/infra/kafka"
 	"github.com/leet-gaming/match-making-api/test/mocks"
 )
@@ -23,14 +31,22 @@ type MockAddAndFindNextPairUseCase struct {
 	mock.Mock
 }
 
-func (m *MockAddAndFindNextPairUseCase) Execute(payload usecases.FindPairPayload) (*pairing_entities.Pair, *pairing_entities.Pool, int, error) {
-	args := m.Called(payload)
+func (m *MockAddAndFindNextPairUseCase) Execute(ctx context.Context, payload usecases.FindPairPayload) (*pairing_entities.Pair, *pairing_entities.Pool, int, error) {
+	args := m.Called(ctx, payload)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(*pairing_entities.Pool), args.Int(2), args.Error(3)
 	}
 	return args.Get(0).(*pairing_entities.Pair), args.Get(1).(*pairing_entities.Pool), args.Int(2), args.Error(3)
 }
 
+func (m *MockAddAndFindNextPairUseCase) FindNextPair(ctx context.Context, pool *pairing_entities.Pool, c pairing_value_objects.Criteria) (*pairing_entities.Pair, *pairing_entities.Pool, error) {
+	args := m.Called(ctx, pool, c)
+	if args.Get(0) == nil {
+		return nil, pool, args.Error(2)
+	}
+	return args.Get(0).(*pairing_entities.Pair), args.Get(1).(*pairing_entities.Pool), args.Error(2)
+}
+
 // MockEventPublisher is a mock for kafka.EventPublisher
 type MockEventPublisher struct {
 	mock.Mock
@@ -41,6 +57,162 @@ func (m *MockEventPublisher) PublishMatchCreated(ctx context.Context, event *kaf
 	return args.Error(0)
 }
 
+func (m *MockEventPublisher) PublishLobbyEvent(ctx context.Context, event *kafka.LobbyEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishMatchLifecycle(ctx context.Context, event *kafka.MatchEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// MockReadyCheck is a mock implementation of ReadyCheckExecutor
+type MockReadyCheck struct {
+	mock.Mock
+}
+
+func (m *MockReadyCheck) Respond(ctx context.Context, pairID uuid.UUID, playerID uuid.UUID, accepted bool) (*pairing_entities.Pair, error) {
+	args := m.Called(ctx, pairID, playerID, accepted)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pairing_entities.Pair), args.Error(1)
+}
+
+func (m *MockReadyCheck) ExpireReadyChecks(ctx context.Context) ([]*pairing_entities.Pair, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pairing_entities.Pair), args.Error(1)
+}
+
+func (m *MockReadyCheck) Leave(ctx context.Context, partyID uuid.UUID, playerID uuid.UUID) (*pairing_entities.Pair, error) {
+	args := m.Called(ctx, partyID, playerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pairing_entities.Pair), args.Error(1)
+}
+
+// MockPenalties is a mock implementation of PenaltyExecutor
+type MockPenalties struct {
+	mock.Mock
+}
+
+func (m *MockPenalties) RecordOffense(ctx context.Context, offense pairing_entities.OffenseType, pairID uuid.UUID, playerIDs ...uuid.UUID) error {
+	args := m.Called(ctx, offense, pairID, playerIDs)
+	return args.Error(0)
+}
+
+func (m *MockPenalties) EnsureCanQueue(ctx context.Context, playerIDs ...uuid.UUID) error {
+	args := m.Called(ctx, playerIDs)
+	return args.Error(0)
+}
+
+// MockBackfill is a mock implementation of BackfillExecutor
+type MockBackfill struct {
+	mock.Mock
+}
+
+func (m *MockBackfill) Request(ctx context.Context, pairID uuid.UUID, playerIDs ...uuid.UUID) (*pairing_entities.Pair, *pairing_entities.Pool, error) {
+	args := m.Called(ctx, pairID, playerIDs)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	pool, _ := args.Get(1).(*pairing_entities.Pool)
+	return args.Get(0).(*pairing_entities.Pair), pool, args.Error(2)
+}
+
+func (m *MockBackfill) FillSlots(ctx context.Context, pool *pairing_entities.Pool) ([]usecases.BackfilledPair, error) {
+	args := m.Called(ctx, pool)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]usecases.BackfilledPair), args.Error(1)
+}
+
+// MockSubscriptionPriority is a mock implementation of SubscriptionPriorityExecutor
+type MockSubscriptionPriority struct {
+	mock.Mock
+}
+
+func (m *MockSubscriptionPriority) Apply(ctx context.Context, playerID uuid.UUID, c *pairing_value_objects.Criteria) error {
+	args := m.Called(ctx, playerID, c)
+	return args.Error(0)
+}
+
+// MockPlayerRoles is a mock implementation of PlayerRolesExecutor
+type MockPlayerRoles struct {
+	mock.Mock
+}
+
+func (m *MockPlayerRoles) Resolve(ctx context.Context, queued map[uuid.UUID][]string, playerIDs ...uuid.UUID) (map[uuid.UUID][]string, error) {
+	args := m.Called(ctx, queued, playerIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID][]string), args.Error(1)
+}
+
+// MockBlockList is a mock implementation of BlockListExecutor
+type MockBlockList struct {
+	mock.Mock
+}
+
+func (m *MockBlockList) Blocked(ctx context.Context, playerIDs ...uuid.UUID) (map[uuid.UUID]pairing_entities.BlockScope, error) {
+	args := m.Called(ctx, playerIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]pairing_entities.BlockScope), args.Error(1)
+}
+
+// MockBatchMatcher is a mock implementation of BatchMatchExecutor
+type MockBatchMatcher struct {
+	mock.Mock
+}
+
+func (m *MockBatchMatcher) MatchBatch(ctx context.Context, pool *pairing_entities.Pool) ([]*pairing_entities.Pair, *pairing_entities.Pool, error) {
+	args := m.Called(ctx, pool)
+	if args.Get(0) == nil {
+		return nil, pool, args.Error(2)
+	}
+	return args.Get(0).([]*pairing_entities.Pair), pool, args.Error(2)
+}
+
+// MockRatings is a mock implementation of RatingExecutor
+type MockRatings struct {
+	mock.Mock
+}
+
+func (m *MockRatings) RecordMatch(ctx context.Context, outcome ratings_entities.MatchOutcome) ([]*ratings_entities.PlayerRating, error) {
+	args := m.Called(ctx, outcome)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ratings_entities.PlayerRating), args.Error(1)
+}
+
+func (m *MockRatings) MMR(ctx context.Context, gameID uuid.UUID, gameModeID *uuid.UUID, playerIDs ...uuid.UUID) (int, error) {
+	args := m.Called(ctx, gameID, gameModeID, playerIDs)
+	return args.Int(0), args.Error(1)
+}
+
+// MockLifecycle is a mock implementation of MatchLifecycleExecutor
+type MockLifecycle struct {
+	mock.Mock
+}
+
+func (m *MockLifecycle) Apply(ctx context.Context, pairID uuid.UUID, status pairing_entities.MatchStatus, reason string) (*pairing_entities.Pair, bool, error) {
+	args := m.Called(ctx, pairID, status, reason)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*pairing_entities.Pair), args.Bool(1), args.Error(2)
+}
+
 func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 	ctx := context.Background()
 
@@ -77,17 +249,17 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 			MMR:       1500,
 		}
 
-		pool := &pairing_entities.Pool{}
+		pool := newTestPool()
 		pair := &pairing_entities.Pair{
 			Match: map[uuid.UUID]*parties_entities.Party{
-				playerID: {ID: playerID},
+				playerID:   {ID: playerID},
 				uuid.New(): {ID: uuid.New()},
 			},
 		}
 		pair.ID = uuid.New()
 
 		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": regionSlug}).Return([]*game_entities.Region{region}, nil)
-		mockAddAndFind.On("Execute", mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+		mockAddAndFind.On("Execute", mock.Anything, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
 			return payload.PartyID == playerID && payload.Criteria.GameID != nil && *payload.Criteria.GameID == gameID && payload.Criteria.Region == region
 		})).Return(pair, pool, 1, nil)
 		mockEventPublisher.On("PublishMatchCreated", ctx, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
@@ -135,10 +307,10 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 			MMR:       1200,
 		}
 
-		pool := &pairing_entities.Pool{}
+		pool := newTestPool()
 
 		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": regionSlug}).Return([]*game_entities.Region{region}, nil)
-		mockAddAndFind.On("Execute", mock.Anything).Return((*pairing_entities.Pair)(nil), pool, 2, nil)
+		mockAddAndFind.On("Execute", mock.Anything, mock.Anything).Return((*pairing_entities.Pair)(nil), pool, 2, nil)
 
 		err := consumer.HandleQueueEvent(ctx, event)
 
@@ -148,6 +320,45 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 		mockEventPublisher.AssertNotCalled(t, "PublishMatchCreated", mock.Anything, mock.Anything)
 	})
 
+	t.Run("Queue Joined Event - Pre-Made Party", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockRegionReader := &mocks.MockPortRegionReader{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			mockRegionReader,
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		)
+
+		leaderID, partyID := uuid.New(), uuid.New()
+		members := []uuid.UUID{uuid.New(), uuid.New()}
+		regionSlug := "eu-west-1"
+		region := &game_entities.Region{Slug: regionSlug}
+
+		event := &kafka.QueueEvent{
+			EventType:    kafka.EventTypeQueueJoined,
+			PlayerID:     leaderID,
+			PartyID:      partyID,
+			PartyMembers: members,
+			GameType:     uuid.New().String(),
+			Region:       regionSlug,
+			MMR:          1400,
+		}
+
+		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": regionSlug}).Return([]*game_entities.Region{region}, nil)
+		mockAddAndFind.On("Execute", mock.Anything, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return payload.PartyID == partyID && payload.PartySize == 3 &&
+				assert.ObjectsAreEqual([]uuid.UUID{leaderID, members[0], members[1]}, payload.PlayerIDs)
+		})).Return((*pairing_entities.Pair)(nil), newTestPool(), 1, nil)
+
+		err := consumer.HandleQueueEvent(ctx, event)
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+	})
+
 	t.Run("Queue Joined Event - Region Not Found", func(t *testing.T) {
 		// Setup mocks
 		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
@@ -237,85 +448,66 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 		)
 
 		playerID := uuid.New()
-		gameID := uuid.New()
-		regionSlug := "us-central-1"
-		region := &game_entities.Region{
-			Name: "US Central",
-			Slug: regionSlug,
-		}
-		region.ID = uuid.New()
 
+		// the region the event names is not where the party was queued, e.g. its pings changed since it joined
 		event := &kafka.QueueEvent{
 			EventType: kafka.EventTypeQueueLeft,
 			PlayerID:  playerID,
-			GameType:  gameID.String(),
-			Region:    regionSlug,
+			GameType:  uuid.New().String(),
+			Region:    "us-central-1",
 			MMR:       1400,
 		}
 
-		pool := &pairing_entities.Pool{
-			Parties: []uuid.UUID{playerID},
-		}
+		pool, otherPool := newTestPool(playerID), newTestPool(uuid.New())
 
-		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": regionSlug}).Return([]*game_entities.Region{region}, nil)
-		mockPoolReader.On("FindPool", mock.MatchedBy(func(c *pairing_value_objects.Criteria) bool {
-			return c.GameID != nil && *c.GameID == gameID && c.Region == region
-		})).Return(pool, nil)
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{otherPool, pool}, nil)
 		mockPoolWriter.On("Save", pool).Return(pool, nil)
 
 		err := consumer.HandleQueueEvent(ctx, event)
 
 		assert.NoError(t, err)
-		mockRegionReader.AssertExpectations(t)
+		assert.Equal(t, 0, pool.Len())
+		assert.Equal(t, 1, otherPool.Len())
+		mockRegionReader.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
 		mockPoolReader.AssertExpectations(t)
 		mockPoolWriter.AssertExpectations(t)
 	})
 
-	t.Run("Queue Left Event - Pool Not Found", func(t *testing.T) {
-		// Setup mocks
-		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
-		mockEventPublisher := &MockEventPublisher{}
+	t.Run("Queue Left Event - Removes The Whole Party", func(t *testing.T) {
 		mockRegionReader := &mocks.MockPortRegionReader{}
 		mockPoolReader := &mocks.MockPoolReader{}
 		mockPoolWriter := &mocks.MockPoolWriter{}
 
 		consumer := usecases.NewMatchmakingEventConsumer(
-			mockAddAndFind,
-			mockEventPublisher,
+			&MockAddAndFindNextPairUseCase{},
+			&MockEventPublisher{},
 			mockRegionReader,
 			mockPoolReader,
 			mockPoolWriter,
 		)
 
-		playerID := uuid.New()
-		gameID := uuid.New()
-		regionSlug := "ap-south-1"
-		region := &game_entities.Region{
-			Name: "AP South",
-			Slug: regionSlug,
-		}
-		region.ID = uuid.New()
+		playerID, partyID, otherPartyID := uuid.New(), uuid.New(), uuid.New()
 
 		event := &kafka.QueueEvent{
 			EventType: kafka.EventTypeQueueLeft,
 			PlayerID:  playerID,
-			GameType:  gameID.String(),
-			Region:    regionSlug,
-			MMR:       1600,
+			PartyID:   partyID,
+			GameType:  uuid.New().String(),
+			Region:    "us-central-1",
 		}
 
-		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": regionSlug}).Return([]*game_entities.Region{region}, nil)
-		mockPoolReader.On("FindPool", mock.Anything).Return((*pairing_entities.Pool)(nil), nil)
+		pool := newTestPool(partyID, otherPartyID)
+
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{pool}, nil)
+		mockPoolWriter.On("Save", pool).Return(pool, nil)
 
 		err := consumer.HandleQueueEvent(ctx, event)
 
 		assert.NoError(t, err)
-		mockRegionReader.AssertExpectations(t)
-		mockPoolReader.AssertExpectations(t)
-		mockPoolWriter.AssertNotCalled(t, "Save", mock.Anything)
+		assert.Equal(t, []uuid.UUID{otherPartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
 	})
 
-	t.Run("Unknown Event Type", func(t *testing.T) {
+	t.Run("Queue Left Event - Pool Not Found", func(t *testing.T) {
 		// Setup mocks
 		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
 		mockEventPublisher := &MockEventPublisher{}
@@ -332,17 +524,23 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 		)
 
 		event := &kafka.QueueEvent{
-			EventType: "UNKNOWN_EVENT",
+			EventType: kafka.EventTypeQueueLeft,
 			PlayerID:  uuid.New(),
+			GameType:  uuid.New().String(),
+			Region:    "ap-south-1",
+			MMR:       1600,
 		}
 
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{newTestPool(uuid.New())}, nil)
+
 		err := consumer.HandleQueueEvent(ctx, event)
 
 		assert.NoError(t, err)
-		// Should just log and return
+		mockPoolReader.AssertExpectations(t)
+		mockPoolWriter.AssertNotCalled(t, "Save", mock.Anything)
 	})
 
-	t.Run("Queue Left Event - Region Lookup Error", func(t *testing.T) {
+	t.Run("Unknown Event Type", func(t *testing.T) {
 		// Setup mocks
 		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
 		mockEventPublisher := &MockEventPublisher{}
@@ -358,29 +556,18 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 			mockPoolWriter,
 		)
 
-		playerID := uuid.New()
-		gameID := uuid.New()
-		regionSlug := "us-central-1"
-
 		event := &kafka.QueueEvent{
-			EventType: kafka.EventTypeQueueLeft,
-			PlayerID:  playerID,
-			GameType:  gameID.String(),
-			Region:    regionSlug,
-			MMR:       1400,
+			EventType: "UNKNOWN_EVENT",
+			PlayerID:  uuid.New(),
 		}
 
-		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": regionSlug}).Return(nil, fmt.Errorf("database error"))
-
 		err := consumer.HandleQueueEvent(ctx, event)
 
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "database error")
-		mockRegionReader.AssertExpectations(t)
-		mockPoolReader.AssertNotCalled(t, "FindPool", mock.Anything)
+		assert.NoError(t, err)
+		// Should just log and return
 	})
 
-	t.Run("Queue Left Event - Pool Save Error", func(t *testing.T) {
+	t.Run("Queue Left Event - Pool Listing Error", func(t *testing.T) {
 		// Setup mocks
 		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
 		mockEventPublisher := &MockEventPublisher{}
@@ -396,43 +583,25 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 			mockPoolWriter,
 		)
 
-		playerID := uuid.New()
-		gameID := uuid.New()
-		regionSlug := "us-central-1"
-		region := &game_entities.Region{
-			Name: "US Central",
-			Slug: regionSlug,
-		}
-		region.ID = uuid.New()
-
 		event := &kafka.QueueEvent{
 			EventType: kafka.EventTypeQueueLeft,
-			PlayerID:  playerID,
-			GameType:  gameID.String(),
-			Region:    regionSlug,
+			PlayerID:  uuid.New(),
+			GameType:  uuid.New().String(),
+			Region:    "us-central-1",
 			MMR:       1400,
 		}
 
-		pool := &pairing_entities.Pool{
-			Parties: []uuid.UUID{playerID},
-		}
-
-		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": regionSlug}).Return([]*game_entities.Region{region}, nil)
-		mockPoolReader.On("FindPool", mock.MatchedBy(func(c *pairing_value_objects.Criteria) bool {
-			return c.GameID != nil && *c.GameID == gameID && c.Region == region
-		})).Return(pool, nil)
-		mockPoolWriter.On("Save", pool).Return(nil, fmt.Errorf("save error"))
+		mockPoolReader.On("ListPools").Return(nil, fmt.Errorf("database error"))
 
 		err := consumer.HandleQueueEvent(ctx, event)
 
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "save error")
-		mockRegionReader.AssertExpectations(t)
+		assert.Contains(t, err.Error(), "database error")
 		mockPoolReader.AssertExpectations(t)
-		mockPoolWriter.AssertExpectations(t)
+		mockPoolWriter.AssertNotCalled(t, "Save", mock.Anything)
 	})
 
-	t.Run("Queue Left Event - Pool Remove Error", func(t *testing.T) {
+	t.Run("Queue Left Event - Pool Save Error", func(t *testing.T) {
 		// Setup mocks
 		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
 		mockEventPublisher := &MockEventPublisher{}
@@ -449,46 +618,24 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 		)
 
 		playerID := uuid.New()
-		gameID := uuid.New()
-		regionSlug := "us-central-1"
-		region := &game_entities.Region{
-			Name: "US Central",
-			Slug: regionSlug,
-		}
-		region.ID = uuid.New()
 
 		event := &kafka.QueueEvent{
 			EventType: kafka.EventTypeQueueLeft,
 			PlayerID:  playerID,
-			GameType:  gameID.String(),
-			Region:    regionSlug,
+			GameType:  uuid.New().String(),
+			Region:    "us-central-1",
 			MMR:       1400,
 		}
 
-		// Mock pool finding - pool exists but player not in it
-		pool := &pairing_entities.Pool{
-			Parties: []uuid.UUID{}, // Empty pool - player not in it
-		}
-
-		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": regionSlug}).Return([]*game_entities.Region{region}, nil)
-		mockPoolReader.On("FindPool", mock.MatchedBy(func(c *pairing_value_objects.Criteria) bool {
-			return c.GameID != nil && *c.GameID == gameID && c.Region == region
-		})).Return(pool, nil)
+		pool := newTestPool(playerID)
 
-		// Pool remove should fail since player not in pool
-		// No save should be called since remove fails
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{pool}, nil)
+		mockPoolWriter.On("Save", pool).Return(nil, fmt.Errorf("save error"))
 
 		err := consumer.HandleQueueEvent(ctx, event)
 
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "not in pool")
-
-		mockRegionReader.AssertExpectations(t)
-		mockPoolReader.AssertExpectations(t)
-		// PoolWriter should not be called since remove failed
-		mockPoolWriter.AssertNotCalled(t, "Save", mock.Anything)
-
-		mockRegionReader.AssertExpectations(t)
+		assert.Contains(t, err.Error(), "save error")
 		mockPoolReader.AssertExpectations(t)
 		mockPoolWriter.AssertExpectations(t)
 	})
@@ -553,4 +700,1284 @@ func TestMatchmakingEventConsumer_HandleQueueEvent(t *testing.T) {
 		assert.NoError(t, err)
 		// Should just log and return
 	})
-}
\ No newline at end of file
+}
+func TestMatchmakingEventConsumer_ReevaluatePools(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Publishes Every Pair Formed By Waiting Parties", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockEventPublisher := &MockEventPublisher{}
+		mockPoolReader := &mocks.MockPoolReader{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			mockPoolReader,
+			&mocks.MockPoolWriter{},
+		)
+
+		gameID := uuid.New()
+		criteria := pairing_value_objects.Criteria{
+			GameID:   &gameID,
+			Region:   &game_entities.Region{Slug: "sa-east-1"},
+			PairSize: 2,
+		}
+		busyPool := &pairing_entities.Pool{Key: "busy", Criteria: criteria}
+		idlePool := &pairing_entities.Pool{Key: "idle", Criteria: criteria}
+
+		pair := &pairing_entities.Pair{
+			Match: map[uuid.UUID]*parties_entities.Party{
+				uuid.New(): {},
+				uuid.New(): {},
+			},
+		}
+		pair.ID = uuid.New()
+
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{busyPool, idlePool}, nil)
+		mockAddAndFind.On("FindNextPair", ctx, busyPool, criteria).Return(pair, busyPool, nil).Once()
+		mockAddAndFind.On("FindNextPair", ctx, busyPool, criteria).Return(nil, busyPool, nil).Once()
+		mockAddAndFind.On("FindNextPair", ctx, idlePool, criteria).Return(nil, idlePool, nil).Once()
+		mockEventPublisher.On("PublishMatchCreated", ctx, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			return e.MatchID == pair.ID && e.GameType == gameID.String() && e.Region == "sa-east-1" && len(e.PlayerIDs) == 2
+		})).Return(nil).Once()
+
+		created, err := consumer.ReevaluatePools(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, created)
+		mockAddAndFind.AssertExpectations(t)
+		mockEventPublisher.AssertExpectations(t)
+	})
+
+	t.Run("Publishes The Teams Of The Pair", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockEventPublisher := &MockEventPublisher{}
+		mockPoolReader := &mocks.MockPoolReader{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			mockPoolReader,
+			&mocks.MockPoolWriter{},
+		)
+
+		pool := &pairing_entities.Pool{Key: "teams"}
+
+		duoID, soloID := uuid.New(), uuid.New()
+		duoPlayers := []uuid.UUID{uuid.New(), uuid.New()}
+		soloPlayers := []uuid.UUID{soloID}
+
+		pair := &pairing_entities.Pair{
+			Match: map[uuid.UUID]*parties_entities.Party{
+				duoID:  {},
+				soloID: {},
+			},
+			Teams: []pairing_entities.Team{
+				{ID: uuid.New(), Name: "Team 1", PartyIDs: []uuid.UUID{duoID}, PlayerIDs: duoPlayers},
+				{ID: uuid.New(), Name: "Team 2", PartyIDs: []uuid.UUID{soloID}, PlayerIDs: soloPlayers},
+			},
+		}
+		pair.ID = uuid.New()
+
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{pool}, nil)
+		mockAddAndFind.On("FindNextPair", ctx, pool, mock.Anything).Return(pair, pool, nil).Once()
+		mockAddAndFind.On("FindNextPair", ctx, pool, mock.Anything).Return(nil, pool, nil).Once()
+		mockEventPublisher.On("PublishMatchCreated", ctx, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			return len(e.Teams) == 2 &&
+				e.Teams[0].TeamID == pair.Teams[0].ID && e.Teams[0].Name == "Team 1" &&
+				assert.ObjectsAreEqual(duoPlayers, e.Teams[0].PlayerIDs) &&
+				assert.ObjectsAreEqual(soloPlayers, e.Teams[1].PlayerIDs) &&
+				assert.ObjectsAreEqual(append(append([]uuid.UUID{}, duoPlayers...), soloPlayers...), e.PlayerIDs)
+		})).Return(nil).Once()
+
+		created, err := consumer.ReevaluatePools(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, created)
+		mockEventPublisher.AssertExpectations(t)
+	})
+
+	t.Run("Keeps Going When A Pool Fails", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockPoolReader := &mocks.MockPoolReader{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			mockPoolReader,
+			&mocks.MockPoolWriter{},
+		)
+
+		failing := &pairing_entities.Pool{Key: "failing"}
+		idle := &pairing_entities.Pool{Key: "idle"}
+
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{failing, idle}, nil)
+		mockAddAndFind.On("FindNextPair", ctx, failing, mock.Anything).Return(nil, nil, assert.AnError).Once()
+		mockAddAndFind.On("FindNextPair", ctx, idle, mock.Anything).Return(nil, idle, nil).Once()
+
+		created, err := consumer.ReevaluatePools(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, created)
+		mockAddAndFind.AssertExpectations(t)
+	})
+
+	t.Run("Publishes The Pairs Of Batches", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockEventPublisher := &MockEventPublisher{}
+		mockPoolReader := &mocks.MockPoolReader{}
+		mockBatch := &MockBatchMatcher{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			mockPoolReader,
+			&mocks.MockPoolWriter{},
+		).WithBatchMatcher(mockBatch)
+
+		batched := &pairing_entities.Pool{Key: "batched"}
+
+		var pairs []*pairing_entities.Pair
+		for range 2 {
+			pair := &pairing_entities.Pair{Match: map[uuid.UUID]*parties_entities.Party{uuid.New(): {}, uuid.New(): {}}}
+			pair.ID = uuid.New()
+			pairs = append(pairs, pair)
+		}
+
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{batched}, nil)
+		mockBatch.On("MatchBatch", ctx, batched).Return(pairs, batched, nil).Once()
+		mockAddAndFind.On("FindNextPair", ctx, batched, mock.Anything).Return(nil, batched, nil).Once()
+		mockEventPublisher.On("PublishMatchCreated", ctx, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			return e.MatchID == pairs[0].ID || e.MatchID == pairs[1].ID
+		})).Return(nil).Twice()
+
+		created, err := consumer.ReevaluatePools(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, created)
+		mockBatch.AssertExpectations(t)
+		mockEventPublisher.AssertExpectations(t)
+	})
+
+	t.Run("Fails When Pools Cannot Be Listed", func(t *testing.T) {
+		mockPoolReader := &mocks.MockPoolReader{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			mockPoolReader,
+			&mocks.MockPoolWriter{},
+		)
+
+		mockPoolReader.On("ListPools").Return(nil, assert.AnError)
+
+		_, err := consumer.ReevaluatePools(ctx)
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+// newTestPool creates a ready to use pool holding the given solo parties
+func newTestPool(partyIDs ...uuid.UUID) *pairing_entities.Pool {
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), pairing_value_objects.Criteria{PairSize: 2})
+
+	for _, entry := range newPoolEntries(partyIDs...) {
+		pool.Join(entry)
+	}
+
+	return pool
+}
+
+func TestMatchmakingEventConsumer_ReadyCheck(t *testing.T) {
+	ctx := context.Background()
+
+	gameID := uuid.New()
+	criteria := pairing_value_objects.Criteria{
+		GameID:   &gameID,
+		Region:   &game_entities.Region{Slug: "sa-east-1"},
+		PairSize: 2,
+	}
+
+	newPendingPair := func() (*pairing_entities.Pair, uuid.UUID, uuid.UUID) {
+		first, second := uuid.New(), uuid.New()
+		entries := []pairing_entities.PoolEntry{
+			{PartyID: first, Criteria: criteria},
+			{PartyID: second, Criteria: criteria},
+		}
+
+		pair := &pairing_entities.Pair{
+			Match: map[uuid.UUID]*parties_entities.Party{first: {}, second: {}},
+		}
+		pair.ID = uuid.New()
+		pair.ReadyCheck = pairing_entities.NewReadyCheck(entries, time.Now(), 10*time.Second)
+
+		return pair, first, second
+	}
+
+	t.Run("Asks Players To Accept Instead Of Publishing MatchCreated", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockEventPublisher := &MockEventPublisher{}
+		mockPoolReader := &mocks.MockPoolReader{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			mockPoolReader,
+			&mocks.MockPoolWriter{},
+		)
+
+		pair, _, _ := newPendingPair()
+		pool := &pairing_entities.Pool{Key: "ready", Criteria: criteria}
+
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{pool}, nil)
+		mockAddAndFind.On("FindNextPair", ctx, pool, criteria).Return(pair, pool, nil).Once()
+		mockAddAndFind.On("FindNextPair", ctx, pool, criteria).Return(nil, pool, nil).Once()
+		mockEventPublisher.On("PublishLobbyEvent", ctx, mock.MatchedBy(func(e *kafka.LobbyEvent) bool {
+			return e.LobbyID == pair.ID && e.EventType == kafka.EventTypeLobbyCreated && len(e.PlayerIDs) == 2 &&
+				e.Metadata[kafka.MetadataReadyCheckExpiresAt] == strconv.FormatInt(pair.ReadyCheck.ExpiresAt.UnixMilli(), 10)
+		})).Return(nil).Once()
+
+		_, err := consumer.ReevaluatePools(ctx)
+
+		assert.NoError(t, err)
+		mockEventPublisher.AssertExpectations(t)
+		mockEventPublisher.AssertNotCalled(t, "PublishMatchCreated", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Publishes MatchCreated Once Everyone Accepted", func(t *testing.T) {
+		mockEventPublisher := &MockEventPublisher{}
+		mockReadyCheck := &MockReadyCheck{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithReadyCheck(mockReadyCheck)
+
+		pair, first, second := newPendingPair()
+		pair.ReadyCheck.Accepted = []uuid.UUID{first}
+
+		confirmed := *pair
+		confirmed.ReadyCheck = &pairing_entities.ReadyCheck{
+			Status:   pairing_entities.ReadyCheckAccepted,
+			Entries:  pair.ReadyCheck.Entries,
+			Accepted: []uuid.UUID{first, second},
+		}
+
+		mockReadyCheck.On("Respond", ctx, pair.ID, second, true).Return(&confirmed, nil)
+		mockEventPublisher.On("PublishLobbyEvent", ctx, mock.MatchedBy(func(e *kafka.LobbyEvent) bool {
+			return e.LobbyID == pair.ID && e.EventType == kafka.EventTypeLobbyReady
+		})).Return(nil).Once()
+		mockEventPublisher.On("PublishMatchCreated", ctx, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			return e.MatchID == pair.ID && e.GameType == gameID.String() && e.Region == "sa-east-1"
+		})).Return(nil).Once()
+
+		err := consumer.HandleLobbyEvent(ctx, &kafka.LobbyEvent{
+			LobbyID:   pair.ID,
+			EventType: kafka.EventTypeReadyStatusChanged,
+			PlayerIDs: []uuid.UUID{second},
+			Metadata:  map[string]string{kafka.MetadataReady: "true"},
+		})
+
+		assert.NoError(t, err)
+		mockReadyCheck.AssertExpectations(t)
+		mockEventPublisher.AssertExpectations(t)
+	})
+
+	t.Run("Waits For The Other Players", func(t *testing.T) {
+		mockEventPublisher := &MockEventPublisher{}
+		mockReadyCheck := &MockReadyCheck{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithReadyCheck(mockReadyCheck)
+
+		pair, first, _ := newPendingPair()
+
+		mockReadyCheck.On("Respond", ctx, pair.ID, first, true).Return(pair, nil)
+
+		err := consumer.HandleLobbyEvent(ctx, &kafka.LobbyEvent{
+			LobbyID:   pair.ID,
+			EventType: kafka.EventTypeReadyStatusChanged,
+			PlayerIDs: []uuid.UUID{first},
+			Metadata:  map[string]string{kafka.MetadataReady: "true"},
+		})
+
+		assert.NoError(t, err)
+		mockEventPublisher.AssertNotCalled(t, "PublishLobbyEvent", mock.Anything, mock.Anything)
+		mockEventPublisher.AssertNotCalled(t, "PublishMatchCreated", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Rejects An Invalid Ready Status", func(t *testing.T) {
+		mockReadyCheck := &MockReadyCheck{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithReadyCheck(mockReadyCheck)
+
+		err := consumer.HandleLobbyEvent(ctx, &kafka.LobbyEvent{
+			LobbyID:   uuid.New(),
+			EventType: kafka.EventTypeReadyStatusChanged,
+			PlayerIDs: []uuid.UUID{uuid.New()},
+			Metadata:  map[string]string{kafka.MetadataReady: "maybe"},
+		})
+
+		assert.Error(t, err)
+		mockReadyCheck.AssertNotCalled(t, "Respond", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Cancels The Lobby Of Expired Ready Checks", func(t *testing.T) {
+		mockEventPublisher := &MockEventPublisher{}
+		mockReadyCheck := &MockReadyCheck{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithReadyCheck(mockReadyCheck)
+
+		pair, first, second := newPendingPair()
+		pair.ReadyCheck.Accepted = []uuid.UUID{first}
+		pair.ReadyCheck.Expire(pair.ReadyCheck.ExpiresAt)
+
+		mockReadyCheck.On("ExpireReadyChecks", ctx).Return([]*pairing_entities.Pair{pair}, nil)
+		mockEventPublisher.On("PublishLobbyEvent", ctx, mock.MatchedBy(func(e *kafka.LobbyEvent) bool {
+			return e.LobbyID == pair.ID && e.EventType == kafka.EventTypeLobbyCancelled &&
+				e.Metadata[kafka.MetadataDroppedPartyIDs] == second.String()
+		})).Return(nil).Once()
+
+		cancelled, err := consumer.ExpireReadyChecks(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, cancelled)
+		mockEventPublisher.AssertExpectations(t)
+		mockEventPublisher.AssertNotCalled(t, "PublishMatchCreated", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMatchmakingEventConsumer_Penalties(t *testing.T) {
+	ctx := context.Background()
+
+	gameID := uuid.New()
+	criteria := pairing_value_objects.Criteria{
+		GameID:   &gameID,
+		Region:   &game_entities.Region{Slug: "sa-east-1"},
+		PairSize: 2,
+	}
+
+	newPendingPair := func() (*pairing_entities.Pair, uuid.UUID, uuid.UUID) {
+		first, second := uuid.New(), uuid.New()
+		entries := []pairing_entities.PoolEntry{
+			{PartyID: first, Criteria: criteria},
+			{PartyID: second, Criteria: criteria},
+		}
+
+		pair := &pairing_entities.Pair{
+			Match: map[uuid.UUID]*parties_entities.Party{first: {}, second: {}},
+		}
+		pair.ID = uuid.New()
+		pair.ReadyCheck = pairing_entities.NewReadyCheck(entries, time.Now(), 10*time.Second)
+
+		return pair, first, second
+	}
+
+	t.Run("Rejects Queue Joins During A Cooldown", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockRegionReader := &mocks.MockPortRegionReader{}
+		mockPenalties := &MockPenalties{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			mockRegionReader,
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithPenalties(mockPenalties)
+
+		playerID, partyID, mateID := uuid.New(), uuid.New(), uuid.New()
+
+		mockRegionReader.On("Search", ctx, mock.Anything).Return([]*game_entities.Region{{Slug: "sa-east-1"}}, nil)
+		mockPenalties.On("EnsureCanQueue", ctx, []uuid.UUID{playerID, mateID}).
+			Return(fmt.Errorf("player %v: %w", mateID, pairing_entities.ErrQueueCooldown))
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType:    kafka.EventTypeQueueJoined,
+			PlayerID:     playerID,
+			PartyID:      partyID,
+			PartyMembers: []uuid.UUID{mateID},
+			GameType:     gameID.String(),
+			Region:       "sa-east-1",
+		})
+
+		assert.NoError(t, err)
+		mockPenalties.AssertExpectations(t)
+		mockAddAndFind.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Penalizes Players Leaving The Queue After A Match Was Found", func(t *testing.T) {
+		mockEventPublisher := &MockEventPublisher{}
+		mockReadyCheck := &MockReadyCheck{}
+		mockPenalties := &MockPenalties{}
+		mockPoolReader := &mocks.MockPoolReader{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			mockPoolReader,
+			&mocks.MockPoolWriter{},
+		).WithReadyCheck(mockReadyCheck).WithPenalties(mockPenalties)
+
+		pair, _, second := newPendingPair()
+		pair.ReadyCheck.Respond(second, false, time.Now())
+
+		mockReadyCheck.On("Leave", ctx, second, second).Return(pair, nil)
+		mockPenalties.On("RecordOffense", ctx, pairing_entities.OffenseQueueLeftAfterMatchFound, pair.ID, []uuid.UUID{second}).Return(nil).Once()
+		mockEventPublisher.On("PublishLobbyEvent", ctx, mock.MatchedBy(func(e *kafka.LobbyEvent) bool {
+			return e.EventType == kafka.EventTypeLobbyCancelled && e.Metadata[kafka.MetadataDroppedPartyIDs] == second.String()
+		})).Return(nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueLeft,
+			PlayerID:  second,
+			GameType:  gameID.String(),
+			Region:    "sa-east-1",
+		})
+
+		assert.NoError(t, err)
+		mockPenalties.AssertExpectations(t)
+		mockEventPublisher.AssertExpectations(t)
+		mockPoolReader.AssertNotCalled(t, "FindPool", mock.Anything)
+	})
+
+	t.Run("Penalizes Players Declining The Ready Check", func(t *testing.T) {
+		mockEventPublisher := &MockEventPublisher{}
+		mockReadyCheck := &MockReadyCheck{}
+		mockPenalties := &MockPenalties{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithReadyCheck(mockReadyCheck).WithPenalties(mockPenalties)
+
+		pair, first, _ := newPendingPair()
+		declined := *pair
+		declined.ReadyCheck = &pairing_entities.ReadyCheck{
+			Status:   pairing_entities.ReadyCheckFailed,
+			Entries:  pair.ReadyCheck.Entries,
+			Declined: []uuid.UUID{first},
+		}
+
+		mockReadyCheck.On("Respond", ctx, pair.ID, first, false).Return(&declined, nil)
+		mockPenalties.On("RecordOffense", ctx, pairing_entities.OffenseReadyCheckDeclined, pair.ID, []uuid.UUID{first}).Return(nil).Once()
+		mockEventPublisher.On("PublishLobbyEvent", ctx, mock.Anything).Return(nil)
+
+		err := consumer.HandleLobbyEvent(ctx, &kafka.LobbyEvent{
+			LobbyID:   pair.ID,
+			EventType: kafka.EventTypeReadyStatusChanged,
+			PlayerIDs: []uuid.UUID{first},
+			Metadata:  map[string]string{kafka.MetadataReady: "false"},
+		})
+
+		assert.NoError(t, err)
+		mockPenalties.AssertExpectations(t)
+	})
+
+	t.Run("Penalizes Players Letting The Ready Check Expire", func(t *testing.T) {
+		mockReadyCheck := &MockReadyCheck{}
+		mockPenalties := &MockPenalties{}
+		mockEventPublisher := &MockEventPublisher{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithReadyCheck(mockReadyCheck).WithPenalties(mockPenalties)
+
+		pair, first, second := newPendingPair()
+		pair.ReadyCheck.Accepted = []uuid.UUID{first}
+		pair.ReadyCheck.Expire(pair.ReadyCheck.ExpiresAt)
+
+		mockReadyCheck.On("ExpireReadyChecks", ctx).Return([]*pairing_entities.Pair{pair}, nil)
+		mockPenalties.On("RecordOffense", ctx, pairing_entities.OffenseReadyCheckDeclined, pair.ID, []uuid.UUID{second}).Return(nil).Once()
+		mockEventPublisher.On("PublishLobbyEvent", ctx, mock.Anything).Return(nil)
+
+		_, err := consumer.ExpireReadyChecks(ctx)
+
+		assert.NoError(t, err)
+		mockPenalties.AssertExpectations(t)
+	})
+
+	t.Run("Penalizes Players Abandoning A Match", func(t *testing.T) {
+		mockPenalties := &MockPenalties{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithPenalties(mockPenalties)
+
+		matchID, leaver, other := uuid.New(), uuid.New(), uuid.New()
+
+		mockPenalties.On("RecordOffense", ctx, pairing_entities.OffenseMatchAbandoned, matchID, []uuid.UUID{leaver, other}).Return(nil).Once()
+
+		err := consumer.HandleMatchEvent(ctx, &kafka.MatchEvent{
+			MatchID:   matchID,
+			EventType: kafka.EventTypeMatchCompleted,
+			Metadata:  map[string]string{kafka.MetadataAbandonedPlayerIDs: leaver.String() + "," + other.String()},
+		})
+
+		assert.NoError(t, err)
+		mockPenalties.AssertExpectations(t)
+	})
+}
+
+func TestMatchmakingEventConsumer_Backfill(t *testing.T) {
+	ctx := context.Background()
+
+	newBackfillPool := func() *pairing_entities.Pool {
+		mutex := &sync.Mutex{}
+		return pairing_entities.NewPool(mutex, sync.NewCond(mutex), pairing_value_objects.Criteria{PairSize: 2})
+	}
+
+	t.Run("Publishes The New Roster Once A Player Who Left Is Replaced", func(t *testing.T) {
+		mockEventPublisher := &MockEventPublisher{}
+		mockBackfill := &MockBackfill{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithBackfill(mockBackfill)
+
+		pool := newBackfillPool()
+		stayed, leaver, replacement := uuid.New(), uuid.New(), uuid.New()
+
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+		pair.Teams = []pairing_entities.Team{{ID: uuid.New(), PlayerIDs: []uuid.UUID{stayed, replacement}}}
+
+		mockBackfill.On("Request", ctx, pair.ID, []uuid.UUID{leaver}).Return(pair, pool, nil).Once()
+		mockBackfill.On("FillSlots", ctx, pool).Return([]usecases.BackfilledPair{{Pair: pair, PartyIDs: []uuid.UUID{replacement}}}, nil).Once()
+		mockEventPublisher.On("PublishLobbyEvent", ctx, mock.MatchedBy(func(e *kafka.LobbyEvent) bool {
+			return e.EventType == kafka.EventTypeLobbyUpdated &&
+				e.LobbyID == pair.ID &&
+				assert.ObjectsAreEqual([]uuid.UUID{stayed, replacement}, e.PlayerIDs) &&
+				e.Metadata[kafka.MetadataBackfilledPartyIDs] == replacement.String()
+		})).Return(nil).Once()
+
+		err := consumer.HandleLobbyEvent(ctx, &kafka.LobbyEvent{
+			LobbyID:   pair.ID,
+			EventType: kafka.EventTypePlayerLeft,
+			PlayerIDs: []uuid.UUID{leaver},
+		})
+
+		assert.NoError(t, err)
+		mockBackfill.AssertExpectations(t)
+		mockEventPublisher.AssertExpectations(t)
+	})
+
+	t.Run("Ignores Matches That Cannot Be Backfilled", func(t *testing.T) {
+		mockEventPublisher := &MockEventPublisher{}
+		mockBackfill := &MockBackfill{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithBackfill(mockBackfill)
+
+		lobbyID, leaver := uuid.New(), uuid.New()
+
+		mockBackfill.On("Request", ctx, lobbyID, []uuid.UUID{leaver}).Return(nil, nil, fmt.Errorf("wrapped: %w", usecases.ErrBackfillUnavailable)).Once()
+
+		err := consumer.HandleLobbyEvent(ctx, &kafka.LobbyEvent{
+			LobbyID:   lobbyID,
+			EventType: kafka.EventTypePlayerLeft,
+			PlayerIDs: []uuid.UUID{leaver},
+		})
+
+		assert.NoError(t, err)
+		mockBackfill.AssertNotCalled(t, "FillSlots", mock.Anything, mock.Anything)
+		mockEventPublisher.AssertNotCalled(t, "PublishLobbyEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Serves Open Slots Before Re-Evaluating A Pool", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockPoolReader := &mocks.MockPoolReader{}
+		mockBackfill := &MockBackfill{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			mockPoolReader,
+			&mocks.MockPoolWriter{},
+		).WithBackfill(mockBackfill)
+
+		pool := newBackfillPool()
+
+		var order []string
+		mockPoolReader.On("ListPools").Return([]*pairing_entities.Pool{pool}, nil)
+		mockBackfill.On("FillSlots", ctx, pool).Run(func(mock.Arguments) { order = append(order, "backfill") }).Return(nil, nil).Once()
+		mockAddAndFind.On("FindNextPair", ctx, pool, pool.Criteria).Run(func(mock.Arguments) { order = append(order, "match") }).Return(nil, nil, nil).Once()
+
+		created, err := consumer.ReevaluatePools(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Equal(t, []string{"backfill", "match"}, order)
+	})
+}
+
+func TestMatchmakingEventConsumer_SubscriptionPriority(t *testing.T) {
+	ctx := context.Background()
+
+	gameID := uuid.New()
+	region := &game_entities.Region{Name: "US East", Slug: "us-east-1"}
+
+	newConsumer := func(mockAddAndFind *MockAddAndFindNextPairUseCase, priority *MockSubscriptionPriority) *usecases.MatchmakingEventConsumer {
+		mockRegionReader := &mocks.MockPortRegionReader{}
+		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": region.Slug}).Return([]*game_entities.Region{region}, nil)
+
+		return usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			mockRegionReader,
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithSubscriptionPriority(priority)
+	}
+
+	joined := func(playerID uuid.UUID) *kafka.QueueEvent {
+		return &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  playerID,
+			GameType:  gameID.String(),
+			Region:    region.Slug,
+			MMR:       1500,
+		}
+	}
+
+	t.Run("Queues The Party With The Tier And Boost Of Its Subscription", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		priority := &MockSubscriptionPriority{}
+		consumer := newConsumer(mockAddAndFind, priority)
+
+		playerID := uuid.New()
+
+		priority.On("Apply", ctx, playerID, mock.Anything).Run(func(args mock.Arguments) {
+			c := args.Get(2).(*pairing_value_objects.Criteria)
+			c.Tier, c.PriorityBoost = "premium", true
+		}).Return(nil).Once()
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return payload.Criteria.Tier == "premium" && payload.Criteria.PriorityBoost
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, joined(playerID))
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+	})
+
+	t.Run("Queues Without Priority When Billing Is Unavailable", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		priority := &MockSubscriptionPriority{}
+		consumer := newConsumer(mockAddAndFind, priority)
+
+		playerID := uuid.New()
+
+		priority.On("Apply", ctx, playerID, mock.Anything).Return(fmt.Errorf("billing down")).Once()
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return !payload.Criteria.PriorityBoost
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, joined(playerID))
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+	})
+}
+
+func TestMatchmakingEventConsumer_PlayerRoles(t *testing.T) {
+	ctx := context.Background()
+
+	gameID := uuid.New()
+	region := &game_entities.Region{Name: "US East", Slug: "us-east-1"}
+
+	newConsumer := func(mockAddAndFind *MockAddAndFindNextPairUseCase, roles *MockPlayerRoles) *usecases.MatchmakingEventConsumer {
+		mockRegionReader := &mocks.MockPortRegionReader{}
+		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": region.Slug}).Return([]*game_entities.Region{region}, nil)
+
+		return usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			mockRegionReader,
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithPlayerRoles(roles)
+	}
+
+	joined := func(playerID uuid.UUID, roles map[uuid.UUID][]string) *kafka.QueueEvent {
+		return &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  playerID,
+			GameType:  gameID.String(),
+			Region:    region.Slug,
+			MMR:       1500,
+			Roles:     roles,
+		}
+	}
+
+	t.Run("Queues The Party With The Roles Resolved For Its Players", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		roles := &MockPlayerRoles{}
+		consumer := newConsumer(mockAddAndFind, roles)
+
+		playerID := uuid.New()
+		resolved := map[uuid.UUID][]string{playerID: {"healer"}}
+
+		roles.On("Resolve", ctx, map[uuid.UUID][]string(nil), []uuid.UUID{playerID}).Return(resolved, nil).Once()
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return assert.ObjectsAreEqual(resolved, payload.Roles)
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, joined(playerID, nil))
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+	})
+
+	t.Run("Queues With The Roles Of The Event When Profiles Are Unavailable", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		roles := &MockPlayerRoles{}
+		consumer := newConsumer(mockAddAndFind, roles)
+
+		playerID := uuid.New()
+		queued := map[uuid.UUID][]string{playerID: {"tank"}}
+
+		roles.On("Resolve", ctx, queued, []uuid.UUID{playerID}).Return(nil, fmt.Errorf("profiles down")).Once()
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return assert.ObjectsAreEqual(queued, payload.Roles)
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, joined(playerID, queued))
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+	})
+}
+
+func TestMatchmakingEventConsumer_BlockList(t *testing.T) {
+	ctx := context.Background()
+
+	gameID := uuid.New()
+	region := &game_entities.Region{Name: "US East", Slug: "us-east-1"}
+
+	newConsumer := func(mockAddAndFind *MockAddAndFindNextPairUseCase, blocks *MockBlockList) *usecases.MatchmakingEventConsumer {
+		mockRegionReader := &mocks.MockPortRegionReader{}
+		mockRegionReader.On("Search", ctx, map[string]interface{}{"slug": region.Slug}).Return([]*game_entities.Region{region}, nil)
+
+		return usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			mockRegionReader,
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithBlockList(blocks)
+	}
+
+	partyID, playerID, teammate := uuid.New(), uuid.New(), uuid.New()
+	joined := &kafka.QueueEvent{
+		EventType:    kafka.EventTypeQueueJoined,
+		PlayerID:     playerID,
+		PartyID:      partyID,
+		PartyMembers: []uuid.UUID{playerID, teammate},
+		GameType:     gameID.String(),
+		Region:       region.Slug,
+		MMR:          1500,
+	}
+
+	t.Run("Queues The Party With The Avoid Lists Of Its Players", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		blocks := &MockBlockList{}
+		consumer := newConsumer(mockAddAndFind, blocks)
+
+		blocked := map[uuid.UUID]pairing_entities.BlockScope{uuid.New(): pairing_entities.BlockAsOpponent}
+
+		blocks.On("Blocked", ctx, []uuid.UUID{playerID, teammate}).Return(blocked, nil).Once()
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return assert.ObjectsAreEqual(blocked, payload.Blocks)
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, joined)
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+	})
+
+	t.Run("Fails The Join When Avoid Lists Are Unavailable", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		blocks := &MockBlockList{}
+		consumer := newConsumer(mockAddAndFind, blocks)
+
+		blocks.On("Blocked", ctx, []uuid.UUID{playerID, teammate}).Return(nil, fmt.Errorf("database down")).Once()
+
+		err := consumer.HandleQueueEvent(ctx, joined)
+
+		assert.Error(t, err)
+		mockAddAndFind.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMatchmakingEventConsumer_Latency(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Queues Parties Reporting Pings In The Region-Agnostic Pool", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockRegionReader := &mocks.MockPortRegionReader{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			mockRegionReader,
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		)
+
+		pings := map[string]int{"eu-west": 30, "us-east": 95}
+
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return payload.Criteria.Region == nil && payload.Criteria.MaxPing == 80 && assert.ObjectsAreEqual(pings, payload.Pings)
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  uuid.New(),
+			GameType:  uuid.New().String(),
+			Pings:     pings,
+			MaxPing:   80,
+			MMR:       1500,
+		})
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+		mockRegionReader.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Reports The Region Chosen By Latency In MatchCreated", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockEventPublisher := &MockEventPublisher{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		)
+
+		pair := &pairing_entities.Pair{Region: "us-east", WorstPing: 42}
+		pair.ID = uuid.New()
+
+		mockAddAndFind.On("Execute", ctx, mock.Anything).Return(pair, newTestPool(), 2, nil).Once()
+		mockEventPublisher.On("PublishMatchCreated", ctx, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			return e.Region == "us-east" && e.Metadata[kafka.MetadataWorstPing] == "42"
+		})).Return(nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  uuid.New(),
+			GameType:  uuid.New().String(),
+			Pings:     map[string]int{"us-east": 42},
+		})
+
+		assert.NoError(t, err)
+		mockEventPublisher.AssertExpectations(t)
+	})
+}
+
+func TestMatchmakingEventConsumer_Maps(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Queues Parties With Their Preferred Maps", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		)
+
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return assert.ObjectsAreEqual([]string{"inferno", "mirage"}, payload.Criteria.MapPreferences)
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  uuid.New(),
+			GameType:  uuid.New().String(),
+			Pings:     map[string]int{"eu-west": 30},
+			Maps:      []string{"inferno", "mirage"},
+		})
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+	})
+
+	t.Run("Reports The Map Chosen In MatchCreated", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockEventPublisher := &MockEventPublisher{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		)
+
+		pair := &pairing_entities.Pair{Map: "inferno"}
+		pair.ID = uuid.New()
+
+		mockAddAndFind.On("Execute", ctx, mock.Anything).Return(pair, newTestPool(), 2, nil).Once()
+		mockEventPublisher.On("PublishMatchCreated", ctx, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			_, hasPing := e.Metadata[kafka.MetadataWorstPing]
+			return e.Metadata[kafka.MetadataMap] == "inferno" && !hasPing
+		})).Return(nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  uuid.New(),
+			GameType:  uuid.New().String(),
+			Pings:     map[string]int{"eu-west": 30},
+		})
+
+		assert.NoError(t, err)
+		mockEventPublisher.AssertExpectations(t)
+	})
+
+	t.Run("Reports The Quality Of The Match In MatchCreated", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		mockEventPublisher := &MockEventPublisher{}
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			mockEventPublisher,
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		)
+
+		pair := &pairing_entities.Pair{Quality: &pairing_entities.QualityReport{
+			Score:              pairing_entities.MatchQuality{Total: 0.75},
+			MMRSpread:          120,
+			TeamBalance:        15,
+			LongestWaitSeconds: []int{90, 30},
+			Relaxations:        []pairing_entities.Relaxation{pairing_entities.RelaxedSkillWindow, pairing_entities.RelaxedRematch},
+		}}
+		pair.ID = uuid.New()
+
+		mockAddAndFind.On("Execute", ctx, mock.Anything).Return(pair, newTestPool(), 2, nil).Once()
+		mockEventPublisher.On("PublishMatchCreated", ctx, mock.MatchedBy(func(e *kafka.MatchEvent) bool {
+			_, hasOverlap := e.Metadata[kafka.MetadataScheduleOverlapMinutes]
+			return e.Metadata[kafka.MetadataQualityScore] == "0.750" &&
+				e.Metadata[kafka.MetadataMMRSpread] == "120" &&
+				e.Metadata[kafka.MetadataTeamBalance] == "15" &&
+				e.Metadata[kafka.MetadataLongestWaitSeconds] == "90,30" &&
+				e.Metadata[kafka.MetadataRelaxations] == "skill_window,rematch" &&
+				!hasOverlap
+		})).Return(nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  uuid.New(),
+			GameType:  uuid.New().String(),
+			Pings:     map[string]int{"eu-west": 30},
+		})
+
+		assert.NoError(t, err)
+		mockEventPublisher.AssertExpectations(t)
+	})
+}
+
+func TestMatchmakingEventConsumer_Ratings(t *testing.T) {
+	ctx := context.Background()
+
+	newConsumer := func(mockAddAndFind *MockAddAndFindNextPairUseCase, ratings *MockRatings) *usecases.MatchmakingEventConsumer {
+		return usecases.NewMatchmakingEventConsumer(
+			mockAddAndFind,
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithRatings(ratings)
+	}
+
+	t.Run("Rates The Teams Of Completed Matches By Score", func(t *testing.T) {
+		ratings := &MockRatings{}
+		consumer := newConsumer(&MockAddAndFindNextPairUseCase{}, ratings)
+
+		gameID, gameModeID := uuid.New(), uuid.New()
+		winners, losers := kafka.TeamInfo{TeamID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}, kafka.TeamInfo{TeamID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}
+
+		event := &kafka.MatchEvent{
+			MatchID:   uuid.New(),
+			EventType: kafka.EventTypeMatchCompleted,
+			GameType:  gameID.String(),
+			GameMode:  gameModeID.String(),
+			Teams:     []kafka.TeamInfo{losers, winners},
+			Result:    &kafka.MatchResult{Scores: map[string]int{winners.TeamID.String(): 16, losers.TeamID.String(): 9}},
+		}
+
+		ratings.On("RecordMatch", ctx, mock.MatchedBy(func(outcome ratings_entities.MatchOutcome) bool {
+			return outcome.MatchID == event.MatchID && outcome.GameID == gameID && *outcome.GameModeID == gameModeID &&
+				assert.ObjectsAreEqual([]ratings_entities.TeamOutcome{{PlayerIDs: losers.PlayerIDs, Rank: 1}, {PlayerIDs: winners.PlayerIDs, Rank: 0}}, outcome.Teams)
+		})).Return([]*ratings_entities.PlayerRating{}, nil).Once()
+
+		assert.NoError(t, consumer.HandleMatchEvent(ctx, event))
+		ratings.AssertExpectations(t)
+	})
+
+	t.Run("Ranks The Winner Ahead Of Everyone Without Scores", func(t *testing.T) {
+		ratings := &MockRatings{}
+		consumer := newConsumer(&MockAddAndFindNextPairUseCase{}, ratings)
+
+		teams := make([]kafka.TeamInfo, 3)
+		for i := range teams {
+			teams[i] = kafka.TeamInfo{TeamID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}
+		}
+
+		ratings.On("RecordMatch", ctx, mock.MatchedBy(func(outcome ratings_entities.MatchOutcome) bool {
+			return outcome.GameModeID == nil && outcome.Teams[0].Rank == 1 && outcome.Teams[1].Rank == 0 && outcome.Teams[2].Rank == 1
+		})).Return([]*ratings_entities.PlayerRating{}, nil).Once()
+
+		err := consumer.HandleMatchEvent(ctx, &kafka.MatchEvent{
+			MatchID:  uuid.New(),
+			GameType: uuid.New().String(),
+			Teams:    teams,
+			Result:   &kafka.MatchResult{WinnerTeamID: &teams[1].TeamID},
+		})
+
+		assert.NoError(t, err)
+		ratings.AssertExpectations(t)
+	})
+
+	t.Run("Skips Matches Without Result", func(t *testing.T) {
+		ratings := &MockRatings{}
+		consumer := newConsumer(&MockAddAndFindNextPairUseCase{}, ratings)
+
+		assert.NoError(t, consumer.HandleMatchEvent(ctx, &kafka.MatchEvent{MatchID: uuid.New(), GameType: uuid.New().String()}))
+		assert.NoError(t, consumer.HandleMatchEvent(ctx, &kafka.MatchEvent{MatchID: uuid.New(), GameType: uuid.New().String(), Result: &kafka.MatchResult{}}))
+		ratings.AssertNotCalled(t, "RecordMatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Queues Parties Without MMR With Their Rating", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		ratings := &MockRatings{}
+		consumer := newConsumer(mockAddAndFind, ratings)
+
+		gameID, playerID := uuid.New(), uuid.New()
+
+		ratings.On("MMR", ctx, gameID, (*uuid.UUID)(nil), []uuid.UUID{playerID}).Return(1830, nil).Once()
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return payload.MMR == 1830 && payload.Criteria.SkillRange.MinMMR == 1630 && payload.Criteria.SkillRange.MaxMMR == 2030
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  playerID,
+			GameType:  gameID.String(),
+			Pings:     map[string]int{"eu-west": 30},
+		})
+
+		assert.NoError(t, err)
+		mockAddAndFind.AssertExpectations(t)
+		ratings.AssertExpectations(t)
+	})
+
+	t.Run("Keeps The MMR Of The Event", func(t *testing.T) {
+		mockAddAndFind := &MockAddAndFindNextPairUseCase{}
+		ratings := &MockRatings{}
+		consumer := newConsumer(mockAddAndFind, ratings)
+
+		mockAddAndFind.On("Execute", ctx, mock.MatchedBy(func(payload usecases.FindPairPayload) bool {
+			return payload.MMR == 1200
+		})).Return(nil, newTestPool(), 1, nil).Once()
+
+		err := consumer.HandleQueueEvent(ctx, &kafka.QueueEvent{
+			EventType: kafka.EventTypeQueueJoined,
+			PlayerID:  uuid.New(),
+			GameType:  uuid.New().String(),
+			Pings:     map[string]int{"eu-west": 30},
+			MMR:       1200,
+		})
+
+		assert.NoError(t, err)
+		ratings.AssertNotCalled(t, "MMR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMatchmakingEventConsumer_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	newConsumer := func(lifecycle *MockLifecycle, ratings *MockRatings) *usecases.MatchmakingEventConsumer {
+		return usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithRatings(ratings).WithLifecycle(lifecycle)
+	}
+
+	completed := func(matchID uuid.UUID) *kafka.MatchEvent {
+		teams := []kafka.TeamInfo{{TeamID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}, {TeamID: uuid.New(), PlayerIDs: []uuid.UUID{uuid.New()}}}
+
+		return &kafka.MatchEvent{
+			MatchID:   matchID,
+			EventType: kafka.EventTypeMatchCompleted,
+			GameType:  uuid.New().String(),
+			Teams:     teams,
+			Result:    &kafka.MatchResult{WinnerTeamID: &teams[0].TeamID},
+		}
+	}
+
+	t.Run("Completes The Match Before Rating It", func(t *testing.T) {
+		lifecycle, ratings := &MockLifecycle{}, &MockRatings{}
+		consumer := newConsumer(lifecycle, ratings)
+		event := completed(uuid.New())
+
+		lifecycle.On("Apply", ctx, event.MatchID, pairing_entities.MatchCompleted, "").Return(&pairing_entities.Pair{}, true, nil).Once()
+		ratings.On("RecordMatch", ctx, mock.Anything).Return([]*ratings_entities.PlayerRating{}, nil).Once()
+
+		assert.NoError(t, consumer.HandleMatchEvent(ctx, event))
+		lifecycle.AssertExpectations(t)
+		ratings.AssertExpectations(t)
+	})
+
+	t.Run("Cancels The Match With The Reason Reported", func(t *testing.T) {
+		lifecycle := &MockLifecycle{}
+		consumer := newConsumer(lifecycle, &MockRatings{})
+		matchID := uuid.New()
+
+		lifecycle.On("Apply", ctx, matchID, pairing_entities.MatchCancelled, "server crashed").Return(&pairing_entities.Pair{}, true, nil).Once()
+
+		err := consumer.HandleMatchEvent(ctx, &kafka.MatchEvent{
+			MatchID:   matchID,
+			EventType: kafka.EventTypeMatchCancelled,
+			Metadata:  map[string]string{kafka.MetadataCancelReason: "server crashed"},
+		})
+
+		assert.NoError(t, err)
+		lifecycle.AssertExpectations(t)
+	})
+
+	t.Run("Ignores Results The Lifecycle Rejects", func(t *testing.T) {
+		lifecycle, ratings := &MockLifecycle{}, &MockRatings{}
+		consumer := newConsumer(lifecycle, ratings)
+		event := completed(uuid.New())
+
+		lifecycle.On("Apply", ctx, event.MatchID, pairing_entities.MatchCompleted, "").Return(nil, false, fmt.Errorf("cancelled: %w", pairing_entities.ErrInvalidTransition)).Once()
+
+		assert.NoError(t, consumer.HandleMatchEvent(ctx, event))
+		ratings.AssertNotCalled(t, "RecordMatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Ignores Results Delivered Twice", func(t *testing.T) {
+		lifecycle, ratings, penalties := &MockLifecycle{}, &MockRatings{}, &MockPenalties{}
+		consumer := newConsumer(lifecycle, ratings).WithPenalties(penalties)
+		event := completed(uuid.New())
+		event.Metadata = map[string]string{kafka.MetadataAbandonedPlayerIDs: event.Teams[1].PlayerIDs[0].String()}
+
+		lifecycle.On("Apply", ctx, event.MatchID, pairing_entities.MatchCompleted, "").Return(&pairing_entities.Pair{}, false, nil).Once()
+
+		assert.NoError(t, consumer.HandleMatchEvent(ctx, event))
+		penalties.AssertNotCalled(t, "RecordOffense", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		ratings.AssertNotCalled(t, "RecordMatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Rates Matches Without Pair", func(t *testing.T) {
+		lifecycle, ratings := &MockLifecycle{}, &MockRatings{}
+		consumer := newConsumer(lifecycle, ratings)
+		event := completed(uuid.New())
+
+		lifecycle.On("Apply", ctx, event.MatchID, pairing_entities.MatchCompleted, "").Return(nil, false, pairing_entities.ErrPairNotFound).Once()
+		ratings.On("RecordMatch", ctx, mock.Anything).Return([]*ratings_entities.PlayerRating{}, nil).Once()
+
+		assert.NoError(t, consumer.HandleMatchEvent(ctx, event))
+		ratings.AssertExpectations(t)
+	})
+
+	t.Run("Fails When The Pair Cannot Be Updated", func(t *testing.T) {
+		lifecycle, ratings := &MockLifecycle{}, &MockRatings{}
+		consumer := newConsumer(lifecycle, ratings)
+		event := completed(uuid.New())
+
+		lifecycle.On("Apply", ctx, event.MatchID, pairing_entities.MatchCompleted, "").Return(nil, false, fmt.Errorf("connection refused")).Once()
+
+		assert.Error(t, consumer.HandleMatchEvent(ctx, event))
+		ratings.AssertNotCalled(t, "RecordMatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Starts The Match Reported On The Results Topic", func(t *testing.T) {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", mock.Anything, pair.ID).Return(pair, nil)
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Save", pair).Return(pair, nil).Once()
+
+		consumer := usecases.NewMatchmakingEventConsumer(
+			&MockAddAndFindNextPairUseCase{},
+			&MockEventPublisher{},
+			&mocks.MockPortRegionReader{},
+			&mocks.MockPoolReader{},
+			&mocks.MockPoolWriter{},
+		).WithLifecycle(&usecases.MatchLifecycleUseCase{PairReader: pairReader, PairWriter: pairWriter})
+
+		value, err := json.Marshal(kafka.MatchEvent{MatchID: pair.ID, EventType: kafka.EventTypeMatchStarted})
+		require.NoError(t, err)
+
+		client, err := kafka.NewClient(&kafka.Config{})
+		require.NoError(t, err)
+
+		results := kafka.NewMatchResultConsumer(client, "test", consumer.HandleMatchEvent)
+
+		assert.NoError(t, results.Handle(ctx, &kafkago.Message{Topic: kafka.TopicMatchesResults, Value: value}))
+		assert.Equal(t, pairing_entities.MatchStarted, pair.Lifecycle())
+		pairWriter.AssertExpectations(t)
+	})
+}
@@ -397,6 +397,47 @@ func isTimeFrameOverlapping(start1, end1, start2, end2 time.Time) bool {
 	return start1.Before(end2) && start2.Before(end1)
 }
 
+// scheduleOverlapMinutes measures how long two schedules share availability: the minutes their time frames overlap,
+// summed over every combination of their date options that areSchedulesCompatible would accept
+func scheduleOverlapMinutes(schedule1 schedule_entities.Schedule, schedule2 schedule_entities.Schedule) int {
+	var overlap time.Duration
+	for _, option1 := range schedule1.Options {
+		for _, option2 := range schedule2.Options {
+			for _, day1 := range option1.Days {
+				for _, weekday1 := range option1.Weekdays {
+					for _, timeframe1 := range option1.TimeFrames {
+						for _, day2 := range option2.Days {
+							for _, weekday2 := range option2.Weekdays {
+								for _, timeframe2 := range option2.TimeFrames {
+									if isAvailableCombination(day1, weekday1, timeframe1, day2, weekday2, timeframe2) {
+										overlap += minTime(timeframe1.End, timeframe2.End).Sub(maxTime(timeframe1.Start, timeframe2.Start))
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return int(overlap.Minutes())
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
 // removeUUID removes the first occurrence of the given UUID from the slice
 func removeUUID(slice []uuid.UUID, id uuid.UUID) []uuid.UUID {
 	for i, v := range slice {
@@ -0,0 +1,142 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+)
+
+var ErrPenaltyForbidden = errors.New("only administrators can manage the penalties of other players")
+
+// PenaltyUseCase records the offenses of players dodging or abandoning matches, and bars them from queueing
+// while they serve the resulting cooldown
+type PenaltyUseCase struct {
+	PenaltyReader pairing_out.PenaltyReader
+	PenaltyWriter pairing_out.PenaltyWriter
+	Policy        pairing_entities.PenaltyPolicy // Optional: if empty, DefaultPenaltyPolicy is used
+}
+
+// RecordOffense adds the offense to the penalty of every given player, escalating their queue cooldown
+func (uc *PenaltyUseCase) RecordOffense(ctx context.Context, offense pairing_entities.OffenseType, pairID uuid.UUID, playerIDs ...uuid.UUID) error {
+	now := time.Now()
+
+	for _, playerID := range playerIDs {
+		penalty, err := uc.PenaltyReader.GetByPlayerID(ctx, playerID)
+		if err != nil {
+			return fmt.Errorf("PenaltyUseCase.RecordOffense: unable to get penalty of player %v: %w", playerID, err)
+		}
+
+		if penalty == nil {
+			penalty = pairing_entities.NewPlayerPenalty(playerID)
+		}
+
+		if penalty.Recorded(offense, pairID) {
+			slog.InfoContext(ctx, "offense already recorded", "player_id", playerID, "offense", offense, "pair_id", pairID)
+			continue
+		}
+
+		cooldown := penalty.Record(pairing_entities.Offense{Type: offense, PairID: pairID, OccurredAt: now}, uc.policy())
+
+		if _, err := uc.PenaltyWriter.Save(ctx, penalty); err != nil {
+			return fmt.Errorf("PenaltyUseCase.RecordOffense: unable to save penalty of player %v: %w", playerID, err)
+		}
+
+		slog.InfoContext(ctx, "offense recorded", "player_id", playerID, "offense", offense, "pair_id", pairID, "cooldown", cooldown, "cooldown_until", penalty.CooldownUntil)
+	}
+
+	return nil
+}
+
+// EnsureCanQueue fails with ErrQueueCooldown when any of the players is still serving a cooldown
+func (uc *PenaltyUseCase) EnsureCanQueue(ctx context.Context, playerIDs ...uuid.UUID) error {
+	now := time.Now()
+
+	penalties, err := uc.PenaltyReader.FindCoolingDown(ctx, now, playerIDs...)
+	if err != nil {
+		return fmt.Errorf("PenaltyUseCase.EnsureCanQueue: unable to find cooldowns: %w", err)
+	}
+
+	for _, penalty := range penalties {
+		if penalty.IsCoolingDown(now) {
+			return fmt.Errorf("PenaltyUseCase.EnsureCanQueue: player %v can queue again in %v: %w", penalty.PlayerID, penalty.RemainingCooldown(now).Round(time.Second), pairing_entities.ErrQueueCooldown)
+		}
+	}
+
+	return nil
+}
+
+// List returns the penalties of every player, or only the ones still cooling down. Decayed offenses are left out.
+func (uc *PenaltyUseCase) List(ctx context.Context, coolingDownOnly bool) ([]*pairing_entities.PlayerPenalty, error) {
+	if !common.IsAdmin(ctx) {
+		return nil, fmt.Errorf("PenaltyUseCase.List: %w", ErrPenaltyForbidden)
+	}
+
+	var penalties []*pairing_entities.PlayerPenalty
+	var err error
+
+	now := time.Now()
+	if coolingDownOnly {
+		penalties, err = uc.PenaltyReader.FindCoolingDown(ctx, now)
+	} else {
+		penalties, err = uc.PenaltyReader.FindAll(ctx)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("PenaltyUseCase.List: unable to find penalties: %w", err)
+	}
+
+	for _, penalty := range penalties {
+		penalty.Decay(now, uc.policy())
+	}
+
+	return penalties, nil
+}
+
+// Get returns the penalty of the player, or nil when they have none. Players can only see their own.
+func (uc *PenaltyUseCase) Get(ctx context.Context, playerID uuid.UUID) (*pairing_entities.PlayerPenalty, error) {
+	currentUserID, _ := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if currentUserID != playerID && !common.IsAdmin(ctx) {
+		return nil, fmt.Errorf("PenaltyUseCase.Get: %w", ErrPenaltyForbidden)
+	}
+
+	penalty, err := uc.PenaltyReader.GetByPlayerID(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("PenaltyUseCase.Get: unable to get penalty of player %v: %w", playerID, err)
+	}
+
+	if penalty != nil {
+		penalty.Decay(time.Now(), uc.policy())
+	}
+
+	return penalty, nil
+}
+
+// Clear lifts the cooldown of the player and forgets their offenses
+func (uc *PenaltyUseCase) Clear(ctx context.Context, playerID uuid.UUID) error {
+	if !common.IsAdmin(ctx) {
+		return fmt.Errorf("PenaltyUseCase.Clear: %w", ErrPenaltyForbidden)
+	}
+
+	if err := uc.PenaltyWriter.Delete(ctx, playerID); err != nil {
+		return fmt.Errorf("PenaltyUseCase.Clear: %w", err)
+	}
+
+	slog.InfoContext(ctx, "penalty cleared", "player_id", playerID)
+
+	return nil
+}
+
+func (uc *PenaltyUseCase) policy() pairing_entities.PenaltyPolicy {
+	if len(uc.Policy.Cooldowns) == 0 {
+		return pairing_entities.DefaultPenaltyPolicy
+	}
+
+	return uc.Policy
+}
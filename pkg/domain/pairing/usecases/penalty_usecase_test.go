@@ -0,0 +1,125 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+func TestPenaltyUseCase_RecordOffense(t *testing.T) {
+	ctx := context.Background()
+	pairID := uuid.New()
+	newcomer, repeat := uuid.New(), uuid.New()
+
+	previous := pairing_entities.NewPlayerPenalty(repeat)
+	previous.Record(pairing_entities.Offense{Type: pairing_entities.OffenseReadyCheckDeclined, OccurredAt: time.Now().Add(-time.Hour)}, pairing_entities.DefaultPenaltyPolicy)
+
+	reader := &mocks.MockPortPenaltyReader{}
+	reader.On("GetByPlayerID", ctx, newcomer).Return(nil, nil)
+	reader.On("GetByPlayerID", ctx, repeat).Return(previous, nil)
+
+	writer := &mocks.MockPortPenaltyWriter{}
+	writer.On("Save", ctx, mock.AnythingOfType("*entities.PlayerPenalty")).Return(previous, nil)
+
+	uc := usecases.PenaltyUseCase{PenaltyReader: reader, PenaltyWriter: writer}
+
+	err := uc.RecordOffense(ctx, pairing_entities.OffenseReadyCheckDeclined, pairID, newcomer, repeat)
+
+	require.NoError(t, err)
+	writer.AssertNumberOfCalls(t, "Save", 2)
+
+	saved := writer.Calls[0].Arguments.Get(1).(*pairing_entities.PlayerPenalty)
+	assert.Equal(t, newcomer, saved.ID)
+	assert.Equal(t, pairID, saved.Offenses[0].PairID)
+	assert.Equal(t, int64(2*60), saved.Offenses[0].Cooldown)
+
+	assert.Len(t, previous.Offenses, 2)
+	assert.Equal(t, int64(10*60), previous.Offenses[1].Cooldown, "the second offense escalates the cooldown")
+
+	t.Run("Skips Offenses Already Recorded For The Pair", func(t *testing.T) {
+		err := uc.RecordOffense(ctx, pairing_entities.OffenseReadyCheckDeclined, pairID, repeat)
+
+		require.NoError(t, err)
+		writer.AssertNumberOfCalls(t, "Save", 2)
+		assert.Len(t, previous.Offenses, 2)
+	})
+}
+
+func TestPenaltyUseCase_EnsureCanQueue(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	t.Run("Fails While A Player Is Cooling Down", func(t *testing.T) {
+		penalty := pairing_entities.NewPlayerPenalty(playerID)
+		penalty.CooldownUntil = time.Now().Add(time.Minute)
+
+		reader := &mocks.MockPortPenaltyReader{}
+		reader.On("FindCoolingDown", ctx, mock.AnythingOfType("time.Time"), []uuid.UUID{playerID}).Return([]*pairing_entities.PlayerPenalty{penalty}, nil)
+
+		uc := usecases.PenaltyUseCase{PenaltyReader: reader}
+
+		assert.ErrorIs(t, uc.EnsureCanQueue(ctx, playerID), pairing_entities.ErrQueueCooldown)
+	})
+
+	t.Run("Lets Players Without A Cooldown Queue", func(t *testing.T) {
+		reader := &mocks.MockPortPenaltyReader{}
+		reader.On("FindCoolingDown", ctx, mock.AnythingOfType("time.Time"), []uuid.UUID{playerID}).Return([]*pairing_entities.PlayerPenalty{}, nil)
+
+		uc := usecases.PenaltyUseCase{PenaltyReader: reader}
+
+		assert.NoError(t, uc.EnsureCanQueue(ctx, playerID))
+	})
+}
+
+func TestPenaltyUseCase_Admin(t *testing.T) {
+	playerID := uuid.New()
+	admin := context.WithValue(context.Background(), common.AudienceKey, common.TenantAudienceIDKey)
+	player := context.WithValue(context.Background(), common.UserIDKey, playerID)
+
+	t.Run("Only Admins List Penalties", func(t *testing.T) {
+		reader := &mocks.MockPortPenaltyReader{}
+		reader.On("FindCoolingDown", admin, mock.AnythingOfType("time.Time"), []uuid.UUID(nil)).Return([]*pairing_entities.PlayerPenalty{}, nil)
+
+		uc := usecases.PenaltyUseCase{PenaltyReader: reader}
+
+		_, err := uc.List(player, true)
+		assert.ErrorIs(t, err, usecases.ErrPenaltyForbidden)
+
+		_, err = uc.List(admin, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Players Only See Their Own Penalty", func(t *testing.T) {
+		reader := &mocks.MockPortPenaltyReader{}
+		reader.On("GetByPlayerID", player, playerID).Return(nil, nil)
+
+		uc := usecases.PenaltyUseCase{PenaltyReader: reader}
+
+		_, err := uc.Get(player, playerID)
+		assert.NoError(t, err)
+
+		_, err = uc.Get(player, uuid.New())
+		assert.ErrorIs(t, err, usecases.ErrPenaltyForbidden)
+	})
+
+	t.Run("Only Admins Clear Penalties", func(t *testing.T) {
+		writer := &mocks.MockPortPenaltyWriter{}
+		writer.On("Delete", admin, playerID).Return(nil)
+
+		uc := usecases.PenaltyUseCase{PenaltyWriter: writer}
+
+		assert.ErrorIs(t, uc.Clear(player, playerID), usecases.ErrPenaltyForbidden)
+		assert.NoError(t, uc.Clear(admin, playerID))
+		writer.AssertNumberOfCalls(t, "Delete", 1)
+	})
+}
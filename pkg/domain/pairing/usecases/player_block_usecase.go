@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+)
+
+var ErrPlayerBlockForbidden = errors.New("only administrators can manage the avoid lists of other players")
+
+// PlayerBlockUseCase manages the avoid lists of players: the players they refuse to team up with, to face, or both.
+// Parties queue with the blocks of their players, and the matcher honors them when forming groups and teams.
+type PlayerBlockUseCase struct {
+	PlayerBlockReader pairing_out.PlayerBlockReader
+	PlayerBlockWriter pairing_out.PlayerBlockWriter
+}
+
+// List returns the avoid list of the player. Players can only see their own.
+func (uc *PlayerBlockUseCase) List(ctx context.Context, playerID uuid.UUID) ([]*pairing_entities.PlayerBlock, error) {
+	if !canManageBlocks(ctx, playerID) {
+		return nil, fmt.Errorf("PlayerBlockUseCase.List: %w", ErrPlayerBlockForbidden)
+	}
+
+	blocks, err := uc.PlayerBlockReader.FindByPlayerIDs(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("PlayerBlockUseCase.List: unable to find blocks of player %v: %w", playerID, err)
+	}
+
+	return blocks, nil
+}
+
+// Block adds blockedID to the avoid list of the player with the given scope, replacing the scope when the player was
+// already blocked. An empty scope blocks the player always.
+func (uc *PlayerBlockUseCase) Block(ctx context.Context, playerID, blockedID uuid.UUID, scope pairing_entities.BlockScope) (*pairing_entities.PlayerBlock, error) {
+	if !canManageBlocks(ctx, playerID) {
+		return nil, fmt.Errorf("PlayerBlockUseCase.Block: %w", ErrPlayerBlockForbidden)
+	}
+
+	if scope == "" {
+		scope = pairing_entities.BlockAlways
+	}
+
+	if !scope.IsValid() {
+		return nil, fmt.Errorf("PlayerBlockUseCase.Block: %q: %w", scope, pairing_entities.ErrInvalidBlockScope)
+	}
+
+	if playerID == blockedID {
+		return nil, fmt.Errorf("PlayerBlockUseCase.Block: %w", pairing_entities.ErrSelfBlock)
+	}
+
+	block := pairing_entities.NewPlayerBlock(playerID, blockedID, scope)
+
+	existing, err := uc.PlayerBlockReader.FindByPlayerIDs(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("PlayerBlockUseCase.Block: unable to find blocks of player %v: %w", playerID, err)
+	}
+
+	// changing the scope keeps the date the player was first blocked
+	for _, previous := range existing {
+		if previous.ID == block.ID {
+			block.CreatedAt = previous.CreatedAt
+			break
+		}
+	}
+
+	saved, err := uc.PlayerBlockWriter.Save(ctx, block)
+	if err != nil {
+		return nil, fmt.Errorf("PlayerBlockUseCase.Block: %w", err)
+	}
+
+	slog.InfoContext(ctx, "player blocked", "player_id", playerID, "blocked_id", blockedID, "scope", scope)
+
+	return saved, nil
+}
+
+// Unblock removes blockedID from the avoid list of the player. Fails with ErrPlayerBlockNotFound when the player did
+// not block them.
+func (uc *PlayerBlockUseCase) Unblock(ctx context.Context, playerID, blockedID uuid.UUID) error {
+	if !canManageBlocks(ctx, playerID) {
+		return fmt.Errorf("PlayerBlockUseCase.Unblock: %w", ErrPlayerBlockForbidden)
+	}
+
+	if err := uc.PlayerBlockWriter.Delete(ctx, playerID, blockedID); err != nil {
+		return fmt.Errorf("PlayerBlockUseCase.Unblock: %w", err)
+	}
+
+	slog.InfoContext(ctx, "player unblocked", "player_id", playerID, "blocked_id", blockedID)
+
+	return nil
+}
+
+// Blocked returns the players the given players blocked, by blocked player, for the party they queue in
+func (uc *PlayerBlockUseCase) Blocked(ctx context.Context, playerIDs ...uuid.UUID) (map[uuid.UUID]pairing_entities.BlockScope, error) {
+	blocks, err := uc.PlayerBlockReader.FindByPlayerIDs(ctx, playerIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("PlayerBlockUseCase.Blocked: unable to find blocks of players %v: %w", playerIDs, err)
+	}
+
+	return pairing_entities.BlockedPlayers(blocks), nil
+}
+
+// canManageBlocks tells whether the current user can manage the avoid list of the player: their own, or any when
+// they are an administrator
+func canManageBlocks(ctx context.Context, playerID uuid.UUID) bool {
+	currentUserID, _ := ctx.Value(common.UserIDKey).(uuid.UUID)
+
+	return (currentUserID != uuid.Nil && currentUserID == playerID) || common.IsAdmin(ctx)
+}
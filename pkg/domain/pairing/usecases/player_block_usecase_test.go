@@ -0,0 +1,114 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+func TestPlayerBlockUseCase_Block(t *testing.T) {
+	playerID, blockedID := uuid.New(), uuid.New()
+	ctx := context.WithValue(context.Background(), common.UserIDKey, playerID)
+
+	t.Run("Blocks Always By Default", func(t *testing.T) {
+		reader := &mocks.MockPortPlayerBlockReader{}
+		reader.On("FindByPlayerIDs", ctx, []uuid.UUID{playerID}).Return([]*pairing_entities.PlayerBlock{}, nil)
+
+		writer := &mocks.MockPortPlayerBlockWriter{}
+		writer.On("Save", ctx, mock.MatchedBy(func(block *pairing_entities.PlayerBlock) bool {
+			return block.PlayerID == playerID && block.BlockedID == blockedID && block.Scope == pairing_entities.BlockAlways
+		})).Return(pairing_entities.NewPlayerBlock(playerID, blockedID, pairing_entities.BlockAlways), nil).Once()
+
+		uc := usecases.PlayerBlockUseCase{PlayerBlockReader: reader, PlayerBlockWriter: writer}
+
+		block, err := uc.Block(ctx, playerID, blockedID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, pairing_entities.BlockAlways, block.Scope)
+		writer.AssertExpectations(t)
+	})
+
+	t.Run("Keeps The Date The Player Was First Blocked", func(t *testing.T) {
+		previous := pairing_entities.NewPlayerBlock(playerID, blockedID, pairing_entities.BlockAlways)
+		previous.CreatedAt = time.Now().Add(-24 * time.Hour)
+
+		reader := &mocks.MockPortPlayerBlockReader{}
+		reader.On("FindByPlayerIDs", ctx, []uuid.UUID{playerID}).Return([]*pairing_entities.PlayerBlock{previous}, nil)
+
+		writer := &mocks.MockPortPlayerBlockWriter{}
+		writer.On("Save", ctx, mock.MatchedBy(func(block *pairing_entities.PlayerBlock) bool {
+			return block.ID == previous.ID && block.CreatedAt.Equal(previous.CreatedAt) && block.Scope == pairing_entities.BlockAsOpponent
+		})).Return(previous, nil).Once()
+
+		uc := usecases.PlayerBlockUseCase{PlayerBlockReader: reader, PlayerBlockWriter: writer}
+
+		_, err := uc.Block(ctx, playerID, blockedID, pairing_entities.BlockAsOpponent)
+
+		require.NoError(t, err)
+		writer.AssertExpectations(t)
+	})
+
+	t.Run("Rejects Invalid Blocks", func(t *testing.T) {
+		uc := usecases.PlayerBlockUseCase{PlayerBlockReader: &mocks.MockPortPlayerBlockReader{}, PlayerBlockWriter: &mocks.MockPortPlayerBlockWriter{}}
+
+		_, err := uc.Block(ctx, playerID, blockedID, "friend")
+		assert.ErrorIs(t, err, pairing_entities.ErrInvalidBlockScope)
+
+		_, err = uc.Block(ctx, playerID, playerID, pairing_entities.BlockAlways)
+		assert.ErrorIs(t, err, pairing_entities.ErrSelfBlock)
+	})
+
+	t.Run("Forbids Managing The Avoid List Of Others", func(t *testing.T) {
+		uc := usecases.PlayerBlockUseCase{PlayerBlockReader: &mocks.MockPortPlayerBlockReader{}, PlayerBlockWriter: &mocks.MockPortPlayerBlockWriter{}}
+
+		_, err := uc.Block(ctx, uuid.New(), blockedID, pairing_entities.BlockAlways)
+		assert.ErrorIs(t, err, usecases.ErrPlayerBlockForbidden)
+
+		_, err = uc.List(context.Background(), playerID)
+		assert.ErrorIs(t, err, usecases.ErrPlayerBlockForbidden)
+
+		err = uc.Unblock(ctx, uuid.New(), blockedID)
+		assert.ErrorIs(t, err, usecases.ErrPlayerBlockForbidden)
+	})
+}
+
+func TestPlayerBlockUseCase_Unblock(t *testing.T) {
+	playerID, blockedID := uuid.New(), uuid.New()
+	adminCtx := context.WithValue(context.Background(), common.AudienceKey, common.TenantAudienceIDKey)
+
+	writer := &mocks.MockPortPlayerBlockWriter{}
+	writer.On("Delete", adminCtx, playerID, blockedID).Return(pairing_entities.ErrPlayerBlockNotFound)
+
+	uc := usecases.PlayerBlockUseCase{PlayerBlockWriter: writer}
+
+	err := uc.Unblock(adminCtx, playerID, blockedID)
+
+	assert.ErrorIs(t, err, pairing_entities.ErrPlayerBlockNotFound)
+}
+
+func TestPlayerBlockUseCase_Blocked(t *testing.T) {
+	ctx := context.Background()
+	playerID, teammate, blockedID := uuid.New(), uuid.New(), uuid.New()
+
+	reader := &mocks.MockPortPlayerBlockReader{}
+	reader.On("FindByPlayerIDs", ctx, []uuid.UUID{playerID, teammate}).Return([]*pairing_entities.PlayerBlock{
+		pairing_entities.NewPlayerBlock(teammate, blockedID, pairing_entities.BlockAsTeammate),
+	}, nil)
+
+	uc := usecases.PlayerBlockUseCase{PlayerBlockReader: reader}
+
+	blocked, err := uc.Blocked(ctx, playerID, teammate)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]pairing_entities.BlockScope{blockedID: pairing_entities.BlockAsTeammate}, blocked)
+}
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/infra/squad"
+)
+
+// PlayerRolesUseCase resolves the roles players accept to play in games with role quotas, from their player profile
+// when they queue without choosing any
+type PlayerRolesUseCase struct {
+	Client squad.PlayerProfileServiceClient
+}
+
+// Resolve returns the roles of every player: the ones they queued with, or the roles of their profile otherwise.
+// Players without valid profile accept any role. When some profiles cannot be read, the roles resolved so far are
+// returned along with the error.
+func (uc *PlayerRolesUseCase) Resolve(ctx context.Context, queued map[uuid.UUID][]string, playerIDs ...uuid.UUID) (map[uuid.UUID][]string, error) {
+	roles := make(map[uuid.UUID][]string, len(playerIDs))
+
+	var errs []error
+	for _, playerID := range playerIDs {
+		if accepted := queued[playerID]; len(accepted) > 0 {
+			roles[playerID] = accepted
+			continue
+		}
+
+		resp, err := uc.Client.GetPlayerProfile(ctx, &squad.GetPlayerProfileRequest{PlayerId: playerID.String()})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to get profile of player %v: %w", playerID, err))
+			continue
+		}
+
+		if accepted := resp.GetPlayerProfile().GetRoles(); resp.GetIsValid() && len(accepted) > 0 {
+			roles[playerID] = accepted
+		}
+	}
+
+	if len(errs) > 0 {
+		return roles, fmt.Errorf("PlayerRolesUseCase.Resolve: %w", errors.Join(errs...))
+	}
+
+	return roles, nil
+}
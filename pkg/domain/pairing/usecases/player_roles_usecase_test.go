@@ -0,0 +1,80 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	"github.com/leet-gaming/match-making-api/pkg/infra/squad"
+)
+
+// MockPlayerProfileServiceClient is a mock of the player profile gRPC client
+type MockPlayerProfileServiceClient struct {
+	mock.Mock
+}
+
+func (m *MockPlayerProfileServiceClient) GetPlayerProfile(ctx context.Context, in *squad.GetPlayerProfileRequest, opts ...grpc.CallOption) (*squad.GetPlayerProfileResponse, error) {
+	args := m.Called(ctx, in.GetPlayerId())
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*squad.GetPlayerProfileResponse), args.Error(1)
+}
+
+func TestPlayerRolesUseCase_Resolve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Keeps The Roles Players Queued With", func(t *testing.T) {
+		client := new(MockPlayerProfileServiceClient)
+		uc := &usecases.PlayerRolesUseCase{Client: client}
+		playerID := uuid.New()
+
+		roles, err := uc.Resolve(ctx, map[uuid.UUID][]string{playerID: {"tank"}}, playerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID][]string{playerID: {"tank"}}, roles)
+		client.AssertNotCalled(t, "GetPlayerProfile", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Falls Back To The Roles Of The Profile", func(t *testing.T) {
+		client := new(MockPlayerProfileServiceClient)
+		uc := &usecases.PlayerRolesUseCase{Client: client}
+		queued, profiled, unknown := uuid.New(), uuid.New(), uuid.New()
+
+		client.On("GetPlayerProfile", ctx, profiled.String()).Return(&squad.GetPlayerProfileResponse{
+			IsValid:       true,
+			PlayerProfile: &squad.PlayerProfile{Roles: []string{"healer", "dps"}},
+		}, nil)
+		client.On("GetPlayerProfile", ctx, unknown.String()).Return(&squad.GetPlayerProfileResponse{IsValid: false}, nil)
+
+		roles, err := uc.Resolve(ctx, map[uuid.UUID][]string{queued: {"tank"}}, queued, profiled, unknown)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID][]string{queued: {"tank"}, profiled: {"healer", "dps"}}, roles)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("Returns The Roles Resolved When A Profile Cannot Be Read", func(t *testing.T) {
+		client := new(MockPlayerProfileServiceClient)
+		uc := &usecases.PlayerRolesUseCase{Client: client}
+		failing, profiled := uuid.New(), uuid.New()
+
+		client.On("GetPlayerProfile", ctx, failing.String()).Return(nil, errors.New("profiles down"))
+		client.On("GetPlayerProfile", ctx, profiled.String()).Return(&squad.GetPlayerProfileResponse{
+			IsValid:       true,
+			PlayerProfile: &squad.PlayerProfile{Roles: []string{"tank"}},
+		}, nil)
+
+		roles, err := uc.Resolve(ctx, nil, failing, profiled)
+
+		assert.Error(t, err)
+		assert.Equal(t, map[uuid.UUID][]string{profiled: {"tank"}}, roles)
+	})
+}
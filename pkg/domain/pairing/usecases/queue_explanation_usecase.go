@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	game_out "github.com/leet-gaming/match-making-api/pkg/domain/game/ports/out"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+)
+
+var (
+	ErrQueueExplanationForbidden = errors.New("only the players of a party or administrators can see why it is not matched yet")
+	ErrPartyNotQueued            = errors.New("party is not queued")
+)
+
+// QueueExplanationUseCase tells queued parties where they stand and why they are not matched yet (see
+// QueueExplainer), with the predicates the matcher groups them by
+type QueueExplanationUseCase struct {
+	PoolReader     pairing_out.PoolReader
+	GameReader     game_out.GameReader     // Optional: if nil, parties are explained as matched in FIFO order
+	GameModeReader game_out.GameModeReader // Optional: if nil, windows are never relaxed and the default strategy applies
+
+	Strategies *pairing_entities.StrategyRegistry // Optional: if nil, game modes can only name the built-in strategies
+}
+
+// Explain returns the explanation of the queued party. Only its players and administrators can see it.
+func (uc *QueueExplanationUseCase) Explain(ctx context.Context, partyID uuid.UUID) (pairing_entities.QueueExplanation, error) {
+	pool, entry, err := uc.findQueued(partyID)
+	if err != nil {
+		return pairing_entities.QueueExplanation{}, fmt.Errorf("QueueExplanationUseCase.Explain: %w", err)
+	}
+
+	currentUserID, _ := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !slices.Contains(entry.Players(), currentUserID) && !common.IsAdmin(ctx) {
+		return pairing_entities.QueueExplanation{}, fmt.Errorf("QueueExplanationUseCase.Explain: %w", ErrQueueExplanationForbidden)
+	}
+
+	// the matcher resolves the strategy of the pool's game mode the way it does when matching the pool
+	matcher := AddAndFindNextPairUseCase{GameReader: uc.GameReader, GameModeReader: uc.GameModeReader, Strategies: uc.Strategies}
+
+	explanation, ok := matcher.explainerFor(ctx, pool).Explain(pool, partyID, time.Now())
+	if !ok {
+		// matched or gone since it was found
+		return pairing_entities.QueueExplanation{}, fmt.Errorf("QueueExplanationUseCase.Explain: party %v: %w", partyID, ErrPartyNotQueued)
+	}
+
+	return explanation, nil
+}
+
+// findQueued returns the pool the party waits in, along with its entry
+func (uc *QueueExplanationUseCase) findQueued(partyID uuid.UUID) (*pairing_entities.Pool, pairing_entities.PoolEntry, error) {
+	pools, err := uc.PoolReader.ListPools()
+	if err != nil {
+		return nil, pairing_entities.PoolEntry{}, fmt.Errorf("unable to list pools: %w", err)
+	}
+
+	for _, pool := range pools {
+		for _, entry := range pool.QueuedEntries() {
+			if entry.PartyID == partyID {
+				return pool, entry, nil
+			}
+		}
+	}
+
+	return nil, pairing_entities.PoolEntry{}, fmt.Errorf("party %v: %w", partyID, ErrPartyNotQueued)
+}
@@ -0,0 +1,88 @@
+package usecases_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+func TestQueueExplanationUseCase_Explain(t *testing.T) {
+	admin := context.WithValue(context.Background(), common.AudienceKey, common.TenantAudienceIDKey)
+
+	gameModeID := uuid.New()
+	playerID := uuid.New()
+
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), pairing_value_objects.Criteria{PairSize: 2, GameModeID: &gameModeID})
+
+	// the party is too far in skill from the first other party, and can be matched with the second one
+	entries := newPoolEntries(uuid.New(), uuid.New(), uuid.New())
+	entries[0].PlayerIDs = []uuid.UUID{playerID}
+	entries[0].MMR, entries[1].MMR, entries[2].MMR = 1000, 1500, 1000
+	for i := range entries {
+		entries[i].Criteria.SkillRange = &pairing_value_objects.SkillRange{MinMMR: entries[i].MMR - 100, MaxMMR: entries[i].MMR + 100}
+		pool.Join(entries[i])
+	}
+
+	party := entries[0].PartyID
+
+	newUseCase := func() usecases.QueueExplanationUseCase {
+		poolReader := &mocks.MockPoolReader{}
+		poolReader.On("ListPools").Return([]*pairing_entities.Pool{pool}, nil)
+
+		gameModeReader := &mocks.MockPortGameModeReader{}
+		gameModeReader.On("GetByID", mock.Anything, gameModeID).Return(&game_entities.GameMode{
+			Matchmaking: game_entities.MatchmakingSettings{Strategy: &game_entities.MatchStrategySettings{Name: game_entities.MatchStrategySkill}},
+		}, nil)
+
+		return usecases.QueueExplanationUseCase{PoolReader: poolReader, GameModeReader: gameModeReader}
+	}
+
+	t.Run("Explains Why The Party Is Not Matched Under The Strategy Of Its Game Mode", func(t *testing.T) {
+		uc := newUseCase()
+
+		explanation, err := uc.Explain(context.WithValue(context.Background(), common.UserIDKey, playerID), party)
+
+		require.NoError(t, err)
+		assert.Equal(t, pool.Key, explanation.PoolKey)
+		assert.Equal(t, 1, explanation.Position)
+		assert.Equal(t, 1, explanation.Candidates)
+		assert.Equal(t, []pairing_entities.Blocker{{Reason: pairing_entities.BlockedByMMRGap, Parties: 1}}, explanation.Blockers)
+	})
+
+	t.Run("Admins See Every Party", func(t *testing.T) {
+		uc := newUseCase()
+
+		_, err := uc.Explain(admin, party)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Other Players Cannot See The Party", func(t *testing.T) {
+		uc := newUseCase()
+
+		_, err := uc.Explain(context.WithValue(context.Background(), common.UserIDKey, uuid.New()), party)
+
+		assert.ErrorIs(t, err, usecases.ErrQueueExplanationForbidden)
+	})
+
+	t.Run("Fails When The Party Is Not Queued", func(t *testing.T) {
+		uc := newUseCase()
+
+		_, err := uc.Explain(admin, uuid.New())
+
+		assert.ErrorIs(t, err, usecases.ErrPartyNotQueued)
+	})
+}
@@ -0,0 +1,175 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+)
+
+// readyCheckUpdateAttempts is how many times a change to a ready check is applied to the latest version of its pair,
+// when others keep saving the pair in between
+const readyCheckUpdateAttempts = 5
+
+// ReadyCheckUseCase records the answers to a pair's ready check, and closes the checks nobody finished in time.
+// When a check fails, the parties that did not decline or time out go back to their pool at their original position.
+// Players answering at the same time, leaving the queue and expiring checks all change the same pair, so every change
+// is saved only when nobody saved the pair since it was read, and applied again to the latest version otherwise.
+type ReadyCheckUseCase struct {
+	PairReader pairing_out.PairReader
+	PairWriter pairing_out.PairWriter
+	PoolReader pairing_out.PoolReader
+	PoolWriter pairing_out.PoolWriter
+}
+
+// Respond records whether the player accepts the pair. Returns the updated pair; callers check its ReadyCheck
+// status to know whether the match is confirmed, cancelled or still waiting for answers.
+func (uc *ReadyCheckUseCase) Respond(ctx context.Context, pairID uuid.UUID, playerID uuid.UUID, accepted bool) (*pairing_entities.Pair, error) {
+	pair, err := uc.update(ctx, pairID, func(pair *pairing_entities.Pair) (bool, error) {
+		if pair.ReadyCheck == nil {
+			return false, pairing_entities.ErrReadyCheckNotStarted
+		}
+
+		if err := pair.ReadyCheck.Respond(playerID, accepted, time.Now()); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ReadyCheckUseCase.Respond: %w", err)
+	}
+
+	slog.InfoContext(ctx, "ready check answered", "pair_id", pairID, "player_id", playerID, "accepted", accepted, "status", pair.ReadyCheck.Status)
+
+	return pair, nil
+}
+
+// Leave declines, on behalf of the player, the pending ready check of the party's latest pair. Returns the
+// cancelled pair, or nil when the party is not waiting on a ready check.
+func (uc *ReadyCheckUseCase) Leave(ctx context.Context, partyID uuid.UUID, playerID uuid.UUID) (*pairing_entities.Pair, error) {
+	pairs, err := uc.PairReader.FindPairsByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, fmt.Errorf("ReadyCheckUseCase.Leave: unable to find pairs of party %v: %w", partyID, err)
+	}
+
+	// pairs come newest first; a party can only wait on its latest one
+	if len(pairs) == 0 || pairs[0].ReadyCheck == nil || pairs[0].ReadyCheck.Status != pairing_entities.ReadyCheckPending {
+		return nil, nil
+	}
+
+	return uc.Respond(ctx, pairs[0].ID, playerID, false)
+}
+
+// ExpireReadyChecks fails every ready check still pending past its deadline. Returns the pairs it cancelled.
+func (uc *ReadyCheckUseCase) ExpireReadyChecks(ctx context.Context) ([]*pairing_entities.Pair, error) {
+	now := time.Now()
+
+	pairs, err := uc.PairReader.FindExpiredReadyChecks(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("ReadyCheckUseCase.ExpireReadyChecks: unable to find expired ready checks: %w", err)
+	}
+
+	expired := make([]*pairing_entities.Pair, 0, len(pairs))
+	for _, pair := range pairs {
+		// the check may have been answered since it was found
+		saved, err := uc.update(ctx, pair.ID, func(pair *pairing_entities.Pair) (bool, error) {
+			return pair.ReadyCheck != nil && pair.ReadyCheck.Expire(now), nil
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to close expired ready check", "pair_id", pair.ID, "error", err)
+			continue
+		}
+
+		if saved == nil {
+			continue
+		}
+
+		slog.InfoContext(ctx, "ready check expired", "pair_id", saved.ID, "accepted_player_ids", saved.ReadyCheck.Accepted)
+
+		expired = append(expired, saved)
+	}
+
+	return expired, nil
+}
+
+// update applies the change to the latest version of the pair and saves it, reading the pair again and applying the
+// change again when someone else saved it in between. Returns a nil pair when the change reports it left the pair as
+// it was. When the ready check failed, the pair is cancelled, so it is not counted as a match played, and once it is
+// saved the parties who accepted are returned to their pool.
+func (uc *ReadyCheckUseCase) update(ctx context.Context, pairID uuid.UUID, change func(*pairing_entities.Pair) (bool, error)) (*pairing_entities.Pair, error) {
+	for attempt := 1; ; attempt++ {
+		pair, err := uc.PairReader.GetByID(ctx, pairID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get pair %v: %w", pairID, err)
+		}
+
+		changed, err := change(pair)
+		if err != nil {
+			return nil, fmt.Errorf("pair %v: %w", pairID, err)
+		}
+
+		if !changed {
+			return nil, nil
+		}
+
+		failed := pair.ReadyCheck.Status == pairing_entities.ReadyCheckFailed
+		if failed {
+			if _, err := pair.Cancel(pairing_entities.CancelReasonReadyCheckFailed, time.Now()); err != nil {
+				return nil, fmt.Errorf("unable to cancel pair %v: %w", pairID, err)
+			}
+		}
+
+		saved, err := uc.PairWriter.Update(pair)
+		if errors.Is(err, pairing_entities.ErrPairChanged) && attempt < readyCheckUpdateAttempts {
+			slog.InfoContext(ctx, "pair saved by someone else, ready check change applied again", "pair_id", pairID, "attempt", attempt)
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to save pair %v: %w", pairID, err)
+		}
+
+		if failed {
+			// only the change failing the check gets here, so the parties are returned once
+			if err := uc.returnToPool(ctx, saved.ReadyCheck.Returning()); err != nil {
+				return nil, fmt.Errorf("unable to return parties of pair %v to the pool: %w", pairID, err)
+			}
+
+			slog.InfoContext(ctx, "ready check failed, parties removed from matchmaking", "pair_id", pairID, "party_ids", pairing_entities.PartyIDs(saved.ReadyCheck.Dropped()))
+		}
+
+		return saved, nil
+	}
+}
+
+func (uc *ReadyCheckUseCase) returnToPool(ctx context.Context, entries []pairing_entities.PoolEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	// every entry of a pair was taken from the same pool
+	pool, err := uc.PoolReader.FindPool(&entries[0].Criteria)
+	if err != nil {
+		return err
+	}
+
+	if pool == nil {
+		return fmt.Errorf("pool %v not found", entries[0].Criteria.PoolKey())
+	}
+
+	pool.Requeue(entries...)
+
+	if _, err := uc.PoolWriter.Save(pool); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "parties returned to the pool", "pool_key", pool.Key, "party_ids", pairing_entities.PartyIDs(entries))
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package usecases_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+// newReadyCheckPair builds a pair waiting on the ready check of the given entries, and the pool they were taken from
+func newReadyCheckPair(entries ...pairing_entities.PoolEntry) (*pairing_entities.Pair, *pairing_entities.Pool) {
+	mutex := &sync.Mutex{}
+	pool := pairing_entities.NewPool(mutex, sync.NewCond(mutex), entries[0].Criteria)
+
+	pair := pairing_entities.NewPair(len(entries), common.ResourceOwner{})
+	pair.ReadyCheck = pairing_entities.NewReadyCheck(entries, time.Now(), time.Minute)
+
+	return pair, pool
+}
+
+func TestReadyCheckUseCase_Respond(t *testing.T) {
+	ctx := context.Background()
+	criteria := pairing_value_objects.Criteria{PairSize: 3}
+
+	first := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now().Add(-3 * time.Minute), Criteria: criteria}
+	second := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now().Add(-2 * time.Minute), Criteria: criteria}
+	third := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now().Add(-time.Minute), Criteria: criteria}
+
+	t.Run("Confirms The Pair Once Everyone Accepted", func(t *testing.T) {
+		pair, _ := newReadyCheckPair(first, second)
+		pair.ReadyCheck.Accepted = []uuid.UUID{first.PartyID}
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Update", pair).Return(pair, nil)
+
+		uc := usecases.ReadyCheckUseCase{PairReader: pairReader, PairWriter: pairWriter}
+
+		updated, err := uc.Respond(ctx, pair.ID, second.PartyID, true)
+
+		require.NoError(t, err)
+		assert.True(t, updated.IsConfirmed())
+		pairWriter.AssertExpectations(t)
+	})
+
+	t.Run("Returns The Other Parties To Their Position When Someone Declines", func(t *testing.T) {
+		pair, pool := newReadyCheckPair(first, third)
+		pool.Join(second)
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Update", pair).Return(pair, nil)
+
+		poolReader := &mocks.MockPoolReader{}
+		poolReader.On("FindPool", mock.Anything).Return(pool, nil)
+
+		poolWriter := &mocks.MockPoolWriter{}
+		poolWriter.On("Save", pool).Return(pool, nil)
+
+		uc := usecases.ReadyCheckUseCase{PairReader: pairReader, PairWriter: pairWriter, PoolReader: poolReader, PoolWriter: poolWriter}
+
+		updated, err := uc.Respond(ctx, pair.ID, third.PartyID, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, pairing_entities.ReadyCheckFailed, updated.ReadyCheck.Status)
+		assert.Equal(t, pairing_entities.MatchCancelled, updated.Lifecycle())
+		assert.Equal(t, pairing_entities.CancelReasonReadyCheckFailed, updated.CancelReason)
+		assert.Equal(t, []uuid.UUID{first.PartyID, second.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+		poolWriter.AssertExpectations(t)
+		pairWriter.AssertExpectations(t)
+	})
+
+	t.Run("Answers Again On The Latest Pair When Another Player Answered Meanwhile", func(t *testing.T) {
+		pair, _ := newReadyCheckPair(first, second)
+
+		// the first player's answer is saved between the second player reading the pair and saving it
+		stale := *pair
+		stale.ReadyCheck = pairing_entities.NewReadyCheck([]pairing_entities.PoolEntry{first, second}, time.Now(), time.Minute)
+		pair.ReadyCheck.Accepted = []uuid.UUID{first.PartyID}
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(&stale, nil).Once()
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil).Once()
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Update", &stale).Return(nil, pairing_entities.ErrPairChanged).Once()
+		pairWriter.On("Update", pair).Return(pair, nil).Once()
+
+		uc := usecases.ReadyCheckUseCase{PairReader: pairReader, PairWriter: pairWriter}
+
+		updated, err := uc.Respond(ctx, pair.ID, second.PartyID, true)
+
+		require.NoError(t, err)
+		assert.True(t, updated.IsConfirmed())
+		assert.ElementsMatch(t, []uuid.UUID{first.PartyID, second.PartyID}, updated.ReadyCheck.Accepted)
+		pairWriter.AssertExpectations(t)
+	})
+
+	t.Run("Fails For Pairs Without A Ready Check", func(t *testing.T) {
+		pair := pairing_entities.NewPair(2, common.ResourceOwner{})
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		uc := usecases.ReadyCheckUseCase{PairReader: pairReader}
+
+		_, err := uc.Respond(ctx, pair.ID, uuid.New(), true)
+
+		assert.ErrorIs(t, err, pairing_entities.ErrReadyCheckNotStarted)
+	})
+}
+
+func TestReadyCheckUseCase_ExpireReadyChecks(t *testing.T) {
+	ctx := context.Background()
+	criteria := pairing_value_objects.Criteria{PairSize: 2}
+
+	accepted := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now().Add(-time.Minute), Criteria: criteria}
+	silent := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now(), Criteria: criteria}
+
+	pair, pool := newReadyCheckPair(accepted, silent)
+	pair.ReadyCheck.Accepted = []uuid.UUID{accepted.PartyID}
+	pair.ReadyCheck.ExpiresAt = time.Now().Add(-time.Second)
+
+	pairReader := &mocks.MockPortPairReader{}
+	pairReader.On("FindExpiredReadyChecks", ctx, mock.AnythingOfType("time.Time")).Return([]*pairing_entities.Pair{pair}, nil)
+	pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+	pairWriter := &mocks.MockPortPairWriter{}
+	pairWriter.On("Update", pair).Return(pair, nil)
+
+	poolReader := &mocks.MockPoolReader{}
+	poolReader.On("FindPool", mock.Anything).Return(pool, nil)
+
+	poolWriter := &mocks.MockPoolWriter{}
+	poolWriter.On("Save", pool).Return(pool, nil)
+
+	uc := usecases.ReadyCheckUseCase{PairReader: pairReader, PairWriter: pairWriter, PoolReader: poolReader, PoolWriter: poolWriter}
+
+	expired, err := uc.ExpireReadyChecks(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, pairing_entities.ReadyCheckFailed, expired[0].ReadyCheck.Status)
+	assert.Equal(t, pairing_entities.MatchCancelled, expired[0].Lifecycle())
+	assert.Equal(t, pairing_entities.CancelReasonReadyCheckFailed, expired[0].CancelReason)
+	assert.Equal(t, []uuid.UUID{accepted.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+}
+
+func TestReadyCheckUseCase_Leave(t *testing.T) {
+	ctx := context.Background()
+	criteria := pairing_value_objects.Criteria{PairSize: 2}
+
+	stays := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now().Add(-time.Minute), Criteria: criteria}
+	leaves := pairing_entities.PoolEntry{PartyID: uuid.New(), JoinedAt: time.Now(), Criteria: criteria}
+
+	t.Run("Declines The Pending Ready Check Of The Party", func(t *testing.T) {
+		pair, pool := newReadyCheckPair(stays, leaves)
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("FindPairsByPartyID", ctx, leaves.PartyID).Return([]*pairing_entities.Pair{pair}, nil)
+		pairReader.On("GetByID", ctx, pair.ID).Return(pair, nil)
+
+		pairWriter := &mocks.MockPortPairWriter{}
+		pairWriter.On("Update", pair).Return(pair, nil)
+
+		poolReader := &mocks.MockPoolReader{}
+		poolReader.On("FindPool", mock.Anything).Return(pool, nil)
+
+		poolWriter := &mocks.MockPoolWriter{}
+		poolWriter.On("Save", pool).Return(pool, nil)
+
+		uc := usecases.ReadyCheckUseCase{PairReader: pairReader, PairWriter: pairWriter, PoolReader: poolReader, PoolWriter: poolWriter}
+
+		cancelled, err := uc.Leave(ctx, leaves.PartyID, leaves.PartyID)
+
+		require.NoError(t, err)
+		require.NotNil(t, cancelled)
+		assert.Equal(t, []uuid.UUID{leaves.PartyID}, cancelled.ReadyCheck.Offenders())
+		assert.Equal(t, []uuid.UUID{stays.PartyID}, pairing_entities.PartyIDs(pool.QueuedEntries()))
+	})
+
+	t.Run("Ignores Parties Not Waiting On A Ready Check", func(t *testing.T) {
+		pair, _ := newReadyCheckPair(stays, leaves)
+		pair.ReadyCheck.Status = pairing_entities.ReadyCheckAccepted
+
+		pairReader := &mocks.MockPortPairReader{}
+		pairReader.On("FindPairsByPartyID", ctx, leaves.PartyID).Return([]*pairing_entities.Pair{pair}, nil)
+
+		uc := usecases.ReadyCheckUseCase{PairReader: pairReader}
+
+		cancelled, err := uc.Leave(ctx, leaves.PartyID, leaves.PartyID)
+
+		require.NoError(t, err)
+		assert.Nil(t, cancelled)
+	})
+}
@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	"github.com/leet-gaming/match-making-api/pkg/infra/billing"
+)
+
+// FreeTier is the tier of players without an active subscription
+const FreeTier = "free"
+
+// activeSubscriptionStatuses are the billing statuses under which a subscription grants its perks
+var activeSubscriptionStatuses = []string{"active", "trialing"}
+
+// SubscriptionPriorityUseCase sets the subscription tier of the parties joining the queue, and whether their plan
+// grants them a priority boost
+type SubscriptionPriorityUseCase struct {
+	Client billing.SubscriptionServiceClient
+}
+
+// Apply looks up the subscription plan of the player and sets the tier and priority boost of the criteria.
+// Players without a valid, active subscription are in the FreeTier, without boost.
+func (uc *SubscriptionPriorityUseCase) Apply(ctx context.Context, playerID uuid.UUID, c *pairing_value_objects.Criteria) error {
+	resp, err := uc.Client.GetSubscription(ctx, &billing.GetSubscriptionRequest{UserId: playerID.String()})
+	if err != nil {
+		return fmt.Errorf("SubscriptionPriorityUseCase.Apply: unable to get subscription of player %v: %w", playerID, err)
+	}
+
+	c.Tier, c.PriorityBoost = FreeTier, false
+
+	subscription := resp.GetSubscription()
+	if !resp.GetIsValid() || subscription == nil || !isActiveSubscription(subscription.GetStatus()) {
+		return nil
+	}
+
+	if tier := strings.ToLower(strings.TrimSpace(subscription.GetPlanId())); tier != "" {
+		c.Tier = tier
+	}
+
+	c.PriorityBoost = c.Tier != FreeTier
+
+	return nil
+}
+
+func isActiveSubscription(status string) bool {
+	return slices.Contains(activeSubscriptionStatuses, strings.ToLower(strings.TrimSpace(status)))
+}
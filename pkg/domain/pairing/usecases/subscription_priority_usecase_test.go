@@ -0,0 +1,116 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/leet-gaming/match-making-api/pkg/domain/pairing/usecases"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	"github.com/leet-gaming/match-making-api/pkg/infra/billing"
+)
+
+// MockSubscriptionServiceClient is a mock of the billing gRPC client
+type MockSubscriptionServiceClient struct {
+	mock.Mock
+}
+
+func (m *MockSubscriptionServiceClient) GetSubscription(ctx context.Context, in *billing.GetSubscriptionRequest, opts ...grpc.CallOption) (*billing.GetSubscriptionResponse, error) {
+	args := m.Called(ctx, in.GetUserId())
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*billing.GetSubscriptionResponse), args.Error(1)
+}
+
+func (m *MockSubscriptionServiceClient) ValidateOperation(ctx context.Context, in *billing.ValidateOperationRequest, opts ...grpc.CallOption) (*billing.ValidateOperationResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*billing.ValidateOperationResponse), args.Error(1)
+}
+
+func (m *MockSubscriptionServiceClient) ConfirmOperation(ctx context.Context, in *billing.ConfirmOperationRequest, opts ...grpc.CallOption) (*billing.ConfirmOperationResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*billing.ConfirmOperationResponse), args.Error(1)
+}
+
+func TestSubscriptionPriorityUseCase_Apply(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		response      *billing.GetSubscriptionResponse
+		expectedTier  string
+		expectedBoost bool
+	}{
+		{
+			name: "Boosts Active Paid Plans",
+			response: &billing.GetSubscriptionResponse{
+				IsValid:      true,
+				Subscription: &billing.Subscription{PlanId: "Premium", Status: "active"},
+			},
+			expectedTier:  "premium",
+			expectedBoost: true,
+		},
+		{
+			name: "Does Not Boost The Free Plan",
+			response: &billing.GetSubscriptionResponse{
+				IsValid:      true,
+				Subscription: &billing.Subscription{PlanId: "free", Status: "active"},
+			},
+			expectedTier:  usecases.FreeTier,
+			expectedBoost: false,
+		},
+		{
+			name: "Treats Lapsed Subscriptions As Free",
+			response: &billing.GetSubscriptionResponse{
+				IsValid:      true,
+				Subscription: &billing.Subscription{PlanId: "premium", Status: "canceled"},
+			},
+			expectedTier:  usecases.FreeTier,
+			expectedBoost: false,
+		},
+		{
+			name:          "Treats Players Without Subscription As Free",
+			response:      &billing.GetSubscriptionResponse{IsValid: false, Reason: "no subscription"},
+			expectedTier:  usecases.FreeTier,
+			expectedBoost: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &MockSubscriptionServiceClient{}
+			client.On("GetSubscription", ctx, playerID.String()).Return(tc.response, nil)
+
+			uc := usecases.SubscriptionPriorityUseCase{Client: client}
+
+			criteria := pairing_value_objects.Criteria{}
+			err := uc.Apply(ctx, playerID, &criteria)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTier, criteria.Tier)
+			assert.Equal(t, tc.expectedBoost, criteria.PriorityBoost)
+		})
+	}
+
+	t.Run("Leaves The Criteria Untouched When Billing Fails", func(t *testing.T) {
+		client := &MockSubscriptionServiceClient{}
+		client.On("GetSubscription", ctx, playerID.String()).Return(nil, errors.New("unavailable"))
+
+		uc := usecases.SubscriptionPriorityUseCase{Client: client}
+
+		criteria := pairing_value_objects.Criteria{Tier: "pro", PriorityBoost: true}
+		err := uc.Apply(ctx, playerID, &criteria)
+
+		assert.Error(t, err)
+		assert.Equal(t, "pro", criteria.Tier)
+		assert.True(t, criteria.PriorityBoost)
+	})
+}
@@ -1,6 +1,9 @@
 package value_objects
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/google/uuid"
 	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
 	schedule_entities "github.com/leet-gaming/match-making-api/pkg/domain/schedules/entities"
@@ -13,6 +16,7 @@ type Criteria struct {
 	Schedule *schedule_entities.Schedule
 	Region   *game_entities.Region
 
+	// PairSize is the number of players in a match, used when the game does not cap its team sizes
 	PairSize int //Edges    map[int]int
 	// MinParties int
 	// MaxParties int
@@ -33,3 +37,46 @@ type SkillRange struct {
 	MinMMR int `json:"min_mmr" bson:"min_mmr"`
 	MaxMMR int `json:"max_mmr" bson:"max_mmr"`
 }
+
+// PoolKey derives a deterministic key for the pool serving these criteria.
+// Criteria sharing tenant, client, game, game mode, region and pair size always resolve to the same pool,
+// regardless of per-party attributes such as schedule, skill range or subscription tier: boosted and free parties
+// share a queue, where boosts only give a bounded head start (see entities.WithPriority).
+func (c Criteria) PoolKey() string {
+	parts := []string{
+		"tenant=" + optionalUUIDKey(c.TenantID),
+		"client=" + optionalUUIDKey(c.ClientID),
+		"game=" + optionalUUIDKey(c.GameID),
+		"mode=" + optionalUUIDKey(c.GameModeID),
+		"region=" + regionKey(c.Region),
+		"size=" + strconv.Itoa(c.PairSize),
+	}
+
+	return strings.Join(parts, "|")
+}
+
+// optionalUUIDKey renders an optional identifier for PoolKey, using "*" when it is not set
+func optionalUUIDKey(id *uuid.UUID) string {
+	if id == nil || *id == uuid.Nil {
+		return "*"
+	}
+
+	return id.String()
+}
+
+// regionKey identifies a region by slug, falling back to its ID when the slug is empty
+func regionKey(region *game_entities.Region) string {
+	if region == nil {
+		return "*"
+	}
+
+	if region.Slug != "" {
+		return region.Slug
+	}
+
+	if region.ID != uuid.Nil {
+		return region.ID.String()
+	}
+
+	return "*"
+}
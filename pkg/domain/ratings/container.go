@@ -0,0 +1,70 @@
+package ratings
+
+import (
+	"github.com/golobby/container/v3"
+	game_out "github.com/leet-gaming/match-making-api/pkg/domain/game/ports/out"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/domain/ratings/usecases"
+)
+
+// Inject initializes and registers the ratings module within the given container.
+//
+// Parameters:
+//   - c: A container.Container instance used for dependency injection.
+//
+// Returns:
+//   - An error if the injection process encounters any issues, or nil if successful.
+func Inject(c container.Container) error {
+	// Register Rating use case. RatingReader/RatingWriter are provided by the infra layer (see mongodb.InjectRatingRepository)
+	if err := c.Singleton(func(
+		ratingReader ratings_out.RatingReader,
+		ratingWriter ratings_out.RatingWriter,
+		gameModeReader game_out.GameModeReader,
+	) (*usecases.RatingUseCase, error) {
+		return &usecases.RatingUseCase{
+			RatingReader:   ratingReader,
+			RatingWriter:   ratingWriter,
+			GameModeReader: gameModeReader,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register Season use case. Seasons and their snapshots are provided by the infra layer (see mongodb.InjectSeasonRepository)
+	if err := c.Singleton(func(
+		seasonReader ratings_out.SeasonReader,
+		seasonWriter ratings_out.SeasonWriter,
+		ratingReader ratings_out.RatingReader,
+		ratingWriter ratings_out.RatingWriter,
+		snapshotReader ratings_out.LeaderboardSnapshotReader,
+		snapshotWriter ratings_out.LeaderboardSnapshotWriter,
+	) (*usecases.SeasonUseCase, error) {
+		return &usecases.SeasonUseCase{
+			SeasonReader:   seasonReader,
+			SeasonWriter:   seasonWriter,
+			RatingReader:   ratingReader,
+			RatingWriter:   ratingWriter,
+			SnapshotReader: snapshotReader,
+			SnapshotWriter: snapshotWriter,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	// Register Leaderboard use case
+	if err := c.Singleton(func(
+		seasonReader ratings_out.SeasonReader,
+		ratingReader ratings_out.RatingReader,
+		snapshotReader ratings_out.LeaderboardSnapshotReader,
+	) (*usecases.LeaderboardUseCase, error) {
+		return &usecases.LeaderboardUseCase{
+			SeasonReader:   seasonReader,
+			RatingReader:   ratingReader,
+			SnapshotReader: snapshotReader,
+		}, nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+)
+
+// Rating algorithms available to game modes, under the names game modes choose them by
+const (
+	AlgorithmElo       = game_entities.RatingAlgorithmElo
+	AlgorithmGlicko2   = game_entities.RatingAlgorithmGlicko2
+	AlgorithmTrueSkill = game_entities.RatingAlgorithmTrueSkill
+)
+
+// DefaultAlgorithm rates the matches of game modes that do not choose an algorithm
+const DefaultAlgorithm = AlgorithmGlicko2
+
+var ErrUnknownAlgorithm = errors.New("unknown rating algorithm")
+
+// Rating is the skill estimate of a player. Every algorithm works on the MMR scale, centered on 1500, so ratings
+// stay comparable when a game mode switches algorithms. The parameters an algorithm does not use are left at zero;
+// they start from the Initial rating of the new algorithm after a switch.
+type Rating struct {
+	Mu         float64 `json:"mu" bson:"mu"`                 // skill estimate
+	Deviation  float64 `json:"deviation" bson:"deviation"`   // uncertainty of Mu; unused by Elo
+	Volatility float64 `json:"volatility" bson:"volatility"` // how erratic the player's results are; only used by Glicko-2
+}
+
+// Algorithm updates the ratings of the players of a match from the ranks their teams finished at
+type Algorithm interface {
+	Name() string
+	// Initial is the rating of players who have not played yet
+	Initial() Rating
+	// Rate returns the new ratings of the players of every team, in the order given. Teams are ranked from 0 (the
+	// winners); teams sharing a rank drew.
+	Rate(teams [][]Rating, ranks []int) [][]Rating
+}
+
+// NewAlgorithm returns the algorithm with the given name, with its default parameters. An empty name returns the
+// DefaultAlgorithm.
+func NewAlgorithm(name string) (Algorithm, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "":
+		return NewAlgorithm(DefaultAlgorithm)
+	case AlgorithmElo:
+		return DefaultElo, nil
+	case AlgorithmGlicko2:
+		return DefaultGlicko2, nil
+	case AlgorithmTrueSkill:
+		return DefaultTrueSkill, nil
+	default:
+		return nil, ErrUnknownAlgorithm
+	}
+}
+
+// teamMeans returns the average Mu of every team
+func teamMeans(teams [][]Rating) []float64 {
+	means := make([]float64, len(teams))
+	for i, team := range teams {
+		for _, r := range team {
+			means[i] += r.Mu
+		}
+
+		if len(team) > 0 {
+			means[i] /= float64(len(team))
+		}
+	}
+
+	return means
+}
+
+// score is the result of a team against another: 1 when it finished ahead, 0.5 on a draw, 0 behind
+func score(rank, opponentRank int) float64 {
+	switch {
+	case rank < opponentRank:
+		return 1
+	case rank == opponentRank:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// cloneTeams copies the ratings, so algorithms never update their input
+func cloneTeams(teams [][]Rating) [][]Rating {
+	cloned := make([][]Rating, len(teams))
+	for i, team := range teams {
+		cloned[i] = slices.Clone(team)
+	}
+
+	return cloned
+}
@@ -0,0 +1,114 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+)
+
+func TestNewAlgorithm(t *testing.T) {
+	t.Run("Finds Every Algorithm By Name", func(t *testing.T) {
+		for _, name := range []string{ratings_entities.AlgorithmElo, ratings_entities.AlgorithmGlicko2, ratings_entities.AlgorithmTrueSkill} {
+			algorithm, err := ratings_entities.NewAlgorithm(name)
+
+			require.NoError(t, err)
+			assert.Equal(t, name, algorithm.Name())
+		}
+	})
+
+	t.Run("Defaults To Glicko-2", func(t *testing.T) {
+		algorithm, err := ratings_entities.NewAlgorithm("")
+
+		require.NoError(t, err)
+		assert.Equal(t, ratings_entities.AlgorithmGlicko2, algorithm.Name())
+	})
+
+	t.Run("Fails For Unknown Algorithms", func(t *testing.T) {
+		_, err := ratings_entities.NewAlgorithm("chess.com")
+
+		assert.ErrorIs(t, err, ratings_entities.ErrUnknownAlgorithm)
+	})
+}
+
+func TestAlgorithms_RewardWinnersAndDoNotUpdateTheirInput(t *testing.T) {
+	for _, algorithm := range []ratings_entities.Algorithm{ratings_entities.DefaultElo, ratings_entities.DefaultGlicko2, ratings_entities.DefaultTrueSkill} {
+		t.Run(algorithm.Name(), func(t *testing.T) {
+			initial := algorithm.Initial()
+			teams := [][]ratings_entities.Rating{{initial, initial}, {initial, initial}}
+
+			rated := algorithm.Rate(teams, []int{1, 0})
+
+			assert.Less(t, rated[0][0].Mu, initial.Mu)
+			assert.Greater(t, rated[1][1].Mu, initial.Mu)
+			assert.InDelta(t, initial.Mu-rated[0][0].Mu, rated[1][0].Mu-initial.Mu, 0.001, "evenly matched teams win and lose as much")
+			assert.Equal(t, initial, teams[0][0])
+		})
+	}
+}
+
+func TestElo_Rate(t *testing.T) {
+	t.Run("Moves Evenly Matched Teams By Half K", func(t *testing.T) {
+		rated := ratings_entities.DefaultElo.Rate([][]ratings_entities.Rating{{{Mu: 1500}}, {{Mu: 1500}}}, []int{0, 1})
+
+		assert.InDelta(t, 1516, rated[0][0].Mu, 0.001)
+		assert.InDelta(t, 1484, rated[1][0].Mu, 0.001)
+	})
+
+	t.Run("Takes Points From The Favorite On A Draw", func(t *testing.T) {
+		rated := ratings_entities.DefaultElo.Rate([][]ratings_entities.Rating{{{Mu: 1700}}, {{Mu: 1300}}}, []int{0, 0})
+
+		assert.Less(t, rated[0][0].Mu, 1700.0)
+		assert.Greater(t, rated[1][0].Mu, 1300.0)
+	})
+}
+
+func TestGlicko2_Rate(t *testing.T) {
+	t.Run("Follows The Example Of The Glicko-2 Paper", func(t *testing.T) {
+		player := ratings_entities.Rating{Mu: 1500, Deviation: 200, Volatility: 0.06}
+
+		// the player beats the 1400 and loses to the 1550 and the 1700; results between opponents do not affect them
+		rated := ratings_entities.DefaultGlicko2.Rate([][]ratings_entities.Rating{
+			{player},
+			{{Mu: 1400, Deviation: 30, Volatility: 0.06}},
+			{{Mu: 1550, Deviation: 100, Volatility: 0.06}},
+			{{Mu: 1700, Deviation: 300, Volatility: 0.06}},
+		}, []int{2, 3, 1, 0})
+
+		assert.InDelta(t, 1464.06, rated[0][0].Mu, 0.01)
+		assert.InDelta(t, 151.52, rated[0][0].Deviation, 0.01)
+		assert.InDelta(t, 0.05999, rated[0][0].Volatility, 0.00001)
+	})
+}
+
+func TestTrueSkill_Rate(t *testing.T) {
+	t.Run("Shrinks The Deviation Of Everyone", func(t *testing.T) {
+		initial := ratings_entities.DefaultTrueSkill.Initial()
+
+		rated := ratings_entities.DefaultTrueSkill.Rate([][]ratings_entities.Rating{{initial}, {initial}}, []int{0, 1})
+
+		assert.Less(t, rated[0][0].Deviation, initial.Deviation)
+		assert.Less(t, rated[1][0].Deviation, initial.Deviation)
+	})
+
+	t.Run("Leaves Evenly Matched Teams Even On A Draw", func(t *testing.T) {
+		initial := ratings_entities.DefaultTrueSkill.Initial()
+
+		rated := ratings_entities.DefaultTrueSkill.Rate([][]ratings_entities.Rating{{initial}, {initial}}, []int{0, 0})
+
+		assert.InDelta(t, initial.Mu, rated[0][0].Mu, 0.001)
+		assert.InDelta(t, initial.Mu, rated[1][0].Mu, 0.001)
+	})
+
+	t.Run("Rewards Upsets More Than Expected Wins", func(t *testing.T) {
+		strong := ratings_entities.Rating{Mu: 1800, Deviation: 200}
+		weak := ratings_entities.Rating{Mu: 1200, Deviation: 200}
+
+		expected := ratings_entities.DefaultTrueSkill.Rate([][]ratings_entities.Rating{{strong}, {weak}}, []int{0, 1})
+		upset := ratings_entities.DefaultTrueSkill.Rate([][]ratings_entities.Rating{{strong}, {weak}}, []int{1, 0})
+
+		assert.Greater(t, upset[1][0].Mu-weak.Mu, expected[0][0].Mu-strong.Mu)
+	})
+}
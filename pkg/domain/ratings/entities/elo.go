@@ -0,0 +1,47 @@
+package entities
+
+import "math"
+
+// Elo rates teams by their average Mu. Every team plays every other one, and each of its players gets the team's
+// rating change: K times the average difference between its actual and expected scores.
+type Elo struct {
+	K float64 // largest rating change of a match
+}
+
+// DefaultElo is the Elo algorithm with the usual K factor
+var DefaultElo = Elo{K: 32}
+
+func (Elo) Name() string {
+	return AlgorithmElo
+}
+
+func (Elo) Initial() Rating {
+	return Rating{Mu: 1500}
+}
+
+func (e Elo) Rate(teams [][]Rating, ranks []int) [][]Rating {
+	rated := cloneTeams(teams)
+	if len(teams) < 2 {
+		return rated
+	}
+
+	means := teamMeans(teams)
+
+	for i := range teams {
+		var delta float64
+		for j := range teams {
+			if i != j {
+				expected := 1 / (1 + math.Pow(10, (means[j]-means[i])/400))
+				delta += score(ranks[i], ranks[j]) - expected
+			}
+		}
+
+		delta *= e.K / float64(len(teams)-1)
+
+		for p := range rated[i] {
+			rated[i][p].Mu += delta
+		}
+	}
+
+	return rated
+}
@@ -0,0 +1,141 @@
+package entities
+
+import "math"
+
+// glicko2Scale converts ratings from the MMR scale to the Glicko-2 one
+const glicko2Scale = 173.7178
+
+// Glicko2 rates every player against the other teams of the match, each standing in as one opponent with the
+// average Mu and the quadratic mean Deviation of its players. A match is a rating period.
+type Glicko2 struct {
+	Tau     float64 // constrains how fast volatility changes
+	Epsilon float64 // convergence tolerance of the volatility iteration
+}
+
+// DefaultGlicko2 is the Glicko-2 algorithm with the parameters suggested by its author
+var DefaultGlicko2 = Glicko2{Tau: 0.5, Epsilon: 0.000001}
+
+func (Glicko2) Name() string {
+	return AlgorithmGlicko2
+}
+
+func (Glicko2) Initial() Rating {
+	return Rating{Mu: 1500, Deviation: 350, Volatility: 0.06}
+}
+
+func (g Glicko2) Rate(teams [][]Rating, ranks []int) [][]Rating {
+	rated := cloneTeams(teams)
+	if len(teams) < 2 {
+		return rated
+	}
+
+	means := teamMeans(teams)
+
+	deviations := make([]float64, len(teams))
+	for i, team := range teams {
+		for _, r := range team {
+			deviations[i] += r.Deviation * r.Deviation
+		}
+
+		if len(team) > 0 {
+			deviations[i] = math.Sqrt(deviations[i] / float64(len(team)))
+		}
+	}
+
+	for i, team := range teams {
+		for p, player := range team {
+			var results []glicko2Result
+			for j := range teams {
+				if i != j {
+					results = append(results, glicko2Result{
+						mu:    (means[j] - 1500) / glicko2Scale,
+						phi:   deviations[j] / glicko2Scale,
+						score: score(ranks[i], ranks[j]),
+					})
+				}
+			}
+
+			rated[i][p] = g.update(player, results)
+		}
+	}
+
+	return rated
+}
+
+// glicko2Result is a result against an opponent, on the Glicko-2 scale
+type glicko2Result struct {
+	mu, phi, score float64
+}
+
+// update applies the Glicko-2 rating period made of the results to the player
+func (g Glicko2) update(player Rating, results []glicko2Result) Rating {
+	mu := (player.Mu - 1500) / glicko2Scale
+	phi := player.Deviation / glicko2Scale
+
+	var variance, improvement float64
+	for _, result := range results {
+		impact := glicko2Impact(result.phi)
+		expected := 1 / (1 + math.Exp(-impact*(mu-result.mu)))
+
+		variance += impact * impact * expected * (1 - expected)
+		improvement += impact * (result.score - expected)
+	}
+
+	v := 1 / variance
+	delta := v * improvement
+
+	sigma := g.volatility(phi, player.Volatility, v, delta)
+
+	prePhi := math.Sqrt(phi*phi + sigma*sigma)
+	newPhi := 1 / math.Sqrt(1/(prePhi*prePhi)+1/v)
+	newMu := mu + newPhi*newPhi*improvement
+
+	return Rating{
+		Mu:         newMu*glicko2Scale + 1500,
+		Deviation:  newPhi * glicko2Scale,
+		Volatility: sigma,
+	}
+}
+
+// volatility finds the new volatility with the Illinois algorithm, as specified by Glicko-2
+func (g Glicko2) volatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		return ex*(delta*delta-phi*phi-v-ex)/(2*math.Pow(phi*phi+v+ex, 2)) - (x-a)/(g.Tau*g.Tau)
+	}
+
+	low := a
+	var high float64
+	if delta*delta > phi*phi+v {
+		high = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*g.Tau) < 0 {
+			k++
+		}
+
+		high = a - k*g.Tau
+	}
+
+	fLow, fHigh := f(low), f(high)
+	for math.Abs(high-low) > g.Epsilon {
+		c := low + (low-high)*fLow/(fHigh-fLow)
+		fc := f(c)
+
+		if fc*fHigh <= 0 {
+			low, fLow = high, fHigh
+		} else {
+			fLow /= 2
+		}
+
+		high, fHigh = c, fc
+	}
+
+	return math.Exp(low / 2)
+}
+
+// glicko2Impact weighs a result by how certain the opponent's rating is
+func glicko2Impact(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
@@ -0,0 +1,152 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+)
+
+var ErrNotRanked = errors.New("player is not ranked in the leaderboard")
+
+// LeaderboardScope selects the ratings ranked in a leaderboard. Ratings are ranked by Mu, highest first, with
+// ties broken by rating ID so every player has a distinct, stable rank.
+type LeaderboardScope struct {
+	GameID      uuid.UUID
+	GameModeID  *uuid.UUID // nil ranks the ratings of the whole game
+	Region      string     // slug of the region the players last played in; empty ranks every region
+	PlayedSince time.Time  // only players who played since then are ranked; zero ranks everyone
+}
+
+// Includes tells whether the rating is ranked in the leaderboard
+func (s LeaderboardScope) Includes(rating *PlayerRating) bool {
+	if rating.GameID != s.GameID || !sameGameMode(rating.GameModeID, s.GameModeID) {
+		return false
+	}
+
+	if s.Region != "" && rating.Region != s.Region {
+		return false
+	}
+
+	return s.PlayedSince.IsZero() || !rating.LastPlayedAt.Before(s.PlayedSince)
+}
+
+func sameGameMode(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// LeaderboardEntry is the standing of a player in a leaderboard
+type LeaderboardEntry struct {
+	Rank       int       `json:"rank" bson:"rank"` // from 1
+	PlayerID   uuid.UUID `json:"player_id" bson:"player_id"`
+	Region     string    `json:"region,omitempty" bson:"region,omitempty"`
+	MMR        int       `json:"mmr" bson:"mmr"`
+	Deviation  float64   `json:"deviation" bson:"deviation"`
+	Matches    int       `json:"matches" bson:"matches"`
+	Wins       int       `json:"wins" bson:"wins"`
+	Losses     int       `json:"losses" bson:"losses"`
+	Draws      int       `json:"draws" bson:"draws"`
+	Percentile float64   `json:"percentile" bson:"percentile"` // share of the other ranked players this one is ahead of, from 0 to 100
+	Tier       string    `json:"tier" bson:"tier"`
+}
+
+// NewLeaderboardEntry returns the standing of the rating at the given rank, out of total ranked players
+func NewLeaderboardEntry(rating *PlayerRating, rank, total int, season *Season) LeaderboardEntry {
+	percentile := Percentile(rank, total)
+
+	return LeaderboardEntry{
+		Rank:       rank,
+		PlayerID:   rating.PlayerID,
+		Region:     rating.Region,
+		MMR:        rating.MMR(),
+		Deviation:  rating.Deviation,
+		Matches:    rating.Matches,
+		Wins:       rating.Wins,
+		Losses:     rating.Losses,
+		Draws:      rating.Draws,
+		Percentile: percentile,
+		Tier:       season.TierFor(percentile),
+	}
+}
+
+// Percentile returns the share of the other ranked players the player at the given rank is ahead of: 100 for the
+// first, 0 for the last
+func Percentile(rank, total int) float64 {
+	if total <= 1 {
+		return 100
+	}
+
+	return float64(total-rank) / float64(total-1) * 100
+}
+
+// Leaderboard is a page of the standings of a season, in a region or in every region
+type Leaderboard struct {
+	SeasonID uuid.UUID          `json:"season_id"`
+	Region   string             `json:"region,omitempty"`
+	Total    int                `json:"total"` // players ranked
+	Archived bool               `json:"archived"`
+	Entries  []LeaderboardEntry `json:"entries"`
+}
+
+// LeaderboardSnapshot is the final standings of a season, archived when it ended. There is one per season,
+// identified by the season ID.
+type LeaderboardSnapshot struct {
+	common.BaseEntity
+	SeasonID   uuid.UUID          `json:"season_id" bson:"season_id"`
+	GameID     uuid.UUID          `json:"game_id" bson:"game_id"`
+	GameModeID *uuid.UUID         `json:"game_mode_id,omitempty" bson:"game_mode_id,omitempty"`
+	SeasonName string             `json:"season_name" bson:"season_name"`
+	StartsAt   time.Time          `json:"starts_at" bson:"starts_at"`
+	EndsAt     time.Time          `json:"ends_at" bson:"ends_at"`
+	Entries    []LeaderboardEntry `json:"entries" bson:"entries"` // every player ranked, in every region
+}
+
+// NewLeaderboardSnapshot archives the standings of the season, from its ratings ranked in every region
+func NewLeaderboardSnapshot(season *Season, ranked []*PlayerRating) *LeaderboardSnapshot {
+	entity := common.NewEntity(season.ResourceOwner)
+	entity.ID = season.ID
+
+	entries := make([]LeaderboardEntry, len(ranked))
+	for i, rating := range ranked {
+		entries[i] = NewLeaderboardEntry(rating, i+1, len(ranked), season)
+	}
+
+	return &LeaderboardSnapshot{
+		BaseEntity: entity,
+		SeasonID:   season.ID,
+		GameID:     season.GameID,
+		GameModeID: season.GameModeID,
+		SeasonName: season.Name,
+		StartsAt:   season.StartsAt,
+		EndsAt:     season.EndsAt,
+		Entries:    entries,
+	}
+}
+
+// Standings returns the entries of the snapshot in the region, or in every region when it is empty, ranked among
+// themselves
+func (s *LeaderboardSnapshot) Standings(region string, season *Season) []LeaderboardEntry {
+	if region == "" {
+		return s.Entries
+	}
+
+	var standings []LeaderboardEntry
+	for _, entry := range s.Entries {
+		if entry.Region == region {
+			standings = append(standings, entry)
+		}
+	}
+
+	for i := range standings {
+		standings[i].Rank = i + 1
+		standings[i].Percentile = Percentile(i+1, len(standings))
+		standings[i].Tier = season.TierFor(standings[i].Percentile)
+	}
+
+	return standings
+}
@@ -0,0 +1,75 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+)
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, 100.0, ratings_entities.Percentile(1, 5))
+	assert.Equal(t, 50.0, ratings_entities.Percentile(3, 5))
+	assert.Equal(t, 0.0, ratings_entities.Percentile(5, 5))
+	assert.Equal(t, 100.0, ratings_entities.Percentile(1, 1))
+}
+
+func TestLeaderboardScope_Includes(t *testing.T) {
+	gameID, gameModeID := uuid.New(), uuid.New()
+	start := time.Now().Add(-time.Hour)
+
+	scope := ratings_entities.LeaderboardScope{GameID: gameID, GameModeID: &gameModeID, Region: "eu-west", PlayedSince: start}
+
+	rated := func(mutate func(r *ratings_entities.PlayerRating)) *ratings_entities.PlayerRating {
+		modeID := gameModeID
+		rating := ratings_entities.NewPlayerRating(uuid.New(), gameID, &modeID, ratings_entities.DefaultElo)
+		rating.Region = "eu-west"
+		rating.LastPlayedAt = time.Now()
+		mutate(rating)
+
+		return rating
+	}
+
+	assert.True(t, scope.Includes(rated(func(r *ratings_entities.PlayerRating) {})))
+	assert.False(t, scope.Includes(rated(func(r *ratings_entities.PlayerRating) { r.GameModeID = nil })), "rated in the whole game")
+	assert.False(t, scope.Includes(rated(func(r *ratings_entities.PlayerRating) { r.Region = "us-east" })), "other region")
+	assert.False(t, scope.Includes(rated(func(r *ratings_entities.PlayerRating) { r.LastPlayedAt = start.Add(-time.Minute) })), "not played this season")
+
+	scope.Region = ""
+	assert.True(t, scope.Includes(rated(func(r *ratings_entities.PlayerRating) { r.Region = "us-east" })), "every region")
+}
+
+func TestLeaderboardSnapshot_Standings(t *testing.T) {
+	season := &ratings_entities.Season{GameID: uuid.New(), Name: "Season 1"}
+	season.ID = uuid.New()
+
+	var ranked []*ratings_entities.PlayerRating
+	for i, region := range []string{"eu-west", "us-east", "eu-west", "us-east", "eu-west"} {
+		rating := ratings_entities.NewPlayerRating(uuid.New(), season.GameID, nil, ratings_entities.DefaultElo)
+		rating.Mu = float64(2000 - i*100)
+		rating.Region = region
+		ranked = append(ranked, rating)
+	}
+
+	snapshot := ratings_entities.NewLeaderboardSnapshot(season, ranked)
+
+	assert.Equal(t, season.ID, snapshot.ID)
+	require.Len(t, snapshot.Entries, 5)
+	assert.Equal(t, 2000, snapshot.Entries[0].MMR)
+	assert.Equal(t, "master", snapshot.Entries[0].Tier)
+	assert.Equal(t, 5, snapshot.Entries[4].Rank)
+
+	t.Run("Ranks The Players Of A Region Among Themselves", func(t *testing.T) {
+		standings := snapshot.Standings("eu-west", season)
+
+		require.Len(t, standings, 3)
+		assert.Equal(t, []int{1, 2, 3}, []int{standings[0].Rank, standings[1].Rank, standings[2].Rank})
+		assert.Equal(t, ranked[2].PlayerID, standings[1].PlayerID)
+		assert.Equal(t, 50.0, standings[1].Percentile)
+		assert.Equal(t, 3, snapshot.Entries[2].Rank, "snapshot left untouched")
+	})
+}
@@ -0,0 +1,158 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+)
+
+var ErrUnratedMatch = errors.New("match cannot be rated")
+
+// PlayerRating is the rating of a player in a game, or in one of its game modes. There is one per player and scope,
+// identified by RatingID.
+type PlayerRating struct {
+	common.BaseEntity
+	PlayerID   uuid.UUID  `json:"player_id" bson:"player_id"`
+	GameID     uuid.UUID  `json:"game_id" bson:"game_id"`
+	GameModeID *uuid.UUID `json:"game_mode_id,omitempty" bson:"game_mode_id,omitempty"` // nil when the player is rated in the whole game
+	Algorithm  string     `json:"algorithm" bson:"algorithm"`                           // that rated the last match
+	Region     string     `json:"region,omitempty" bson:"region,omitempty"`             // slug of the region of the last match, where the player is ranked
+	Rating     `bson:",inline"`
+
+	Matches      int       `json:"matches" bson:"matches"`
+	Wins         int       `json:"wins" bson:"wins"`
+	Losses       int       `json:"losses" bson:"losses"`
+	Draws        int       `json:"draws" bson:"draws"`
+	LastMatchID  uuid.UUID `json:"last_match_id,omitempty" bson:"last_match_id,omitempty"` // so results delivered twice are rated once
+	LastPlayedAt time.Time `json:"last_played_at,omitempty" bson:"last_played_at,omitempty"`
+	LastResetBy  uuid.UUID `json:"last_reset_by,omitempty" bson:"last_reset_by,omitempty"` // season whose archival last soft reset the rating, so it is reset once
+}
+
+// RatingID identifies the rating of the player in the game mode, or in the whole game when gameModeID is nil
+func RatingID(playerID, gameID uuid.UUID, gameModeID *uuid.UUID) uuid.UUID {
+	scope := "game=" + gameID.String()
+	if gameModeID != nil {
+		scope += "|mode=" + gameModeID.String()
+	}
+
+	return uuid.NewSHA1(playerID, []byte(scope))
+}
+
+// NewPlayerRating returns the rating of a player who has not played in the game mode yet
+func NewPlayerRating(playerID, gameID uuid.UUID, gameModeID *uuid.UUID, algorithm Algorithm) *PlayerRating {
+	entity := common.NewEntity(common.ResourceOwner{UserID: playerID})
+	entity.ID = RatingID(playerID, gameID, gameModeID)
+
+	return &PlayerRating{
+		BaseEntity: entity,
+		PlayerID:   playerID,
+		GameID:     gameID,
+		GameModeID: gameModeID,
+		Algorithm:  algorithm.Name(),
+		Rating:     algorithm.Initial(),
+	}
+}
+
+// MMR returns the rating as the integer MMR the matcher works with
+func (r *PlayerRating) MMR() int {
+	return int(math.Round(r.Mu))
+}
+
+// Record sets the rating the player got for finishing the match at the given result (see MatchOutcome.Result)
+func (r *PlayerRating) Record(outcome MatchOutcome, rating Rating, algorithm string, result float64) {
+	r.Rating = rating
+	r.Algorithm = algorithm
+	r.Matches++
+
+	if outcome.Region != "" {
+		r.Region = outcome.Region
+	}
+
+	switch result {
+	case 1:
+		r.Wins++
+	case 0:
+		r.Losses++
+	default:
+		r.Draws++
+	}
+
+	r.LastMatchID = outcome.MatchID
+	r.LastPlayedAt = outcome.PlayedAt
+	r.UpdatedAt = time.Now()
+}
+
+// TeamOutcome is a team of a match and the rank it finished at, from 0 for the winners. Teams sharing a rank drew.
+type TeamOutcome struct {
+	PlayerIDs []uuid.UUID
+	Rank      int
+}
+
+// MatchOutcome is the final standing of a match, as rated
+type MatchOutcome struct {
+	MatchID    uuid.UUID
+	GameID     uuid.UUID
+	GameModeID *uuid.UUID
+	Region     string // slug of the region the match was played in, when known
+	Teams      []TeamOutcome
+	PlayedAt   time.Time
+}
+
+// Validate fails with ErrUnratedMatch unless at least two teams of players took part in the match
+func (o MatchOutcome) Validate() error {
+	teams := 0
+	for _, team := range o.Teams {
+		if len(team.PlayerIDs) > 0 {
+			teams++
+		}
+	}
+
+	if teams < 2 {
+		return ErrUnratedMatch
+	}
+
+	return nil
+}
+
+// Result is the score of the team against the rest of the match: 1 when no team finished ahead of it, 0 when it
+// finished behind every other team, 0.5 otherwise
+func (o MatchOutcome) Result(team int) float64 {
+	ahead, behind := 0, 0
+	for i, other := range o.Teams {
+		if i == team {
+			continue
+		}
+
+		switch score(o.Teams[team].Rank, other.Rank) {
+		case 0:
+			ahead++
+		case 1:
+			behind++
+		}
+	}
+
+	switch {
+	case ahead == 0 && behind > 0:
+		return 1
+	case behind == 0 && ahead > 0:
+		return 0
+	default:
+		return 0.5
+	}
+}
+
+// Reset soft resets the rating at the end of the season, unless the season already reset it
+func (r *PlayerRating) Reset(season *Season) bool {
+	if r.LastResetBy == season.ID {
+		return false
+	}
+
+	r.Rating = season.SoftReset.Apply(r.Rating)
+	r.LastResetBy = season.ID
+	r.UpdatedAt = time.Now()
+
+	return true
+}
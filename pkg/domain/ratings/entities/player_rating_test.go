@@ -0,0 +1,59 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+)
+
+func TestRatingID(t *testing.T) {
+	playerID, gameID, gameModeID := uuid.New(), uuid.New(), uuid.New()
+
+	assert.Equal(t, ratings_entities.RatingID(playerID, gameID, &gameModeID), ratings_entities.RatingID(playerID, gameID, &gameModeID))
+	assert.NotEqual(t, ratings_entities.RatingID(playerID, gameID, &gameModeID), ratings_entities.RatingID(playerID, gameID, nil))
+	assert.NotEqual(t, ratings_entities.RatingID(playerID, gameID, nil), ratings_entities.RatingID(uuid.New(), gameID, nil))
+}
+
+func TestMatchOutcome(t *testing.T) {
+	team := func(rank int) ratings_entities.TeamOutcome {
+		return ratings_entities.TeamOutcome{PlayerIDs: []uuid.UUID{uuid.New()}, Rank: rank}
+	}
+
+	t.Run("Scores Each Team Against The Rest Of The Match", func(t *testing.T) {
+		outcome := ratings_entities.MatchOutcome{Teams: []ratings_entities.TeamOutcome{team(0), team(1), team(2)}}
+
+		assert.Equal(t, 1.0, outcome.Result(0))
+		assert.Equal(t, 0.5, outcome.Result(1))
+		assert.Equal(t, 0.0, outcome.Result(2))
+	})
+
+	t.Run("Scores Draws", func(t *testing.T) {
+		outcome := ratings_entities.MatchOutcome{Teams: []ratings_entities.TeamOutcome{team(0), team(0)}}
+
+		assert.Equal(t, 0.5, outcome.Result(0))
+	})
+
+	t.Run("Needs Two Teams Of Players", func(t *testing.T) {
+		assert.ErrorIs(t, ratings_entities.MatchOutcome{Teams: []ratings_entities.TeamOutcome{team(0), {Rank: 1}}}.Validate(), ratings_entities.ErrUnratedMatch)
+		assert.NoError(t, ratings_entities.MatchOutcome{Teams: []ratings_entities.TeamOutcome{team(0), team(1)}}.Validate())
+	})
+}
+
+func TestPlayerRating_Record(t *testing.T) {
+	rating := ratings_entities.NewPlayerRating(uuid.New(), uuid.New(), nil, ratings_entities.DefaultGlicko2)
+	outcome := ratings_entities.MatchOutcome{MatchID: uuid.New(), Region: "eu-west", PlayedAt: time.Now()}
+
+	rating.Record(outcome, ratings_entities.Rating{Mu: 1523.6, Deviation: 290}, ratings_entities.AlgorithmElo, 1)
+
+	assert.Equal(t, 1524, rating.MMR())
+	assert.Equal(t, ratings_entities.AlgorithmElo, rating.Algorithm)
+	assert.Equal(t, 1, rating.Matches)
+	assert.Equal(t, 1, rating.Wins)
+	assert.Equal(t, "eu-west", rating.Region)
+	assert.Equal(t, outcome.MatchID, rating.LastMatchID)
+	assert.Equal(t, outcome.PlayedAt, rating.LastPlayedAt)
+}
@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+)
+
+var (
+	ErrInvalidSeason  = errors.New("invalid season")
+	ErrSeasonNotFound = errors.New("season not found")
+	ErrSeasonNotEnded = errors.New("season has not ended yet")
+	ErrSeasonArchived = errors.New("season is already archived")
+	ErrNotArchived    = errors.New("season has not been archived yet")
+)
+
+// DefaultTiers bracket the players of seasons that do not define their own tiers
+var DefaultTiers = []Tier{
+	{Name: "bronze", MinPercentile: 0},
+	{Name: "silver", MinPercentile: 25},
+	{Name: "gold", MinPercentile: 50},
+	{Name: "platinum", MinPercentile: 75},
+	{Name: "diamond", MinPercentile: 90},
+	{Name: "master", MinPercentile: 98},
+}
+
+// Tier is a bracket of the leaderboard, holding the players at or above MinPercentile and below the next tier
+type Tier struct {
+	Name          string  `json:"name" bson:"name"`
+	MinPercentile float64 `json:"min_percentile" bson:"min_percentile"` // from 0 to 100
+}
+
+// SoftReset pulls ratings towards Target when a season is archived, so the next season starts closer together
+// without throwing away what is known about the players: Mu becomes Target + (Mu - Target) * Carry.
+type SoftReset struct {
+	Target    float64 `json:"target,omitempty" bson:"target,omitempty"`       // 0 pulls ratings towards 1500
+	Carry     float64 `json:"carry" bson:"carry"`                             // share of the distance to Target kept, from 0 (hard reset) to 1 (no reset)
+	Deviation float64 `json:"deviation,omitempty" bson:"deviation,omitempty"` // lowest Deviation after the reset, so placements move fast again; 0 keeps it
+}
+
+// Apply returns the rating as reset for the next season
+func (r SoftReset) Apply(rating Rating) Rating {
+	target := r.Target
+	if target == 0 {
+		target = 1500
+	}
+
+	rating.Mu = target + (rating.Mu-target)*r.Carry
+	rating.Deviation = max(rating.Deviation, r.Deviation)
+
+	return rating
+}
+
+// Season is a ranked period of a game, or of one of its game modes. Its leaderboard ranks the players rated
+// during the season; once it ends, it is archived into a LeaderboardSnapshot and ratings are soft reset.
+type Season struct {
+	common.BaseEntity
+	GameID     uuid.UUID  `json:"game_id" bson:"game_id"`
+	GameModeID *uuid.UUID `json:"game_mode_id,omitempty" bson:"game_mode_id,omitempty"` // nil for seasons ranking the whole game
+	Name       string     `json:"name" bson:"name"`
+	StartsAt   time.Time  `json:"starts_at" bson:"starts_at"`
+	EndsAt     time.Time  `json:"ends_at" bson:"ends_at"`
+	SoftReset  SoftReset  `json:"soft_reset" bson:"soft_reset"`
+	Tiers      []Tier     `json:"tiers,omitempty" bson:"tiers,omitempty"`             // empty uses DefaultTiers
+	ArchivedAt *time.Time `json:"archived_at,omitempty" bson:"archived_at,omitempty"` // set once the season was archived
+}
+
+// Validate checks the season can be created
+func (s *Season) Validate() error {
+	if s.GameID == uuid.Nil {
+		return fmt.Errorf("%w: game_id is required", ErrInvalidSeason)
+	}
+
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSeason)
+	}
+
+	if s.StartsAt.IsZero() || !s.EndsAt.After(s.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidSeason)
+	}
+
+	if s.SoftReset.Carry < 0 || s.SoftReset.Carry > 1 {
+		return fmt.Errorf("%w: soft_reset.carry must be between 0 and 1", ErrInvalidSeason)
+	}
+
+	if s.SoftReset.Deviation < 0 {
+		return fmt.Errorf("%w: soft_reset.deviation must not be negative", ErrInvalidSeason)
+	}
+
+	for _, tier := range s.Tiers {
+		if strings.TrimSpace(tier.Name) == "" || tier.MinPercentile < 0 || tier.MinPercentile > 100 {
+			return fmt.Errorf("%w: tiers must be named and have a min_percentile between 0 and 100", ErrInvalidSeason)
+		}
+	}
+
+	return nil
+}
+
+// HasEnded tells whether the season is over at the given instant
+func (s *Season) HasEnded(now time.Time) bool {
+	return !now.Before(s.EndsAt)
+}
+
+// IsArchived tells whether the season standings were archived
+func (s *Season) IsArchived() bool {
+	return s.ArchivedAt != nil
+}
+
+// TierFor returns the tier of the players at the given percentile
+func (s *Season) TierFor(percentile float64) string {
+	tiers := s.Tiers
+	if len(tiers) == 0 {
+		tiers = DefaultTiers
+	}
+
+	tiers = slices.Clone(tiers)
+	slices.SortStableFunc(tiers, func(a, b Tier) int {
+		switch {
+		case a.MinPercentile < b.MinPercentile:
+			return -1
+		case a.MinPercentile > b.MinPercentile:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	tier := ""
+	for _, t := range tiers {
+		if percentile >= t.MinPercentile {
+			tier = t.Name
+		}
+	}
+
+	return tier
+}
+
+// Scope returns the ratings ranked in the season's leaderboard of the region, or of every region when it is empty
+func (s *Season) Scope(region string) LeaderboardScope {
+	return LeaderboardScope{
+		GameID:      s.GameID,
+		GameModeID:  s.GameModeID,
+		Region:      region,
+		PlayedSince: s.StartsAt,
+	}
+}
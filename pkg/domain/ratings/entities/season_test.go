@@ -0,0 +1,86 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+)
+
+func TestSoftReset_Apply(t *testing.T) {
+	t.Run("Pulls Ratings Towards The Target", func(t *testing.T) {
+		reset := ratings_entities.SoftReset{Target: 1500, Carry: 0.5}
+
+		assert.Equal(t, 1600.0, reset.Apply(ratings_entities.Rating{Mu: 1700}).Mu)
+		assert.Equal(t, 1400.0, reset.Apply(ratings_entities.Rating{Mu: 1300}).Mu)
+	})
+
+	t.Run("Hard Resets Without Carry", func(t *testing.T) {
+		assert.Equal(t, 1500.0, ratings_entities.SoftReset{}.Apply(ratings_entities.Rating{Mu: 1900}).Mu)
+	})
+
+	t.Run("Raises The Deviation To The Floor", func(t *testing.T) {
+		reset := ratings_entities.SoftReset{Carry: 1, Deviation: 200}
+
+		assert.Equal(t, 200.0, reset.Apply(ratings_entities.Rating{Mu: 1700, Deviation: 80}).Deviation)
+		assert.Equal(t, 300.0, reset.Apply(ratings_entities.Rating{Mu: 1700, Deviation: 300}).Deviation)
+	})
+}
+
+func TestSeason_Validate(t *testing.T) {
+	now := time.Now()
+	valid := func() ratings_entities.Season {
+		return ratings_entities.Season{GameID: uuid.New(), Name: "Season 1", StartsAt: now, EndsAt: now.Add(24 * time.Hour), SoftReset: ratings_entities.SoftReset{Carry: 0.5}}
+	}
+
+	season := valid()
+	assert.NoError(t, season.Validate())
+
+	for name, invalidate := range map[string]func(s *ratings_entities.Season){
+		"Missing Game":        func(s *ratings_entities.Season) { s.GameID = uuid.Nil },
+		"Missing Name":        func(s *ratings_entities.Season) { s.Name = " " },
+		"Ending Before Start": func(s *ratings_entities.Season) { s.EndsAt = s.StartsAt },
+		"Carry Above One":     func(s *ratings_entities.Season) { s.SoftReset.Carry = 1.5 },
+		"Unnamed Tier":        func(s *ratings_entities.Season) { s.Tiers = []ratings_entities.Tier{{MinPercentile: 10}} },
+	} {
+		t.Run("Rejects "+name, func(t *testing.T) {
+			season := valid()
+			invalidate(&season)
+
+			assert.ErrorIs(t, season.Validate(), ratings_entities.ErrInvalidSeason)
+		})
+	}
+}
+
+func TestSeason_TierFor(t *testing.T) {
+	t.Run("Brackets By The Default Tiers", func(t *testing.T) {
+		season := ratings_entities.Season{}
+
+		assert.Equal(t, "bronze", season.TierFor(0))
+		assert.Equal(t, "gold", season.TierFor(50))
+		assert.Equal(t, "diamond", season.TierFor(97.9))
+		assert.Equal(t, "master", season.TierFor(100))
+	})
+
+	t.Run("Brackets By The Season Tiers In Any Order", func(t *testing.T) {
+		season := ratings_entities.Season{Tiers: []ratings_entities.Tier{{Name: "elite", MinPercentile: 90}, {Name: "contender", MinPercentile: 0}}}
+
+		assert.Equal(t, "contender", season.TierFor(89))
+		assert.Equal(t, "elite", season.TierFor(90))
+	})
+}
+
+func TestPlayerRating_Reset(t *testing.T) {
+	season := &ratings_entities.Season{SoftReset: ratings_entities.SoftReset{Carry: 0.5}}
+	season.ID = uuid.New()
+
+	rating := ratings_entities.NewPlayerRating(uuid.New(), uuid.New(), nil, ratings_entities.DefaultElo)
+	rating.Mu = 1700
+
+	assert.True(t, rating.Reset(season))
+	assert.False(t, rating.Reset(season))
+	assert.Equal(t, 1600.0, rating.Mu)
+}
@@ -0,0 +1,144 @@
+package entities
+
+import (
+	"math"
+	"sort"
+)
+
+// TrueSkill rates teams as the sum of their players' skills, in the manner of TrueSkill. Teams are sorted by rank
+// and each one is compared to the team right behind it; every comparison updates the players of both teams
+// through the usual win or draw factors. This pairwise approximation matches TrueSkill exactly for two teams.
+// Deviation is the standard deviation of the player's skill.
+type TrueSkill struct {
+	Beta            float64 // performance variability of a player in a match
+	Tau             float64 // added to Deviation before every match, so skills keep moving
+	DrawProbability float64 // chance of a draw between evenly matched teams
+}
+
+// DefaultTrueSkill is TrueSkill with its usual parameters, scaled from a mean of 25 to the MMR scale
+var DefaultTrueSkill = TrueSkill{Beta: 250, Tau: 5, DrawProbability: 0.1}
+
+func (TrueSkill) Name() string {
+	return AlgorithmTrueSkill
+}
+
+func (TrueSkill) Initial() Rating {
+	return Rating{Mu: 1500, Deviation: 500}
+}
+
+func (t TrueSkill) Rate(teams [][]Rating, ranks []int) [][]Rating {
+	rated := cloneTeams(teams)
+	if len(teams) < 2 {
+		return rated
+	}
+
+	for _, team := range rated {
+		for p := range team {
+			team[p].Deviation = math.Sqrt(team[p].Deviation*team[p].Deviation + t.Tau*t.Tau)
+		}
+	}
+
+	order := make([]int, len(teams))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return ranks[order[a]] < ranks[order[b]]
+	})
+
+	for k := 0; k+1 < len(order); k++ {
+		ahead, behind := order[k], order[k+1]
+		t.compare(rated[ahead], rated[behind], ranks[ahead] == ranks[behind])
+	}
+
+	return rated
+}
+
+// compare updates the players of two teams after the first one finished ahead of the second, or drew with it
+func (t TrueSkill) compare(ahead, behind []Rating, draw bool) {
+	if len(ahead) == 0 || len(behind) == 0 {
+		return
+	}
+
+	players := len(ahead) + len(behind)
+	variance := float64(players) * t.Beta * t.Beta
+	var muAhead, muBehind float64
+	for _, r := range ahead {
+		muAhead += r.Mu
+		variance += r.Deviation * r.Deviation
+	}
+
+	for _, r := range behind {
+		muBehind += r.Mu
+		variance += r.Deviation * r.Deviation
+	}
+
+	c := math.Sqrt(variance)
+	diff := (muAhead - muBehind) / c
+	margin := t.drawMargin(players) / c
+
+	var v, w float64
+	if draw {
+		v, w = drawFactors(diff, margin)
+	} else {
+		v, w = winFactors(diff, margin)
+	}
+
+	update := func(team []Rating, sign float64) {
+		for p := range team {
+			skill := team[p].Deviation * team[p].Deviation
+			team[p].Mu += sign * skill / c * v
+			team[p].Deviation = math.Sqrt(skill * math.Max(1-skill/variance*w, 0.0001))
+		}
+	}
+
+	update(ahead, 1)
+	update(behind, -1)
+}
+
+// drawMargin is the performance difference under which a match between teams of the given players is a draw
+func (t TrueSkill) drawMargin(players int) float64 {
+	return normalQuantile((t.DrawProbability+1)/2) * math.Sqrt(float64(players)) * t.Beta
+}
+
+// winFactors are the mean and variance corrections of a win by diff, normalized, with the given draw margin
+func winFactors(diff, margin float64) (float64, float64) {
+	x := diff - margin
+
+	denominator := normalCDF(x)
+	if denominator < 1e-12 {
+		return -x, 1
+	}
+
+	v := normalPDF(x) / denominator
+
+	return v, v * (v + x)
+}
+
+// drawFactors are the mean and variance corrections of a draw with a normalized difference diff
+func drawFactors(diff, margin float64) (float64, float64) {
+	a, b := -margin-diff, margin-diff
+
+	denominator := normalCDF(b) - normalCDF(a)
+	if denominator < 1e-12 {
+		return 0, 1
+	}
+
+	v := (normalPDF(a) - normalPDF(b)) / denominator
+	w := v*v + (b*normalPDF(b)-a*normalPDF(a))/denominator
+
+	return v, w
+}
+
+func normalPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
+
+func normalCDF(x float64) float64 {
+	return math.Erfc(-x/math.Sqrt2) / 2
+}
+
+func normalQuantile(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
@@ -0,0 +1,58 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+)
+
+// RatingWriter interface for writing player ratings
+type RatingWriter interface {
+	// Save inserts the rating or replaces the stored one of the same player and scope
+	Save(ctx context.Context, rating *entities.PlayerRating) (*entities.PlayerRating, error)
+}
+
+// RatingReader interface for reading player ratings
+type RatingReader interface {
+	// FindByPlayers returns the ratings the given players have in the game mode, or in the whole game when
+	// gameModeID is nil. Players who have not been rated yet are left out.
+	FindByPlayers(ctx context.Context, gameID uuid.UUID, gameModeID *uuid.UUID, playerIDs ...uuid.UUID) ([]*entities.PlayerRating, error)
+	// FindRanked returns the ratings of the leaderboard in rank order, skipping the first offset ones. A limit of 0
+	// returns every remaining rating.
+	FindRanked(ctx context.Context, scope entities.LeaderboardScope, offset, limit int) ([]*entities.PlayerRating, error)
+	// Count returns the number of ratings ranked in the leaderboard
+	Count(ctx context.Context, scope entities.LeaderboardScope) (int, error)
+	// CountAhead returns the number of ratings of the leaderboard ranked ahead of the given one
+	CountAhead(ctx context.Context, scope entities.LeaderboardScope, rating *entities.PlayerRating) (int, error)
+}
+
+// SeasonWriter interface for writing seasons
+type SeasonWriter interface {
+	// Save inserts the season or replaces the stored one with the same ID
+	Save(ctx context.Context, season *entities.Season) (*entities.Season, error)
+}
+
+// SeasonReader interface for reading seasons
+type SeasonReader interface {
+	// GetByID returns the season, or nil when it does not exist
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Season, error)
+	// Search returns the seasons of the game, or of every game when gameID is nil, latest first. A nil gameModeID
+	// returns the seasons of every game mode.
+	Search(ctx context.Context, gameID *uuid.UUID, gameModeID *uuid.UUID) ([]*entities.Season, error)
+	// FindEnded returns the seasons that ended by now and are not archived yet
+	FindEnded(ctx context.Context, now time.Time) ([]*entities.Season, error)
+}
+
+// LeaderboardSnapshotWriter interface for writing the archived standings of seasons
+type LeaderboardSnapshotWriter interface {
+	// Save inserts the snapshot or replaces the stored one of the same season
+	Save(ctx context.Context, snapshot *entities.LeaderboardSnapshot) (*entities.LeaderboardSnapshot, error)
+}
+
+// LeaderboardSnapshotReader interface for reading the archived standings of seasons
+type LeaderboardSnapshotReader interface {
+	// GetBySeasonID returns the snapshot of the season, or nil when it was not archived
+	GetBySeasonID(ctx context.Context, seasonID uuid.UUID) (*entities.LeaderboardSnapshot, error)
+}
@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+)
+
+const (
+	// DefaultLeaderboardLimit is the page size of Top when no limit is given
+	DefaultLeaderboardLimit = 50
+	// MaxLeaderboardLimit caps the page size of Top and the radius of AroundPlayer
+	MaxLeaderboardLimit = 200
+	// DefaultLeaderboardRadius is the number of players shown above and below the player by AroundPlayer
+	DefaultLeaderboardRadius = 5
+)
+
+// LeaderboardUseCase serves the standings of seasons, per region or across every region. Live seasons are ranked from
+// the current ratings; archived seasons are served from their LeaderboardSnapshot.
+type LeaderboardUseCase struct {
+	SeasonReader   ratings_out.SeasonReader
+	RatingReader   ratings_out.RatingReader
+	SnapshotReader ratings_out.LeaderboardSnapshotReader
+}
+
+// Top returns the page of the season's leaderboard in the region, or in every region when it is empty, starting
+// at the given offset
+func (uc *LeaderboardUseCase) Top(ctx context.Context, seasonID uuid.UUID, region string, offset, limit int) (*ratings_entities.Leaderboard, error) {
+	season, err := uc.season(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardUseCase.Top: %w", err)
+	}
+
+	offset = max(offset, 0)
+	if limit <= 0 {
+		limit = DefaultLeaderboardLimit
+	}
+	limit = min(limit, MaxLeaderboardLimit)
+
+	if season.IsArchived() {
+		standings, err := uc.archived(ctx, season, region)
+		if err != nil {
+			return nil, fmt.Errorf("LeaderboardUseCase.Top: %w", err)
+		}
+
+		return archivedLeaderboard(season, region, standings, offset, offset+limit), nil
+	}
+
+	scope := season.Scope(region)
+
+	total, err := uc.RatingReader.Count(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardUseCase.Top: unable to count players of season %v: %w", seasonID, err)
+	}
+
+	ranked, err := uc.RatingReader.FindRanked(ctx, scope, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardUseCase.Top: unable to rank season %v: %w", seasonID, err)
+	}
+
+	return liveLeaderboard(season, region, total, offset, ranked), nil
+}
+
+// AroundPlayer returns the standing of the player in the season's leaderboard of the region, or of every region when
+// it is empty, along with up to radius players ranked right above and below them. Fails with ErrNotRanked when the
+// player is not ranked in the leaderboard.
+func (uc *LeaderboardUseCase) AroundPlayer(ctx context.Context, seasonID uuid.UUID, region string, playerID uuid.UUID, radius int) (*ratings_entities.Leaderboard, error) {
+	season, err := uc.season(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardUseCase.AroundPlayer: %w", err)
+	}
+
+	if radius <= 0 {
+		radius = DefaultLeaderboardRadius
+	}
+	radius = min(radius, MaxLeaderboardLimit)
+
+	if season.IsArchived() {
+		standings, err := uc.archived(ctx, season, region)
+		if err != nil {
+			return nil, fmt.Errorf("LeaderboardUseCase.AroundPlayer: %w", err)
+		}
+
+		for i, entry := range standings {
+			if entry.PlayerID == playerID {
+				return archivedLeaderboard(season, region, standings, i-radius, i+radius+1), nil
+			}
+		}
+
+		return nil, fmt.Errorf("LeaderboardUseCase.AroundPlayer: player %v: %w", playerID, ratings_entities.ErrNotRanked)
+	}
+
+	scope := season.Scope(region)
+
+	ratings, err := uc.RatingReader.FindByPlayers(ctx, season.GameID, season.GameModeID, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardUseCase.AroundPlayer: unable to find rating of player %v: %w", playerID, err)
+	}
+
+	if len(ratings) == 0 || !scope.Includes(ratings[0]) {
+		return nil, fmt.Errorf("LeaderboardUseCase.AroundPlayer: player %v: %w", playerID, ratings_entities.ErrNotRanked)
+	}
+
+	ahead, err := uc.RatingReader.CountAhead(ctx, scope, ratings[0])
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardUseCase.AroundPlayer: unable to rank player %v: %w", playerID, err)
+	}
+
+	total, err := uc.RatingReader.Count(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardUseCase.AroundPlayer: unable to count players of season %v: %w", seasonID, err)
+	}
+
+	offset := max(ahead-radius, 0)
+
+	ranked, err := uc.RatingReader.FindRanked(ctx, scope, offset, ahead-offset+radius+1)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardUseCase.AroundPlayer: unable to rank season %v: %w", seasonID, err)
+	}
+
+	return liveLeaderboard(season, region, total, offset, ranked), nil
+}
+
+// season returns the season, failing with ErrSeasonNotFound when it does not exist
+func (uc *LeaderboardUseCase) season(ctx context.Context, seasonID uuid.UUID) (*ratings_entities.Season, error) {
+	season, err := uc.SeasonReader.GetByID(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get season %v: %w", seasonID, err)
+	}
+
+	if season == nil {
+		return nil, fmt.Errorf("season %v: %w", seasonID, ratings_entities.ErrSeasonNotFound)
+	}
+
+	return season, nil
+}
+
+// archived returns the standings archived for the season in the region
+func (uc *LeaderboardUseCase) archived(ctx context.Context, season *ratings_entities.Season, region string) ([]ratings_entities.LeaderboardEntry, error) {
+	snapshot, err := uc.SnapshotReader.GetBySeasonID(ctx, season.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get snapshot of season %v: %w", season.ID, err)
+	}
+
+	if snapshot == nil {
+		return nil, fmt.Errorf("season %v: %w", season.ID, ratings_entities.ErrNotArchived)
+	}
+
+	return snapshot.Standings(region, season), nil
+}
+
+// liveLeaderboard returns the leaderboard of the ratings ranked from offset, out of total ranked players
+func liveLeaderboard(season *ratings_entities.Season, region string, total, offset int, ranked []*ratings_entities.PlayerRating) *ratings_entities.Leaderboard {
+	entries := make([]ratings_entities.LeaderboardEntry, len(ranked))
+	for i, rating := range ranked {
+		entries[i] = ratings_entities.NewLeaderboardEntry(rating, offset+i+1, total, season)
+	}
+
+	return &ratings_entities.Leaderboard{SeasonID: season.ID, Region: region, Total: total, Entries: entries}
+}
+
+// archivedLeaderboard returns the leaderboard of the archived standings between from and to, clamped to the standings
+func archivedLeaderboard(season *ratings_entities.Season, region string, standings []ratings_entities.LeaderboardEntry, from, to int) *ratings_entities.Leaderboard {
+	from = min(max(from, 0), len(standings))
+	to = min(max(to, from), len(standings))
+
+	return &ratings_entities.Leaderboard{
+		SeasonID: season.ID,
+		Region:   region,
+		Total:    len(standings),
+		Archived: true,
+		Entries:  append([]ratings_entities.LeaderboardEntry{}, standings[from:to]...),
+	}
+}
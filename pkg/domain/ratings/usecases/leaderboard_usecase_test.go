@@ -0,0 +1,107 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/ratings/usecases"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+func TestLeaderboardUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	season := &ratings_entities.Season{GameID: uuid.New(), Name: "Season 1", StartsAt: time.Now().Add(-time.Hour), EndsAt: time.Now().Add(time.Hour)}
+	season.ID = uuid.New()
+
+	ratings := make([]*ratings_entities.PlayerRating, 10)
+	for i := range ratings {
+		ratings[i] = ratings_entities.NewPlayerRating(uuid.New(), season.GameID, nil, ratings_entities.DefaultElo)
+		ratings[i].Mu = float64(2000 - i*50)
+		ratings[i].Region = "eu-west"
+		ratings[i].LastPlayedAt = time.Now()
+	}
+
+	seasonReader := &mocks.MockPortSeasonReader{}
+	seasonReader.On("GetByID", ctx, season.ID).Return(season, nil)
+
+	scope := season.Scope("eu-west")
+
+	t.Run("Ranks A Page Of The Live Leaderboard", func(t *testing.T) {
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("Count", ctx, scope).Return(10, nil)
+		ratingReader.On("FindRanked", ctx, scope, 4, 2).Return(ratings[4:6], nil)
+
+		uc := usecases.LeaderboardUseCase{SeasonReader: seasonReader, RatingReader: ratingReader}
+
+		leaderboard, err := uc.Top(ctx, season.ID, "eu-west", 4, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, leaderboard.Total)
+		require.Len(t, leaderboard.Entries, 2)
+		assert.Equal(t, 5, leaderboard.Entries[0].Rank)
+		assert.Equal(t, ratings[5].PlayerID, leaderboard.Entries[1].PlayerID)
+		assert.False(t, leaderboard.Archived)
+	})
+
+	t.Run("Ranks The Players Around A Player", func(t *testing.T) {
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindByPlayers", ctx, season.GameID, (*uuid.UUID)(nil), []uuid.UUID{ratings[1].PlayerID}).Return(ratings[1:2], nil)
+		ratingReader.On("CountAhead", ctx, scope, ratings[1]).Return(1, nil)
+		ratingReader.On("Count", ctx, scope).Return(10, nil)
+		ratingReader.On("FindRanked", ctx, scope, 0, 4).Return(ratings[0:4], nil)
+
+		uc := usecases.LeaderboardUseCase{SeasonReader: seasonReader, RatingReader: ratingReader}
+
+		leaderboard, err := uc.AroundPlayer(ctx, season.ID, "eu-west", ratings[1].PlayerID, 2)
+
+		require.NoError(t, err)
+		require.Len(t, leaderboard.Entries, 4)
+		assert.Equal(t, 2, leaderboard.Entries[1].Rank)
+		assert.Equal(t, ratings[1].PlayerID, leaderboard.Entries[1].PlayerID)
+	})
+
+	t.Run("Fails For Players Not Ranked In The Leaderboard", func(t *testing.T) {
+		elsewhere := ratings_entities.NewPlayerRating(uuid.New(), season.GameID, nil, ratings_entities.DefaultElo)
+		elsewhere.Region = "us-east"
+		elsewhere.LastPlayedAt = time.Now()
+
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindByPlayers", ctx, season.GameID, (*uuid.UUID)(nil), []uuid.UUID{elsewhere.PlayerID}).Return([]*ratings_entities.PlayerRating{elsewhere}, nil)
+
+		uc := usecases.LeaderboardUseCase{SeasonReader: seasonReader, RatingReader: ratingReader}
+
+		_, err := uc.AroundPlayer(ctx, season.ID, "eu-west", elsewhere.PlayerID, 2)
+
+		assert.ErrorIs(t, err, ratings_entities.ErrNotRanked)
+	})
+
+	t.Run("Serves Archived Seasons From Their Snapshot", func(t *testing.T) {
+		archivedAt := time.Now()
+		archived := *season
+		archived.ID = uuid.New()
+		archived.ArchivedAt = &archivedAt
+
+		archivedReader := &mocks.MockPortSeasonReader{}
+		archivedReader.On("GetByID", ctx, archived.ID).Return(&archived, nil)
+
+		snapshotReader := &mocks.MockPortLeaderboardSnapshotReader{}
+		snapshotReader.On("GetBySeasonID", ctx, archived.ID).Return(ratings_entities.NewLeaderboardSnapshot(&archived, ratings), nil)
+
+		uc := usecases.LeaderboardUseCase{SeasonReader: archivedReader, SnapshotReader: snapshotReader}
+
+		leaderboard, err := uc.AroundPlayer(ctx, archived.ID, "", ratings[9].PlayerID, 2)
+
+		require.NoError(t, err)
+		assert.True(t, leaderboard.Archived)
+		require.Len(t, leaderboard.Entries, 3)
+		assert.Equal(t, 10, leaderboard.Entries[2].Rank)
+		assert.Equal(t, 0.0, leaderboard.Entries[2].Percentile)
+	})
+}
@@ -0,0 +1,156 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	game_out "github.com/leet-gaming/match-making-api/pkg/domain/game/ports/out"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+)
+
+// RatingUseCase keeps the ratings of players up to date with the results of their matches, rating each game mode
+// with the algorithm it chooses, and serves them as MMR to the matcher
+type RatingUseCase struct {
+	RatingReader   ratings_out.RatingReader
+	RatingWriter   ratings_out.RatingWriter
+	GameModeReader game_out.GameModeReader // Optional: if nil, every game mode is rated with the DefaultAlgorithm
+}
+
+// RecordMatch rates the players of the match from the ranks their teams finished at. Players who were already rated
+// for the match are left untouched, so results delivered twice are only counted once. Returns the updated ratings.
+func (uc *RatingUseCase) RecordMatch(ctx context.Context, outcome ratings_entities.MatchOutcome) ([]*ratings_entities.PlayerRating, error) {
+	if err := outcome.Validate(); err != nil {
+		return nil, fmt.Errorf("RatingUseCase.RecordMatch: match %v: %w", outcome.MatchID, err)
+	}
+
+	var playerIDs []uuid.UUID
+	for _, team := range outcome.Teams {
+		playerIDs = append(playerIDs, team.PlayerIDs...)
+	}
+
+	algorithm := uc.algorithmFor(ctx, outcome.GameModeID)
+
+	ratings, err := uc.ratingsOf(ctx, algorithm, outcome.GameID, outcome.GameModeID, playerIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("RatingUseCase.RecordMatch: %w", err)
+	}
+
+	teams := make([][]ratings_entities.Rating, len(outcome.Teams))
+	ranks := make([]int, len(outcome.Teams))
+	for i, team := range outcome.Teams {
+		ranks[i] = team.Rank
+		for _, playerID := range team.PlayerIDs {
+			teams[i] = append(teams[i], ratings[playerID].Rating)
+		}
+	}
+
+	if outcome.PlayedAt.IsZero() {
+		outcome.PlayedAt = time.Now()
+	}
+
+	rated := algorithm.Rate(teams, ranks)
+
+	var updated []*ratings_entities.PlayerRating
+	for i, team := range outcome.Teams {
+		result := outcome.Result(i)
+
+		for p, playerID := range team.PlayerIDs {
+			rating := ratings[playerID]
+			if rating.LastMatchID == outcome.MatchID {
+				continue
+			}
+
+			rating.Record(outcome, rated[i][p], algorithm.Name(), result)
+
+			if _, err := uc.RatingWriter.Save(ctx, rating); err != nil {
+				return updated, fmt.Errorf("RatingUseCase.RecordMatch: unable to save rating of player %v: %w", playerID, err)
+			}
+
+			updated = append(updated, rating)
+		}
+	}
+
+	slog.InfoContext(ctx, "match rated", "match_id", outcome.MatchID, "algorithm", algorithm.Name(), "players", len(updated))
+
+	return updated, nil
+}
+
+// MMR returns the average rating of the players in the game mode, or in the whole game when gameModeID is nil.
+// Players who have not been rated yet count with the initial rating of the game mode's algorithm.
+func (uc *RatingUseCase) MMR(ctx context.Context, gameID uuid.UUID, gameModeID *uuid.UUID, playerIDs ...uuid.UUID) (int, error) {
+	if len(playerIDs) == 0 {
+		return 0, nil
+	}
+
+	ratings, err := uc.ratingsOf(ctx, uc.algorithmFor(ctx, gameModeID), gameID, gameModeID, playerIDs...)
+	if err != nil {
+		return 0, fmt.Errorf("RatingUseCase.MMR: %w", err)
+	}
+
+	total := 0
+	for _, playerID := range playerIDs {
+		total += ratings[playerID].MMR()
+	}
+
+	return total / len(playerIDs), nil
+}
+
+// ratingsOf returns the rating of every player by player ID, starting the ones not rated yet from the algorithm's
+// initial rating. Ratings kept by another algorithm keep their Mu, and take the algorithm's initial Deviation and
+// Volatility where they have none, so an Elo rating is not frozen once the game mode switches to Glicko-2.
+func (uc *RatingUseCase) ratingsOf(ctx context.Context, algorithm ratings_entities.Algorithm, gameID uuid.UUID, gameModeID *uuid.UUID, playerIDs ...uuid.UUID) (map[uuid.UUID]*ratings_entities.PlayerRating, error) {
+	found, err := uc.RatingReader.FindByPlayers(ctx, gameID, gameModeID, playerIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find ratings of players %v: %w", playerIDs, err)
+	}
+
+	ratings := make(map[uuid.UUID]*ratings_entities.PlayerRating, len(playerIDs))
+	initial := algorithm.Initial()
+	for _, rating := range found {
+		if rating.Algorithm != algorithm.Name() {
+			if rating.Deviation == 0 {
+				rating.Deviation = initial.Deviation
+			}
+
+			if rating.Volatility == 0 {
+				rating.Volatility = initial.Volatility
+			}
+		}
+
+		ratings[rating.PlayerID] = rating
+	}
+
+	for _, playerID := range playerIDs {
+		if ratings[playerID] == nil {
+			ratings[playerID] = ratings_entities.NewPlayerRating(playerID, gameID, gameModeID, algorithm)
+		}
+	}
+
+	return ratings, nil
+}
+
+// algorithmFor returns the algorithm chosen by the game mode, or the DefaultAlgorithm when the game mode does not
+// choose one or cannot be resolved
+func (uc *RatingUseCase) algorithmFor(ctx context.Context, gameModeID *uuid.UUID) ratings_entities.Algorithm {
+	name := ""
+	if uc.GameModeReader != nil && gameModeID != nil {
+		gameMode, err := uc.GameModeReader.GetByID(ctx, *gameModeID)
+		if err != nil || gameMode == nil {
+			slog.WarnContext(ctx, "unable to resolve game mode, rating with the default algorithm", "game_mode_id", gameModeID, "error", err)
+		} else {
+			name = gameMode.Matchmaking.RatingAlgorithm
+		}
+	}
+
+	algorithm, err := ratings_entities.NewAlgorithm(name)
+	if err != nil {
+		slog.WarnContext(ctx, "unknown rating algorithm, rating with the default algorithm", "game_mode_id", gameModeID, "algorithm", name)
+		algorithm, _ = ratings_entities.NewAlgorithm(ratings_entities.DefaultAlgorithm)
+	}
+
+	return algorithm
+}
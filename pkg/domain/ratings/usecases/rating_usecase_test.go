@@ -0,0 +1,168 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/ratings/usecases"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+func TestRatingUseCase_RecordMatch(t *testing.T) {
+	ctx := context.Background()
+	gameID, gameModeID := uuid.New(), uuid.New()
+
+	newOutcome := func() ratings_entities.MatchOutcome {
+		return ratings_entities.MatchOutcome{
+			MatchID:    uuid.New(),
+			GameID:     gameID,
+			GameModeID: &gameModeID,
+			Teams: []ratings_entities.TeamOutcome{
+				{PlayerIDs: []uuid.UUID{uuid.New()}, Rank: 0},
+				{PlayerIDs: []uuid.UUID{uuid.New()}, Rank: 1},
+			},
+		}
+	}
+
+	eloGameMode := func() *mocks.MockPortGameModeReader {
+		gameModeReader := &mocks.MockPortGameModeReader{}
+		gameModeReader.On("GetByID", ctx, gameModeID).Return(&game_entities.GameMode{
+			Matchmaking: game_entities.MatchmakingSettings{RatingAlgorithm: ratings_entities.AlgorithmElo},
+		}, nil)
+
+		return gameModeReader
+	}
+
+	t.Run("Rates New Players With The Algorithm Of The Game Mode", func(t *testing.T) {
+		outcome := newOutcome()
+
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindByPlayers", ctx, gameID, &gameModeID, mock.Anything).Return([]*ratings_entities.PlayerRating{}, nil)
+
+		ratingWriter := &mocks.MockPortRatingWriter{}
+		ratingWriter.On("Save", ctx, mock.Anything).Return(&ratings_entities.PlayerRating{}, nil).Twice()
+
+		uc := usecases.RatingUseCase{RatingReader: ratingReader, RatingWriter: ratingWriter, GameModeReader: eloGameMode()}
+
+		updated, err := uc.RecordMatch(ctx, outcome)
+
+		require.NoError(t, err)
+		require.Len(t, updated, 2)
+		assert.Equal(t, 1516, updated[0].MMR())
+		assert.Equal(t, 1, updated[0].Wins)
+		assert.Equal(t, 1484, updated[1].MMR())
+		assert.Equal(t, 1, updated[1].Losses)
+		assert.Equal(t, ratings_entities.AlgorithmElo, updated[1].Algorithm)
+		assert.Equal(t, ratings_entities.RatingID(outcome.Teams[0].PlayerIDs[0], gameID, &gameModeID), updated[0].ID)
+		ratingWriter.AssertExpectations(t)
+	})
+
+	t.Run("Rates Each Player Once Per Match", func(t *testing.T) {
+		outcome := newOutcome()
+
+		alreadyRated := ratings_entities.NewPlayerRating(outcome.Teams[0].PlayerIDs[0], gameID, &gameModeID, ratings_entities.DefaultElo)
+		alreadyRated.Record(outcome, ratings_entities.Rating{Mu: 1516}, ratings_entities.AlgorithmElo, 1)
+
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindByPlayers", ctx, gameID, &gameModeID, mock.Anything).Return([]*ratings_entities.PlayerRating{alreadyRated}, nil)
+
+		ratingWriter := &mocks.MockPortRatingWriter{}
+		ratingWriter.On("Save", ctx, mock.Anything).Return(&ratings_entities.PlayerRating{}, nil).Once()
+
+		uc := usecases.RatingUseCase{RatingReader: ratingReader, RatingWriter: ratingWriter, GameModeReader: eloGameMode()}
+
+		updated, err := uc.RecordMatch(ctx, outcome)
+
+		require.NoError(t, err)
+		require.Len(t, updated, 1)
+		assert.Equal(t, outcome.Teams[1].PlayerIDs[0], updated[0].PlayerID)
+		assert.Equal(t, 1, alreadyRated.Matches)
+		ratingWriter.AssertExpectations(t)
+	})
+
+	t.Run("Keeps Rating Players After The Game Mode Switches From Elo To Glicko-2", func(t *testing.T) {
+		outcome := newOutcome()
+
+		var found []*ratings_entities.PlayerRating
+		for _, team := range outcome.Teams {
+			rating := ratings_entities.NewPlayerRating(team.PlayerIDs[0], gameID, &gameModeID, ratings_entities.DefaultElo)
+			rating.Mu = 1600
+			found = append(found, rating)
+		}
+
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindByPlayers", ctx, gameID, &gameModeID, mock.Anything).Return(found, nil)
+
+		ratingWriter := &mocks.MockPortRatingWriter{}
+		ratingWriter.On("Save", ctx, mock.Anything).Return(&ratings_entities.PlayerRating{}, nil).Twice()
+
+		uc := usecases.RatingUseCase{RatingReader: ratingReader, RatingWriter: ratingWriter}
+
+		updated, err := uc.RecordMatch(ctx, outcome)
+
+		require.NoError(t, err)
+		require.Len(t, updated, 2)
+
+		initial := ratings_entities.DefaultGlicko2.Initial()
+		for _, rating := range updated {
+			assert.Equal(t, ratings_entities.AlgorithmGlicko2, rating.Algorithm)
+			assert.NotZero(t, rating.Volatility)
+			assert.Less(t, rating.Deviation, initial.Deviation, "the uncertainty shrinks with the match played")
+		}
+
+		assert.Greater(t, updated[0].MMR(), 1600)
+		assert.Less(t, updated[1].MMR(), 1600)
+	})
+
+	t.Run("Fails For Matches That Cannot Be Rated", func(t *testing.T) {
+		outcome := newOutcome()
+		outcome.Teams = outcome.Teams[:1]
+
+		uc := usecases.RatingUseCase{}
+
+		_, err := uc.RecordMatch(ctx, outcome)
+
+		assert.ErrorIs(t, err, ratings_entities.ErrUnratedMatch)
+	})
+
+	t.Run("Fails When The Ratings Cannot Be Read", func(t *testing.T) {
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindByPlayers", ctx, gameID, &gameModeID, mock.Anything).Return(nil, errors.New("db down"))
+
+		uc := usecases.RatingUseCase{RatingReader: ratingReader}
+
+		_, err := uc.RecordMatch(ctx, newOutcome())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRatingUseCase_MMR(t *testing.T) {
+	ctx := context.Background()
+	gameID := uuid.New()
+
+	t.Run("Averages The Ratings, Counting Unrated Players At The Initial Rating", func(t *testing.T) {
+		rated, unrated := uuid.New(), uuid.New()
+
+		rating := ratings_entities.NewPlayerRating(rated, gameID, nil, ratings_entities.DefaultGlicko2)
+		rating.Mu = 1700
+
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindByPlayers", ctx, gameID, (*uuid.UUID)(nil), []uuid.UUID{rated, unrated}).Return([]*ratings_entities.PlayerRating{rating}, nil)
+
+		uc := usecases.RatingUseCase{RatingReader: ratingReader}
+
+		mmr, err := uc.MMR(ctx, gameID, nil, rated, unrated)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1600, mmr)
+	})
+}
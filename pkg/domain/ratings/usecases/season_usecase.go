@@ -0,0 +1,228 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+)
+
+var ErrSeasonForbidden = errors.New("only administrators can manage seasons")
+
+// DefaultSeasonArchivalInterval is how often RunArchival looks for ended seasons when no interval is given
+const DefaultSeasonArchivalInterval = 5 * time.Minute
+
+// SeasonUseCase manages the ranked seasons of games and game modes. Once a season ends, its standings are archived
+// into a LeaderboardSnapshot and the ratings it ranked are soft reset for the next one.
+type SeasonUseCase struct {
+	SeasonReader   ratings_out.SeasonReader
+	SeasonWriter   ratings_out.SeasonWriter
+	RatingReader   ratings_out.RatingReader
+	RatingWriter   ratings_out.RatingWriter
+	SnapshotReader ratings_out.LeaderboardSnapshotReader
+	SnapshotWriter ratings_out.LeaderboardSnapshotWriter
+}
+
+// Create validates and stores a new season
+func (uc *SeasonUseCase) Create(ctx context.Context, season *ratings_entities.Season) (*ratings_entities.Season, error) {
+	if !common.IsAdmin(ctx) {
+		return nil, fmt.Errorf("SeasonUseCase.Create: %w", ErrSeasonForbidden)
+	}
+
+	if err := season.Validate(); err != nil {
+		return nil, fmt.Errorf("SeasonUseCase.Create: %w", err)
+	}
+
+	season.BaseEntity = common.NewEntity(common.GetResourceOwner(ctx))
+	season.ArchivedAt = nil
+
+	created, err := uc.SeasonWriter.Save(ctx, season)
+	if err != nil {
+		return nil, fmt.Errorf("SeasonUseCase.Create: %w", err)
+	}
+
+	slog.InfoContext(ctx, "season created", "season_id", created.ID, "game_id", created.GameID, "game_mode_id", created.GameModeID, "starts_at", created.StartsAt, "ends_at", created.EndsAt)
+
+	return created, nil
+}
+
+// Get returns the season, failing with ErrSeasonNotFound when it does not exist
+func (uc *SeasonUseCase) Get(ctx context.Context, id uuid.UUID) (*ratings_entities.Season, error) {
+	season, err := uc.SeasonReader.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("SeasonUseCase.Get: unable to get season %v: %w", id, err)
+	}
+
+	if season == nil {
+		return nil, fmt.Errorf("SeasonUseCase.Get: season %v: %w", id, ratings_entities.ErrSeasonNotFound)
+	}
+
+	return season, nil
+}
+
+// List returns the seasons of the game and game mode, latest first. Nil IDs list the seasons of every game or game mode.
+func (uc *SeasonUseCase) List(ctx context.Context, gameID *uuid.UUID, gameModeID *uuid.UUID) ([]*ratings_entities.Season, error) {
+	seasons, err := uc.SeasonReader.Search(ctx, gameID, gameModeID)
+	if err != nil {
+		return nil, fmt.Errorf("SeasonUseCase.List: unable to find seasons: %w", err)
+	}
+
+	return seasons, nil
+}
+
+// Snapshot returns the archived standings of the season, failing with ErrNotArchived until it was archived
+func (uc *SeasonUseCase) Snapshot(ctx context.Context, id uuid.UUID) (*ratings_entities.LeaderboardSnapshot, error) {
+	if _, err := uc.Get(ctx, id); err != nil {
+		return nil, fmt.Errorf("SeasonUseCase.Snapshot: %w", err)
+	}
+
+	snapshot, err := uc.SnapshotReader.GetBySeasonID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("SeasonUseCase.Snapshot: unable to get snapshot of season %v: %w", id, err)
+	}
+
+	if snapshot == nil {
+		return nil, fmt.Errorf("SeasonUseCase.Snapshot: season %v: %w", id, ratings_entities.ErrNotArchived)
+	}
+
+	return snapshot, nil
+}
+
+// Archive archives the standings of the ended season and soft resets its ratings, without waiting for RunArchival
+func (uc *SeasonUseCase) Archive(ctx context.Context, id uuid.UUID) (*ratings_entities.LeaderboardSnapshot, error) {
+	if !common.IsAdmin(ctx) {
+		return nil, fmt.Errorf("SeasonUseCase.Archive: %w", ErrSeasonForbidden)
+	}
+
+	season, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("SeasonUseCase.Archive: %w", err)
+	}
+
+	snapshot, err := uc.archive(ctx, season, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("SeasonUseCase.Archive: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// ArchiveEnded archives every season that ended and was not archived yet. Returns the number of seasons archived.
+func (uc *SeasonUseCase) ArchiveEnded(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	seasons, err := uc.SeasonReader.FindEnded(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("SeasonUseCase.ArchiveEnded: unable to find ended seasons: %w", err)
+	}
+
+	archived := 0
+	for _, season := range seasons {
+		if _, err := uc.archive(ctx, season, now); err != nil {
+			return archived, fmt.Errorf("SeasonUseCase.ArchiveEnded: %w", err)
+		}
+
+		archived++
+	}
+
+	return archived, nil
+}
+
+// RunArchival calls ArchiveEnded on every tick of the given interval until the context is done
+func (uc *SeasonUseCase) RunArchival(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSeasonArchivalInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.ArchiveEnded(ctx); err != nil {
+				slog.ErrorContext(ctx, "Failed to archive ended seasons", "error", err)
+			}
+		}
+	}
+}
+
+// archive snapshots the standings of the season in every region, soft resets every rating of its game or game mode
+// and marks the season as archived. The season is marked last, so an interrupted archival can be retried: the
+// snapshot taken first is kept and ratings already reset are left untouched.
+func (uc *SeasonUseCase) archive(ctx context.Context, season *ratings_entities.Season, now time.Time) (*ratings_entities.LeaderboardSnapshot, error) {
+	if season.IsArchived() {
+		return nil, fmt.Errorf("season %v: %w", season.ID, ratings_entities.ErrSeasonArchived)
+	}
+
+	if !season.HasEnded(now) {
+		return nil, fmt.Errorf("season %v ends at %v: %w", season.ID, season.EndsAt, ratings_entities.ErrSeasonNotEnded)
+	}
+
+	snapshot, err := uc.snapshot(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := uc.RatingReader.FindRanked(ctx, ratings_entities.LeaderboardScope{GameID: season.GameID, GameModeID: season.GameModeID}, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find ratings to reset for season %v: %w", season.ID, err)
+	}
+
+	reset := 0
+	for _, rating := range ratings {
+		if !rating.Reset(season) {
+			continue
+		}
+
+		if _, err := uc.RatingWriter.Save(ctx, rating); err != nil {
+			return nil, fmt.Errorf("unable to reset rating of player %v: %w", rating.PlayerID, err)
+		}
+
+		reset++
+	}
+
+	season.ArchivedAt = &now
+	season.UpdatedAt = now
+
+	if _, err := uc.SeasonWriter.Save(ctx, season); err != nil {
+		return nil, fmt.Errorf("unable to mark season %v as archived: %w", season.ID, err)
+	}
+
+	slog.InfoContext(ctx, "season archived", "season_id", season.ID, "ranked", len(snapshot.Entries), "reset", reset)
+
+	return snapshot, nil
+}
+
+// snapshot returns the snapshot taken by an interrupted archival of the season, or takes it from the ratings ranked
+// in every region
+func (uc *SeasonUseCase) snapshot(ctx context.Context, season *ratings_entities.Season) (*ratings_entities.LeaderboardSnapshot, error) {
+	snapshot, err := uc.SnapshotReader.GetBySeasonID(ctx, season.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get snapshot of season %v: %w", season.ID, err)
+	}
+
+	if snapshot != nil {
+		return snapshot, nil
+	}
+
+	ranked, err := uc.RatingReader.FindRanked(ctx, season.Scope(""), 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to rank season %v: %w", season.ID, err)
+	}
+
+	snapshot, err = uc.SnapshotWriter.Save(ctx, ratings_entities.NewLeaderboardSnapshot(season, ranked))
+	if err != nil {
+		return nil, fmt.Errorf("unable to save snapshot of season %v: %w", season.ID, err)
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,188 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leet-gaming/match-making-api/pkg/common"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	"github.com/leet-gaming/match-making-api/pkg/domain/ratings/usecases"
+	"github.com/leet-gaming/match-making-api/test/mocks"
+)
+
+func TestSeasonUseCase_Create(t *testing.T) {
+	admin := context.WithValue(context.Background(), common.AudienceKey, common.TenantAudienceIDKey)
+	admin = context.WithValue(admin, common.TenantIDKey, uuid.New())
+	now := time.Now()
+
+	newSeason := func() *ratings_entities.Season {
+		return &ratings_entities.Season{GameID: uuid.New(), Name: "Season 1", StartsAt: now, EndsAt: now.Add(30 * 24 * time.Hour)}
+	}
+
+	t.Run("Only Admins Create Seasons", func(t *testing.T) {
+		uc := usecases.SeasonUseCase{}
+
+		_, err := uc.Create(context.Background(), newSeason())
+
+		assert.ErrorIs(t, err, usecases.ErrSeasonForbidden)
+	})
+
+	t.Run("Rejects Invalid Seasons", func(t *testing.T) {
+		season := newSeason()
+		season.EndsAt = season.StartsAt.Add(-time.Hour)
+
+		uc := usecases.SeasonUseCase{}
+
+		_, err := uc.Create(admin, season)
+
+		assert.ErrorIs(t, err, ratings_entities.ErrInvalidSeason)
+	})
+
+	t.Run("Stores The Season", func(t *testing.T) {
+		seasonWriter := &mocks.MockPortSeasonWriter{}
+		seasonWriter.On("Save", admin, mock.Anything).Return(newSeason(), nil).Once()
+
+		uc := usecases.SeasonUseCase{SeasonWriter: seasonWriter}
+
+		_, err := uc.Create(admin, newSeason())
+
+		require.NoError(t, err)
+		seasonWriter.AssertExpectations(t)
+	})
+}
+
+func TestSeasonUseCase_Archive(t *testing.T) {
+	admin := context.WithValue(context.Background(), common.AudienceKey, common.TenantAudienceIDKey)
+	gameID := uuid.New()
+
+	endedSeason := func() *ratings_entities.Season {
+		season := &ratings_entities.Season{
+			GameID:    gameID,
+			Name:      "Season 1",
+			StartsAt:  time.Now().Add(-30 * 24 * time.Hour),
+			EndsAt:    time.Now().Add(-time.Minute),
+			SoftReset: ratings_entities.SoftReset{Carry: 0.5},
+		}
+		season.ID = uuid.New()
+
+		return season
+	}
+
+	rating := func(mu float64) *ratings_entities.PlayerRating {
+		rating := ratings_entities.NewPlayerRating(uuid.New(), gameID, nil, ratings_entities.DefaultElo)
+		rating.Mu = mu
+
+		return rating
+	}
+
+	t.Run("Snapshots The Standings, Soft Resets Ratings And Marks The Season", func(t *testing.T) {
+		season := endedSeason()
+		first, second, idle := rating(1900), rating(1700), rating(1300)
+
+		seasonReader := &mocks.MockPortSeasonReader{}
+		seasonReader.On("GetByID", admin, season.ID).Return(season, nil)
+
+		snapshotReader := &mocks.MockPortLeaderboardSnapshotReader{}
+		snapshotReader.On("GetBySeasonID", admin, season.ID).Return(nil, nil)
+
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindRanked", admin, season.Scope(""), 0, 0).Return([]*ratings_entities.PlayerRating{first, second}, nil)
+		ratingReader.On("FindRanked", admin, ratings_entities.LeaderboardScope{GameID: gameID}, 0, 0).Return([]*ratings_entities.PlayerRating{first, second, idle}, nil)
+
+		snapshotWriter := &mocks.MockPortLeaderboardSnapshotWriter{}
+		snapshotWriter.On("Save", admin, mock.Anything).Return(func() *ratings_entities.LeaderboardSnapshot {
+			return ratings_entities.NewLeaderboardSnapshot(season, []*ratings_entities.PlayerRating{rating(1900), rating(1700)})
+		}(), nil).Once()
+
+		ratingWriter := &mocks.MockPortRatingWriter{}
+		ratingWriter.On("Save", admin, mock.Anything).Return(&ratings_entities.PlayerRating{}, nil).Times(3)
+
+		seasonWriter := &mocks.MockPortSeasonWriter{}
+		seasonWriter.On("Save", admin, season).Return(season, nil).Once()
+
+		uc := usecases.SeasonUseCase{
+			SeasonReader:   seasonReader,
+			SeasonWriter:   seasonWriter,
+			RatingReader:   ratingReader,
+			RatingWriter:   ratingWriter,
+			SnapshotReader: snapshotReader,
+			SnapshotWriter: snapshotWriter,
+		}
+
+		snapshot, err := uc.Archive(admin, season.ID)
+
+		require.NoError(t, err)
+		assert.Len(t, snapshot.Entries, 2)
+		assert.Equal(t, 1700.0, first.Mu)
+		assert.Equal(t, 1600.0, second.Mu)
+		assert.Equal(t, 1400.0, idle.Mu)
+		assert.True(t, season.IsArchived())
+		snapshotWriter.AssertExpectations(t)
+		ratingWriter.AssertExpectations(t)
+		seasonWriter.AssertExpectations(t)
+	})
+
+	t.Run("Resumes An Interrupted Archival", func(t *testing.T) {
+		season := endedSeason()
+		alreadyReset, pending := rating(1600), rating(1300)
+		alreadyReset.Reset(season)
+
+		seasonReader := &mocks.MockPortSeasonReader{}
+		seasonReader.On("GetByID", admin, season.ID).Return(season, nil)
+
+		snapshotReader := &mocks.MockPortLeaderboardSnapshotReader{}
+		snapshotReader.On("GetBySeasonID", admin, season.ID).Return(ratings_entities.NewLeaderboardSnapshot(season, nil), nil)
+
+		ratingReader := &mocks.MockPortRatingReader{}
+		ratingReader.On("FindRanked", admin, ratings_entities.LeaderboardScope{GameID: gameID}, 0, 0).Return([]*ratings_entities.PlayerRating{alreadyReset, pending}, nil)
+
+		ratingWriter := &mocks.MockPortRatingWriter{}
+		ratingWriter.On("Save", admin, pending).Return(pending, nil).Once()
+
+		seasonWriter := &mocks.MockPortSeasonWriter{}
+		seasonWriter.On("Save", admin, season).Return(season, nil).Once()
+
+		uc := usecases.SeasonUseCase{
+			SeasonReader:   seasonReader,
+			SeasonWriter:   seasonWriter,
+			RatingReader:   ratingReader,
+			RatingWriter:   ratingWriter,
+			SnapshotReader: snapshotReader,
+		}
+
+		_, err := uc.Archive(admin, season.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1550.0, alreadyReset.Mu)
+		assert.Equal(t, 1400.0, pending.Mu)
+		ratingWriter.AssertExpectations(t)
+	})
+
+	t.Run("Waits For The Season To End", func(t *testing.T) {
+		season := endedSeason()
+		season.EndsAt = time.Now().Add(time.Hour)
+
+		seasonReader := &mocks.MockPortSeasonReader{}
+		seasonReader.On("GetByID", admin, season.ID).Return(season, nil)
+
+		uc := usecases.SeasonUseCase{SeasonReader: seasonReader}
+
+		_, err := uc.Archive(admin, season.ID)
+
+		assert.ErrorIs(t, err, ratings_entities.ErrSeasonNotEnded)
+	})
+
+	t.Run("Only Admins Archive Seasons", func(t *testing.T) {
+		uc := usecases.SeasonUseCase{}
+
+		_, err := uc.Archive(context.Background(), uuid.New())
+
+		assert.ErrorIs(t, err, usecases.ErrSeasonForbidden)
+	})
+}
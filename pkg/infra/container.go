@@ -19,5 +19,30 @@ import (
 // Returns:
 //   - error: An error if the injection process fails, nil otherwise.
 func Inject(c container.Container) error {
-	return common.InjectAll(c, ioc.InjectIoc, mongodb.InjectGameRepository, mongodb.InjectGameModeRepository, mongodb.InjectRegionRepository, squad.Inject, billing.Inject, iam.Inject, InjectKafka)
+	return common.InjectAll(c,
+		ioc.InjectIoc,
+		// game repositories
+		mongodb.InjectGameRepository,
+		mongodb.InjectGameModeRepository,
+		mongodb.InjectRegionRepository,
+		// pairing repositories
+		mongodb.InjectPairRepository,
+		mongodb.InjectPoolRepository,
+		mongodb.InjectPenaltyRepository,
+		mongodb.InjectPlayerBlockRepository,
+		mongodb.InjectInvitationRepository,
+		mongodb.InjectExternalInvitationRepository,
+		mongodb.InjectNotificationRepository,
+		mongodb.InjectNotificationTemplateRepository,
+		mongodb.InjectUserNotificationPreferencesRepository,
+		// ratings repositories
+		mongodb.InjectRatingRepository,
+		mongodb.InjectSeasonRepository,
+		mongodb.InjectLeaderboardSnapshotRepository,
+		// external services
+		squad.Inject,
+		billing.Inject,
+		iam.Inject,
+		InjectKafka,
+	)
 }
@@ -0,0 +1,95 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ExternalInvitationRepository combines all external invitation data operations
+type ExternalInvitationRepository interface {
+	pairing_out.ExternalInvitationWriter
+	pairing_out.ExternalInvitationReader
+}
+
+type externalInvitationRepository struct {
+	MongoDBRepository[pairing_entities.ExternalInvitation]
+}
+
+// NewExternalInvitationRepository creates a new external invitation repository
+func NewExternalInvitationRepository(client *mongo.Client, dbName string, collectionName string) ExternalInvitationRepository {
+	repo := MongoDBRepository[pairing_entities.ExternalInvitation]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.ExternalInvitation{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.ExternalInvitation{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":                {true, "_id"},
+		"Email":             {true, "email"},
+		"RegistrationToken": {true, "registration_token"},
+		"MatchID":           {true, "match_id"},
+		"EventID":           {true, "event_id"},
+		"CreatedBy":         {true, "created_by"},
+		"Status":            {true, "status"},
+	})
+
+	return &externalInvitationRepository{repo}
+}
+
+// Save implements pairing_out.ExternalInvitationWriter. Inserts the invitation or replaces the stored one with the same ID.
+func (r *externalInvitationRepository) Save(ctx context.Context, invitation *pairing_entities.ExternalInvitation) (*pairing_entities.ExternalInvitation, error) {
+	if err := r.upsert(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("externalInvitationRepository.Save: unable to save external invitation %v: %w", invitation.ID, err)
+	}
+
+	return invitation, nil
+}
+
+// GetByID implements pairing_out.ExternalInvitationReader.
+func (r *externalInvitationRepository) GetByID(ctx context.Context, id uuid.UUID) (*pairing_entities.ExternalInvitation, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// GetByRegistrationToken implements pairing_out.ExternalInvitationReader.
+func (r *externalInvitationRepository) GetByRegistrationToken(ctx context.Context, token string) (*pairing_entities.ExternalInvitation, error) {
+	return r.findOne(ctx, bson.M{"registration_token": token})
+}
+
+// FindByEmail implements pairing_out.ExternalInvitationReader. Emails are stored as typed by the
+// administrator, so the comparison is case-insensitive.
+func (r *externalInvitationRepository) FindByEmail(ctx context.Context, email string) ([]*pairing_entities.ExternalInvitation, error) {
+	pattern := "^" + regexp.QuoteMeta(strings.TrimSpace(email)) + "$"
+	filter := bson.M{"email": primitive.Regex{Pattern: pattern, Options: "i"}}
+
+	return r.findMany(ctx, filter, newestFirst())
+}
+
+// FindByMatchID implements pairing_out.ExternalInvitationReader.
+func (r *externalInvitationRepository) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]*pairing_entities.ExternalInvitation, error) {
+	return r.findMany(ctx, bson.M{"match_id": matchID}, newestFirst())
+}
+
+// FindByEventID implements pairing_out.ExternalInvitationReader.
+func (r *externalInvitationRepository) FindByEventID(ctx context.Context, eventID uuid.UUID) ([]*pairing_entities.ExternalInvitation, error) {
+	return r.findMany(ctx, bson.M{"event_id": eventID}, newestFirst())
+}
+
+// FindByCreatedBy implements pairing_out.ExternalInvitationReader.
+func (r *externalInvitationRepository) FindByCreatedBy(ctx context.Context, createdBy uuid.UUID) ([]*pairing_entities.ExternalInvitation, error) {
+	return r.findMany(ctx, bson.M{"created_by": createdBy}, newestFirst())
+}
@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectExternalInvitationRepository registers ExternalInvitationRepository as a singleton in the container
+func InjectExternalInvitationRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (ExternalInvitationRepository, error) {
+		return NewExternalInvitationRepository(client, cfg.MongoDB.DBName, "external_invitations"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register ExternalInvitationRepository")
+		return err
+	}
+
+	// Register ExternalInvitationWriter interface for usecases
+	err = c.Singleton(func(repo ExternalInvitationRepository) (pairing_out.ExternalInvitationWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register ExternalInvitationWriter")
+		return err
+	}
+
+	// Register ExternalInvitationReader interface for usecases
+	err = c.Singleton(func(repo ExternalInvitationRepository) (pairing_out.ExternalInvitationReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register ExternalInvitationReader")
+		return err
+	}
+
+	return nil
+}
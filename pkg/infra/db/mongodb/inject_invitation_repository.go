@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectInvitationRepository registers InvitationRepository as a singleton in the container
+func InjectInvitationRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (InvitationRepository, error) {
+		return NewInvitationRepository(client, cfg.MongoDB.DBName, "invitations"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register InvitationRepository")
+		return err
+	}
+
+	// Register InvitationWriter interface for usecases
+	err = c.Singleton(func(repo InvitationRepository) (pairing_out.InvitationWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register InvitationWriter")
+		return err
+	}
+
+	// Register InvitationReader interface for usecases
+	err = c.Singleton(func(repo InvitationRepository) (pairing_out.InvitationReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register InvitationReader")
+		return err
+	}
+
+	return nil
+}
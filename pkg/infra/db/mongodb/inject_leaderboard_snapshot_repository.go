@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectLeaderboardSnapshotRepository registers LeaderboardSnapshotRepository as a singleton in the container
+func InjectLeaderboardSnapshotRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (LeaderboardSnapshotRepository, error) {
+		return NewLeaderboardSnapshotRepository(client, cfg.MongoDB.DBName, "leaderboard_snapshots"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register LeaderboardSnapshotRepository")
+		return err
+	}
+
+	// Register LeaderboardSnapshotWriter interface for usecases
+	err = c.Singleton(func(repo LeaderboardSnapshotRepository) (ratings_out.LeaderboardSnapshotWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register LeaderboardSnapshotWriter")
+		return err
+	}
+
+	// Register LeaderboardSnapshotReader interface for usecases
+	err = c.Singleton(func(repo LeaderboardSnapshotRepository) (ratings_out.LeaderboardSnapshotReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register LeaderboardSnapshotReader")
+		return err
+	}
+
+	return nil
+}
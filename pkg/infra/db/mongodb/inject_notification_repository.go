@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectNotificationRepository registers NotificationRepository as a singleton in the container
+func InjectNotificationRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (NotificationRepository, error) {
+		return NewNotificationRepository(client, cfg.MongoDB.DBName, "notifications"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register NotificationRepository")
+		return err
+	}
+
+	// Register NotificationWriter interface for usecases
+	err = c.Singleton(func(repo NotificationRepository) (pairing_out.NotificationWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register NotificationWriter")
+		return err
+	}
+
+	// Register NotificationReader interface for usecases
+	err = c.Singleton(func(repo NotificationRepository) (pairing_out.NotificationReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register NotificationReader")
+		return err
+	}
+
+	return nil
+}
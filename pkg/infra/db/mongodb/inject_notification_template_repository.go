@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectNotificationTemplateRepository registers NotificationTemplateRepository as a singleton in the container
+func InjectNotificationTemplateRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (NotificationTemplateRepository, error) {
+		return NewNotificationTemplateRepository(client, cfg.MongoDB.DBName, "notification_templates"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register NotificationTemplateRepository")
+		return err
+	}
+
+	// Register NotificationTemplateWriter interface for usecases
+	err = c.Singleton(func(repo NotificationTemplateRepository) (pairing_out.NotificationTemplateWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register NotificationTemplateWriter")
+		return err
+	}
+
+	// Register NotificationTemplateReader interface for usecases
+	err = c.Singleton(func(repo NotificationTemplateRepository) (pairing_out.NotificationTemplateReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register NotificationTemplateReader")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectPairRepository registers PairRepository as a singleton in the container
+func InjectPairRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (PairRepository, error) {
+		return NewPairRepository(client, cfg.MongoDB.DBName, "pairs"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register PairRepository")
+		return err
+	}
+
+	// Register PairWriter interface for usecases
+	err = c.Singleton(func(repo PairRepository) (pairing_out.PairWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register PairWriter")
+		return err
+	}
+
+	// Register PairReader interface for usecases
+	err = c.Singleton(func(repo PairRepository) (pairing_out.PairReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register PairReader")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectPenaltyRepository registers PenaltyRepository as a singleton in the container
+func InjectPenaltyRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (PenaltyRepository, error) {
+		return NewPenaltyRepository(client, cfg.MongoDB.DBName, "player_penalties"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register PenaltyRepository")
+		return err
+	}
+
+	// Register PenaltyWriter interface for usecases
+	err = c.Singleton(func(repo PenaltyRepository) (pairing_out.PenaltyWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register PenaltyWriter")
+		return err
+	}
+
+	// Register PenaltyReader interface for usecases
+	err = c.Singleton(func(repo PenaltyRepository) (pairing_out.PenaltyReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register PenaltyReader")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectPlayerBlockRepository registers PlayerBlockRepository as a singleton in the container
+func InjectPlayerBlockRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (PlayerBlockRepository, error) {
+		return NewPlayerBlockRepository(client, cfg.MongoDB.DBName, "player_blocks"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register PlayerBlockRepository")
+		return err
+	}
+
+	// Register PlayerBlockWriter interface for usecases
+	err = c.Singleton(func(repo PlayerBlockRepository) (pairing_out.PlayerBlockWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register PlayerBlockWriter")
+		return err
+	}
+
+	// Register PlayerBlockReader interface for usecases
+	err = c.Singleton(func(repo PlayerBlockRepository) (pairing_out.PlayerBlockReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register PlayerBlockReader")
+		return err
+	}
+
+	return nil
+}
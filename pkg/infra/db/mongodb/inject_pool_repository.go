@@ -0,0 +1,54 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectPoolRepository registers PoolRepository as a singleton in the container.
+// Persisted pools are restored when the repository is first resolved, so queues survive restarts.
+func InjectPoolRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (PoolRepository, error) {
+		repo := NewPoolRepository(client, cfg.MongoDB.DBName, "pools")
+
+		restored, err := repo.Restore(context.TODO())
+		if err != nil {
+			slog.Error("Failed to restore matchmaking pools", "err", err)
+			return nil, err
+		}
+
+		slog.Info("Matchmaking pools restored", "count", restored)
+
+		return repo, nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register PoolRepository")
+		return err
+	}
+
+	// Register PoolWriter interface for usecases
+	err = c.Singleton(func(repo PoolRepository) (pairing_out.PoolWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register PoolWriter")
+		return err
+	}
+
+	// Register PoolReader interface for usecases
+	err = c.Singleton(func(repo PoolRepository) (pairing_out.PoolReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register PoolReader")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectRatingRepository registers RatingRepository as a singleton in the container
+func InjectRatingRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (RatingRepository, error) {
+		return NewRatingRepository(client, cfg.MongoDB.DBName, "player_ratings"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register RatingRepository")
+		return err
+	}
+
+	// Register RatingWriter interface for usecases
+	err = c.Singleton(func(repo RatingRepository) (ratings_out.RatingWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register RatingWriter")
+		return err
+	}
+
+	// Register RatingReader interface for usecases
+	err = c.Singleton(func(repo RatingRepository) (ratings_out.RatingReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register RatingReader")
+		return err
+	}
+
+	return nil
+}
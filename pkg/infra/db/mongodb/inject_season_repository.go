@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectSeasonRepository registers SeasonRepository as a singleton in the container
+func InjectSeasonRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (SeasonRepository, error) {
+		return NewSeasonRepository(client, cfg.MongoDB.DBName, "seasons"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register SeasonRepository")
+		return err
+	}
+
+	// Register SeasonWriter interface for usecases
+	err = c.Singleton(func(repo SeasonRepository) (ratings_out.SeasonWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register SeasonWriter")
+		return err
+	}
+
+	// Register SeasonReader interface for usecases
+	err = c.Singleton(func(repo SeasonRepository) (ratings_out.SeasonReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register SeasonReader")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"log/slog"
+
+	"github.com/golobby/container/v3"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"github.com/leet-gaming/match-making-api/pkg/infra/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InjectUserNotificationPreferencesRepository registers UserNotificationPreferencesRepository as a singleton in the container
+func InjectUserNotificationPreferencesRepository(c container.Container) error {
+	err := c.Singleton(func(client *mongo.Client, cfg config.Config) (UserNotificationPreferencesRepository, error) {
+		return NewUserNotificationPreferencesRepository(client, cfg.MongoDB.DBName, "user_notification_preferences"), nil
+	})
+
+	if err != nil {
+		slog.Error("Failed to register UserNotificationPreferencesRepository")
+		return err
+	}
+
+	// Register UserNotificationPreferencesWriter interface for usecases
+	err = c.Singleton(func(repo UserNotificationPreferencesRepository) (pairing_out.UserNotificationPreferencesWriter, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register UserNotificationPreferencesWriter")
+		return err
+	}
+
+	// Register UserNotificationPreferencesReader interface for usecases
+	err = c.Singleton(func(repo UserNotificationPreferencesRepository) (pairing_out.UserNotificationPreferencesReader, error) {
+		return repo, nil
+	})
+	if err != nil {
+		slog.Error("Failed to register UserNotificationPreferencesReader")
+		return err
+	}
+
+	return nil
+}
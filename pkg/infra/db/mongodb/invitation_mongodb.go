@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InvitationRepository combines all invitation data operations
+type InvitationRepository interface {
+	pairing_out.InvitationWriter
+	pairing_out.InvitationReader
+}
+
+type invitationRepository struct {
+	MongoDBRepository[pairing_entities.Invitation]
+}
+
+// NewInvitationRepository creates a new invitation repository
+func NewInvitationRepository(client *mongo.Client, dbName string, collectionName string) InvitationRepository {
+	repo := MongoDBRepository[pairing_entities.Invitation]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.Invitation{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.Invitation{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":      {true, "_id"},
+		"UserID":  {true, "user_id"},
+		"MatchID": {true, "match_id"},
+		"EventID": {true, "event_id"},
+		"Status":  {true, "status"},
+	})
+
+	return &invitationRepository{repo}
+}
+
+// Save implements pairing_out.InvitationWriter. Inserts the invitation or replaces the stored one with the same ID.
+func (r *invitationRepository) Save(ctx context.Context, invitation *pairing_entities.Invitation) (*pairing_entities.Invitation, error) {
+	if err := r.upsert(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("invitationRepository.Save: unable to save invitation %v: %w", invitation.ID, err)
+	}
+
+	return invitation, nil
+}
+
+// GetByID implements pairing_out.InvitationReader.
+func (r *invitationRepository) GetByID(ctx context.Context, id uuid.UUID) (*pairing_entities.Invitation, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByUserID implements pairing_out.InvitationReader. Most recent invitations come first.
+func (r *invitationRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*pairing_entities.Invitation, error) {
+	return r.findMany(ctx, bson.M{"user_id": userID}, newestFirst())
+}
+
+// FindByMatchID implements pairing_out.InvitationReader. Most recent invitations come first.
+func (r *invitationRepository) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]*pairing_entities.Invitation, error) {
+	return r.findMany(ctx, bson.M{"match_id": matchID}, newestFirst())
+}
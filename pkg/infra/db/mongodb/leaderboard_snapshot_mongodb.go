@@ -0,0 +1,67 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// LeaderboardSnapshotRepository combines all leaderboard snapshot data operations
+type LeaderboardSnapshotRepository interface {
+	ratings_out.LeaderboardSnapshotWriter
+	ratings_out.LeaderboardSnapshotReader
+}
+
+type leaderboardSnapshotRepository struct {
+	MongoDBRepository[ratings_entities.LeaderboardSnapshot]
+}
+
+// NewLeaderboardSnapshotRepository creates a new leaderboard snapshot repository. Snapshots are stored under the
+// season ID.
+func NewLeaderboardSnapshotRepository(client *mongo.Client, dbName string, collectionName string) LeaderboardSnapshotRepository {
+	repo := MongoDBRepository[ratings_entities.LeaderboardSnapshot]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(ratings_entities.LeaderboardSnapshot{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(ratings_entities.LeaderboardSnapshot{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":         {true, "_id"},
+		"SeasonID":   {true, "season_id"},
+		"GameID":     {true, "game_id"},
+		"GameModeID": {true, "game_mode_id"},
+	})
+
+	return &leaderboardSnapshotRepository{repo}
+}
+
+// Save implements ratings_out.LeaderboardSnapshotWriter. Inserts the snapshot or replaces the stored one of the same season.
+func (r *leaderboardSnapshotRepository) Save(ctx context.Context, snapshot *ratings_entities.LeaderboardSnapshot) (*ratings_entities.LeaderboardSnapshot, error) {
+	if err := r.upsert(ctx, snapshot); err != nil {
+		return nil, fmt.Errorf("leaderboardSnapshotRepository.Save: unable to save snapshot of season %v: %w", snapshot.SeasonID, err)
+	}
+
+	return snapshot, nil
+}
+
+// GetBySeasonID implements ratings_out.LeaderboardSnapshotReader. Returns nil when the season was not archived.
+func (r *leaderboardSnapshotRepository) GetBySeasonID(ctx context.Context, seasonID uuid.UUID) (*ratings_entities.LeaderboardSnapshot, error) {
+	snapshot, err := r.findOne(ctx, bson.M{"_id": seasonID})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	return snapshot, err
+}
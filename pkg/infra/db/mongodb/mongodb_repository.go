@@ -203,3 +203,43 @@ func (r *MongoDBRepository[T]) InitQueryableFields(fieldInfos map[string]FieldIn
 
 	Repositories[common.ResourceType(r.entityName)] = r
 }
+
+// upsert replaces the stored document matching the entity ID, inserting it when it does not exist yet.
+func (r *MongoDBRepository[T]) upsert(ctx context.Context, entity *T) error {
+	filter := bson.M{"_id": (*entity).GetID()}
+
+	_, err := r.collection.ReplaceOne(ctx, filter, entity, options.Replace().SetUpsert(true))
+	return err
+}
+
+// findOne returns the first document matching the filter.
+func (r *MongoDBRepository[T]) findOne(ctx context.Context, filter interface{}) (*T, error) {
+	var entity T
+	err := r.collection.FindOne(ctx, filter).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+// findMany returns every document matching the filter.
+func (r *MongoDBRepository[T]) findMany(ctx context.Context, filter interface{}, opts ...*options.FindOptions) ([]*T, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	entities := make([]*T, 0)
+	if err = cursor.All(ctx, &entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// newestFirst sorts documents by creation date, most recent first.
+func newestFirst() *options.FindOptions {
+	return options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+}
@@ -0,0 +1,124 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NotificationRepository combines all notification data operations
+type NotificationRepository interface {
+	pairing_out.NotificationWriter
+	pairing_out.NotificationReader
+}
+
+type notificationRepository struct {
+	MongoDBRepository[pairing_entities.Notification]
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(client *mongo.Client, dbName string, collectionName string) NotificationRepository {
+	repo := MongoDBRepository[pairing_entities.Notification]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.Notification{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.Notification{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":      {true, "_id"},
+		"UserID":  {true, "user_id"},
+		"Channel": {true, "channel"},
+		"Type":    {true, "type"},
+		"Status":  {true, "status"},
+	})
+
+	return &notificationRepository{repo}
+}
+
+// Save implements pairing_out.NotificationWriter. Inserts the notification or replaces the stored one with the same ID.
+func (r *notificationRepository) Save(ctx context.Context, notification *pairing_entities.Notification) (*pairing_entities.Notification, error) {
+	if err := r.upsert(ctx, notification); err != nil {
+		return nil, fmt.Errorf("notificationRepository.Save: unable to save notification %v: %w", notification.ID, err)
+	}
+
+	return notification, nil
+}
+
+// SaveBatch implements pairing_out.NotificationWriter. All notifications are upserted in a single bulk write.
+func (r *notificationRepository) SaveBatch(ctx context.Context, notifications []*pairing_entities.Notification) ([]*pairing_entities.Notification, error) {
+	if len(notifications) == 0 {
+		return notifications, nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(notifications))
+	for _, notification := range notifications {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"_id": notification.ID}).
+			SetReplacement(notification).
+			SetUpsert(true))
+	}
+
+	if _, err := r.collection.BulkWrite(ctx, models); err != nil {
+		return nil, fmt.Errorf("notificationRepository.SaveBatch: unable to save %d notifications: %w", len(notifications), err)
+	}
+
+	return notifications, nil
+}
+
+// GetByID implements pairing_out.NotificationReader.
+func (r *notificationRepository) GetByID(ctx context.Context, id uuid.UUID) (*pairing_entities.Notification, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByUserID implements pairing_out.NotificationReader. Results are paginated, most recent first.
+// A limit of zero or less returns every remaining notification.
+func (r *notificationRepository) FindByUserID(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]*pairing_entities.Notification, error) {
+	opts := newestFirst()
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	if offset > 0 {
+		opts.SetSkip(int64(offset))
+	}
+
+	return r.findMany(ctx, bson.M{"user_id": userID}, opts)
+}
+
+// FindByStatus implements pairing_out.NotificationReader.
+func (r *notificationRepository) FindByStatus(ctx context.Context, status pairing_entities.NotificationStatus) ([]*pairing_entities.Notification, error) {
+	return r.findMany(ctx, bson.M{"status": status}, newestFirst())
+}
+
+// FindFailedNotifications implements pairing_out.NotificationReader. Only notifications that still have
+// retry attempts left are returned, oldest failures first so they are retried in order.
+func (r *notificationRepository) FindFailedNotifications(ctx context.Context) ([]*pairing_entities.Notification, error) {
+	filter := bson.M{
+		"status": pairing_entities.NotificationStatusFailed,
+		"$expr":  bson.M{"$lt": bson.A{"$retry_count", "$max_retries"}},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "failed_at", Value: 1}})
+
+	return r.findMany(ctx, filter, opts)
+}
+
+// CountByUserID implements pairing_out.NotificationReader.
+func (r *notificationRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
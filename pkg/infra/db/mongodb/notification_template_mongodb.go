@@ -0,0 +1,70 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// NotificationTemplateRepository combines all notification template data operations
+type NotificationTemplateRepository interface {
+	pairing_out.NotificationTemplateWriter
+	pairing_out.NotificationTemplateReader
+}
+
+type notificationTemplateRepository struct {
+	MongoDBRepository[pairing_entities.NotificationTemplate]
+}
+
+// NewNotificationTemplateRepository creates a new notification template repository
+func NewNotificationTemplateRepository(client *mongo.Client, dbName string, collectionName string) NotificationTemplateRepository {
+	repo := MongoDBRepository[pairing_entities.NotificationTemplate]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.NotificationTemplate{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.NotificationTemplate{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":       {true, "_id"},
+		"Name":     {true, "name"},
+		"Type":     {true, "type"},
+		"IsActive": {true, "is_active"},
+	})
+
+	return &notificationTemplateRepository{repo}
+}
+
+// Save implements pairing_out.NotificationTemplateWriter. Inserts the template or replaces the stored one with the same ID.
+func (r *notificationTemplateRepository) Save(ctx context.Context, template *pairing_entities.NotificationTemplate) (*pairing_entities.NotificationTemplate, error) {
+	if err := r.upsert(ctx, template); err != nil {
+		return nil, fmt.Errorf("notificationTemplateRepository.Save: unable to save notification template %v: %w", template.ID, err)
+	}
+
+	return template, nil
+}
+
+// GetByID implements pairing_out.NotificationTemplateReader.
+func (r *notificationTemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*pairing_entities.NotificationTemplate, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByType implements pairing_out.NotificationTemplateReader.
+func (r *notificationTemplateRepository) FindByType(ctx context.Context, notificationType pairing_entities.NotificationType) ([]*pairing_entities.NotificationTemplate, error) {
+	return r.findMany(ctx, bson.M{"type": notificationType}, newestFirst())
+}
+
+// FindActiveTemplates implements pairing_out.NotificationTemplateReader.
+func (r *notificationTemplateRepository) FindActiveTemplates(ctx context.Context) ([]*pairing_entities.NotificationTemplate, error) {
+	return r.findMany(ctx, bson.M{"is_active": true}, newestFirst())
+}
@@ -0,0 +1,148 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PairRepository combines all pair data operations
+type PairRepository interface {
+	pairing_out.PairWriter
+	pairing_out.PairReader
+}
+
+type pairRepository struct {
+	MongoDBRepository[pairing_entities.Pair]
+}
+
+// NewPairRepository creates a new pair repository
+func NewPairRepository(client *mongo.Client, dbName string, collectionName string) PairRepository {
+	repo := MongoDBRepository[pairing_entities.Pair]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.Pair{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.Pair{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":             {true, "_id"},
+		"ConflictStatus": {true, "conflict_status"},
+	})
+
+	return &pairRepository{repo}
+}
+
+// Save implements pairing_out.PairWriter. Inserts the pair or replaces the stored one with the same ID.
+func (r *pairRepository) Save(pair *pairing_entities.Pair) (*pairing_entities.Pair, error) {
+	pair.Version++
+
+	if err := r.upsert(context.TODO(), pair); err != nil {
+		pair.Version--
+		return nil, fmt.Errorf("pairRepository.Save: unable to save pair %v: %w", pair.ID, err)
+	}
+
+	return pair, nil
+}
+
+// Update implements pairing_out.PairWriter. Replaces the stored pair only when it still has the version the pair was
+// read with; pairs stored before they were versioned have none.
+func (r *pairRepository) Update(pair *pairing_entities.Pair) (*pairing_entities.Pair, error) {
+	filter := bson.M{"_id": pair.ID, "version": pair.Version}
+	if pair.Version == 0 {
+		filter["version"] = bson.M{"$in": bson.A{0, nil}}
+	}
+
+	pair.Version++
+
+	result, err := r.collection.ReplaceOne(context.TODO(), filter, pair)
+	if err != nil {
+		pair.Version--
+		return nil, fmt.Errorf("pairRepository.Update: unable to update pair %v: %w", pair.ID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		pair.Version--
+		return nil, fmt.Errorf("pairRepository.Update: pair %v: %w", pair.ID, pairing_entities.ErrPairChanged)
+	}
+
+	return pair, nil
+}
+
+// GetByID implements pairing_out.PairReader. Fails with ErrPairNotFound when the pair does not exist.
+func (r *pairRepository) GetByID(ctx context.Context, id uuid.UUID) (*pairing_entities.Pair, error) {
+	pair, err := r.findOne(ctx, bson.M{"_id": id})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("pairRepository.GetByID: pair %v: %w", id, pairing_entities.ErrPairNotFound)
+	}
+
+	return pair, err
+}
+
+// FindPairsByPartyID implements pairing_out.PairReader. Parties are stored as keys of the match map,
+// so a pair belongs to the party when the corresponding key exists.
+func (r *pairRepository) FindPairsByPartyID(ctx context.Context, partyID uuid.UUID) ([]*pairing_entities.Pair, error) {
+	filter := bson.M{"match." + partyID.String(): bson.M{"$exists": true}}
+
+	return r.findMany(ctx, filter, newestFirst())
+}
+
+// FindExpiredReadyChecks implements pairing_out.PairReader. Returns the pairs whose ready check is still pending
+// past its deadline, oldest deadline first.
+func (r *pairRepository) FindExpiredReadyChecks(ctx context.Context, now time.Time) ([]*pairing_entities.Pair, error) {
+	filter := bson.M{
+		"ready_check.status":     pairing_entities.ReadyCheckPending,
+		"ready_check.expires_at": bson.M{"$lte": now},
+	}
+
+	return r.findMany(ctx, filter, options.Find().SetSort(bson.D{{Key: "ready_check.expires_at", Value: 1}}))
+}
+
+// FindStartedBefore implements pairing_out.PairReader. Returns the matches of the game still in progress that
+// started before the given time, longest running first.
+func (r *pairRepository) FindStartedBefore(ctx context.Context, gameID uuid.UUID, before time.Time) ([]*pairing_entities.Pair, error) {
+	filter := bson.M{
+		"status":           pairing_entities.MatchStarted,
+		"criteria.game_id": gameID,
+		"started_at":       bson.M{"$lte": before},
+	}
+
+	return r.findMany(ctx, filter, options.Find().SetSort(bson.D{{Key: "started_at", Value: 1}}))
+}
+
+// FindCreatedBetween implements pairing_out.PairReader. Returns the matches created since the first time and before the
+// second one, of the game mode when given, oldest first. Either bound is ignored when zero.
+func (r *pairRepository) FindCreatedBetween(ctx context.Context, gameModeID *uuid.UUID, since, until time.Time) ([]*pairing_entities.Pair, error) {
+	filter := bson.M{}
+	if gameModeID != nil {
+		filter["criteria.game_mode_id"] = *gameModeID
+	}
+
+	createdAt := bson.M{}
+	if !since.IsZero() {
+		createdAt["$gte"] = since
+	}
+
+	if !until.IsZero() {
+		createdAt["$lt"] = until
+	}
+
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	return r.findMany(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+}
@@ -0,0 +1,91 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PenaltyRepository combines all player penalty data operations
+type PenaltyRepository interface {
+	pairing_out.PenaltyWriter
+	pairing_out.PenaltyReader
+}
+
+type penaltyRepository struct {
+	MongoDBRepository[pairing_entities.PlayerPenalty]
+}
+
+// NewPenaltyRepository creates a new player penalty repository. Penalties are stored under the player ID.
+func NewPenaltyRepository(client *mongo.Client, dbName string, collectionName string) PenaltyRepository {
+	repo := MongoDBRepository[pairing_entities.PlayerPenalty]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.PlayerPenalty{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.PlayerPenalty{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":            {true, "_id"},
+		"PlayerID":      {true, "player_id"},
+		"CooldownUntil": {true, "cooldown_until"},
+	})
+
+	return &penaltyRepository{repo}
+}
+
+// Save implements pairing_out.PenaltyWriter. Inserts the penalty or replaces the stored one of the same player.
+func (r *penaltyRepository) Save(ctx context.Context, penalty *pairing_entities.PlayerPenalty) (*pairing_entities.PlayerPenalty, error) {
+	if err := r.upsert(ctx, penalty); err != nil {
+		return nil, fmt.Errorf("penaltyRepository.Save: unable to save penalty of player %v: %w", penalty.PlayerID, err)
+	}
+
+	return penalty, nil
+}
+
+// Delete implements pairing_out.PenaltyWriter.
+func (r *penaltyRepository) Delete(ctx context.Context, playerID uuid.UUID) error {
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": playerID}); err != nil {
+		return fmt.Errorf("penaltyRepository.Delete: unable to delete penalty of player %v: %w", playerID, err)
+	}
+
+	return nil
+}
+
+// GetByPlayerID implements pairing_out.PenaltyReader. Returns nil when the player has no penalty.
+func (r *penaltyRepository) GetByPlayerID(ctx context.Context, playerID uuid.UUID) (*pairing_entities.PlayerPenalty, error) {
+	penalty, err := r.findOne(ctx, bson.M{"_id": playerID})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	return penalty, err
+}
+
+// FindCoolingDown implements pairing_out.PenaltyReader. Returns the penalties whose cooldown ends the latest first.
+func (r *penaltyRepository) FindCoolingDown(ctx context.Context, now time.Time, playerIDs ...uuid.UUID) ([]*pairing_entities.PlayerPenalty, error) {
+	filter := bson.M{"cooldown_until": bson.M{"$gt": now}}
+	if len(playerIDs) > 0 {
+		filter["_id"] = bson.M{"$in": playerIDs}
+	}
+
+	return r.findMany(ctx, filter, options.Find().SetSort(bson.D{{Key: "cooldown_until", Value: -1}}))
+}
+
+// FindAll implements pairing_out.PenaltyReader. Returns the most recently updated penalties first.
+func (r *penaltyRepository) FindAll(ctx context.Context) ([]*pairing_entities.PlayerPenalty, error) {
+	return r.findMany(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}}))
+}
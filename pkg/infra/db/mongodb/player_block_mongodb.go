@@ -0,0 +1,79 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// PlayerBlockRepository combines all player block data operations
+type PlayerBlockRepository interface {
+	pairing_out.PlayerBlockWriter
+	pairing_out.PlayerBlockReader
+}
+
+type playerBlockRepository struct {
+	MongoDBRepository[pairing_entities.PlayerBlock]
+}
+
+// NewPlayerBlockRepository creates a new player block repository. Blocks are stored under the ID derived from the
+// blocking and blocked players (see pairing_entities.PlayerBlockID).
+func NewPlayerBlockRepository(client *mongo.Client, dbName string, collectionName string) PlayerBlockRepository {
+	repo := MongoDBRepository[pairing_entities.PlayerBlock]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.PlayerBlock{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.PlayerBlock{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":        {true, "_id"},
+		"PlayerID":  {true, "player_id"},
+		"BlockedID": {true, "blocked_id"},
+		"Scope":     {true, "scope"},
+	})
+
+	return &playerBlockRepository{repo}
+}
+
+// Save implements pairing_out.PlayerBlockWriter. Inserts the block or replaces the stored one of the same players.
+func (r *playerBlockRepository) Save(ctx context.Context, block *pairing_entities.PlayerBlock) (*pairing_entities.PlayerBlock, error) {
+	if err := r.upsert(ctx, block); err != nil {
+		return nil, fmt.Errorf("playerBlockRepository.Save: unable to save block of player %v by player %v: %w", block.BlockedID, block.PlayerID, err)
+	}
+
+	return block, nil
+}
+
+// Delete implements pairing_out.PlayerBlockWriter.
+func (r *playerBlockRepository) Delete(ctx context.Context, playerID, blockedID uuid.UUID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": pairing_entities.PlayerBlockID(playerID, blockedID)})
+	if err != nil {
+		return fmt.Errorf("playerBlockRepository.Delete: unable to delete block of player %v by player %v: %w", blockedID, playerID, err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("playerBlockRepository.Delete: player %v did not block player %v: %w", playerID, blockedID, pairing_entities.ErrPlayerBlockNotFound)
+	}
+
+	return nil
+}
+
+// FindByPlayerIDs implements pairing_out.PlayerBlockReader. Returns the most recent blocks first.
+func (r *playerBlockRepository) FindByPlayerIDs(ctx context.Context, playerIDs ...uuid.UUID) ([]*pairing_entities.PlayerBlock, error) {
+	if len(playerIDs) == 0 {
+		return []*pairing_entities.PlayerBlock{}, nil
+	}
+
+	return r.findMany(ctx, bson.M{"player_id": bson.M{"$in": playerIDs}}, newestFirst())
+}
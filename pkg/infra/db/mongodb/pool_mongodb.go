@@ -0,0 +1,149 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+	"time"
+
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// PoolRepository combines all pool data operations
+type PoolRepository interface {
+	pairing_out.PoolReader
+	pairing_out.PoolWriter
+
+	// Restore loads every persisted pool into memory, returning how many were restored
+	Restore(ctx context.Context) (int, error)
+}
+
+// poolRepository keeps live pools in memory, keyed by Criteria.PoolKey, and persists a snapshot on every Save.
+// Pools hold synchronization primitives, so callers must always get the same instance for the same key;
+// MongoDB is only read when a pool is not cached yet (ie, after a restart).
+type poolRepository struct {
+	MongoDBRepository[pairing_entities.Pool]
+
+	mu    sync.RWMutex
+	pools map[string]*pairing_entities.Pool
+}
+
+// NewPoolRepository creates a new pool repository
+func NewPoolRepository(client *mongo.Client, dbName string, collectionName string) PoolRepository {
+	repo := MongoDBRepository[pairing_entities.Pool]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.Pool{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.Pool{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":  {true, "_id"},
+		"Key": {true, "key"},
+	})
+
+	return &poolRepository{
+		MongoDBRepository: repo,
+		pools:             make(map[string]*pairing_entities.Pool),
+	}
+}
+
+// FindPool implements pairing_out.PoolReader. Returns nil when no pool exists for the criteria.
+func (r *poolRepository) FindPool(criteria *pairing_value_objects.Criteria) (*pairing_entities.Pool, error) {
+	key := criteria.PoolKey()
+
+	r.mu.RLock()
+	pool, cached := r.pools[key]
+	r.mu.RUnlock()
+
+	if cached {
+		return pool, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// another goroutine may have loaded it while we were waiting for the lock
+	if pool, cached = r.pools[key]; cached {
+		return pool, nil
+	}
+
+	pool, err := r.findOne(context.TODO(), bson.M{"_id": pairing_entities.PoolID(key)})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("poolRepository.FindPool: unable to load pool %s: %w", key, err)
+	}
+
+	r.pools[key] = pool.Restore()
+
+	return pool, nil
+}
+
+// ListPools implements pairing_out.PoolReader. Returns the live pools; persisted ones are loaded by Restore.
+func (r *poolRepository) ListPools() ([]*pairing_entities.Pool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pools := make([]*pairing_entities.Pool, 0, len(r.pools))
+	for _, pool := range r.pools {
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
+// Save implements pairing_out.PoolWriter. The pool becomes the live instance for its key and its
+// membership, including join timestamps, is persisted.
+func (r *poolRepository) Save(pool *pairing_entities.Pool) (*pairing_entities.Pool, error) {
+	r.mu.Lock()
+	r.pools[pool.Key] = pool
+	r.mu.Unlock()
+
+	snapshot := pool.Snapshot()
+	snapshot.UpdatedAt = time.Now()
+
+	if err := r.upsert(context.TODO(), snapshot); err != nil {
+		return nil, fmt.Errorf("poolRepository.Save: unable to save pool %s: %w", pool.Key, err)
+	}
+
+	return pool, nil
+}
+
+// Restore implements PoolRepository. Pools already in memory are kept as they are.
+func (r *poolRepository) Restore(ctx context.Context) (int, error) {
+	pools, err := r.findMany(ctx, bson.M{})
+	if err != nil {
+		return 0, fmt.Errorf("poolRepository.Restore: unable to load pools: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	restored := 0
+	for _, pool := range pools {
+		if _, cached := r.pools[pool.Key]; cached {
+			continue
+		}
+
+		r.pools[pool.Key] = pool.Restore()
+		restored++
+
+		slog.InfoContext(ctx, "pool restored", "pool_key", pool.Key, "queued_parties", len(pool.Entries))
+	}
+
+	return restored, nil
+}
@@ -0,0 +1,136 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RatingRepository combines all player rating data operations
+type RatingRepository interface {
+	ratings_out.RatingWriter
+	ratings_out.RatingReader
+}
+
+type ratingRepository struct {
+	MongoDBRepository[ratings_entities.PlayerRating]
+}
+
+// NewRatingRepository creates a new player rating repository. Ratings are stored under their RatingID.
+func NewRatingRepository(client *mongo.Client, dbName string, collectionName string) RatingRepository {
+	repo := MongoDBRepository[ratings_entities.PlayerRating]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(ratings_entities.PlayerRating{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(ratings_entities.PlayerRating{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":         {true, "_id"},
+		"PlayerID":   {true, "player_id"},
+		"GameID":     {true, "game_id"},
+		"GameModeID": {true, "game_mode_id"},
+		"Mu":         {true, "mu"},
+		"Region":     {true, "region"},
+	})
+
+	return &ratingRepository{repo}
+}
+
+// Save implements ratings_out.RatingWriter. Inserts the rating or replaces the stored one of the same player and scope.
+func (r *ratingRepository) Save(ctx context.Context, rating *ratings_entities.PlayerRating) (*ratings_entities.PlayerRating, error) {
+	if err := r.upsert(ctx, rating); err != nil {
+		return nil, fmt.Errorf("ratingRepository.Save: unable to save rating of player %v: %w", rating.PlayerID, err)
+	}
+
+	return rating, nil
+}
+
+// FindByPlayers implements ratings_out.RatingReader. Ratings are looked up by their RatingID.
+func (r *ratingRepository) FindByPlayers(ctx context.Context, gameID uuid.UUID, gameModeID *uuid.UUID, playerIDs ...uuid.UUID) ([]*ratings_entities.PlayerRating, error) {
+	if len(playerIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(playerIDs))
+	for i, playerID := range playerIDs {
+		ids[i] = ratings_entities.RatingID(playerID, gameID, gameModeID)
+	}
+
+	return r.findMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+}
+
+// FindRanked implements ratings_out.RatingReader. Ratings are ranked by mu, highest first, ties broken by _id.
+func (r *ratingRepository) FindRanked(ctx context.Context, scope ratings_entities.LeaderboardScope, offset, limit int) ([]*ratings_entities.PlayerRating, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "mu", Value: -1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(offset))
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	ratings, err := r.findMany(ctx, leaderboardFilter(scope), opts)
+	if err != nil {
+		return nil, fmt.Errorf("ratingRepository.FindRanked: %w", err)
+	}
+
+	return ratings, nil
+}
+
+// Count implements ratings_out.RatingReader.
+func (r *ratingRepository) Count(ctx context.Context, scope ratings_entities.LeaderboardScope) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, leaderboardFilter(scope))
+	if err != nil {
+		return 0, fmt.Errorf("ratingRepository.Count: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// CountAhead implements ratings_out.RatingReader. Counts the ratings with a higher mu, or the same mu and a lower _id.
+func (r *ratingRepository) CountAhead(ctx context.Context, scope ratings_entities.LeaderboardScope, rating *ratings_entities.PlayerRating) (int, error) {
+	filter := leaderboardFilter(scope)
+	filter["$or"] = bson.A{
+		bson.M{"mu": bson.M{"$gt": rating.Mu}},
+		bson.M{"mu": rating.Mu, "_id": bson.M{"$lt": rating.ID}},
+	}
+
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("ratingRepository.CountAhead: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// leaderboardFilter matches the ratings ranked in the leaderboard scope
+func leaderboardFilter(scope ratings_entities.LeaderboardScope) bson.M {
+	filter := bson.M{"game_id": scope.GameID}
+
+	if scope.GameModeID != nil {
+		filter["game_mode_id"] = *scope.GameModeID
+	} else {
+		filter["game_mode_id"] = bson.M{"$exists": false}
+	}
+
+	if scope.Region != "" {
+		filter["region"] = scope.Region
+	}
+
+	if !scope.PlayedSince.IsZero() {
+		filter["last_played_at"] = bson.M{"$gte": scope.PlayedSince}
+	}
+
+	return filter
+}
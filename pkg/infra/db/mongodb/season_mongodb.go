@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SeasonRepository combines all season data operations
+type SeasonRepository interface {
+	ratings_out.SeasonWriter
+	ratings_out.SeasonReader
+}
+
+type seasonRepository struct {
+	MongoDBRepository[ratings_entities.Season]
+}
+
+// NewSeasonRepository creates a new season repository
+func NewSeasonRepository(client *mongo.Client, dbName string, collectionName string) SeasonRepository {
+	repo := MongoDBRepository[ratings_entities.Season]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(ratings_entities.Season{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(ratings_entities.Season{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":         {true, "_id"},
+		"GameID":     {true, "game_id"},
+		"GameModeID": {true, "game_mode_id"},
+		"Name":       {true, "name"},
+		"StartsAt":   {true, "starts_at"},
+		"EndsAt":     {true, "ends_at"},
+	})
+
+	return &seasonRepository{repo}
+}
+
+// Save implements ratings_out.SeasonWriter. Inserts the season or replaces the stored one with the same ID.
+func (r *seasonRepository) Save(ctx context.Context, season *ratings_entities.Season) (*ratings_entities.Season, error) {
+	if err := r.upsert(ctx, season); err != nil {
+		return nil, fmt.Errorf("seasonRepository.Save: unable to save season %v: %w", season.ID, err)
+	}
+
+	return season, nil
+}
+
+// GetByID implements ratings_out.SeasonReader. Returns nil when the season does not exist.
+func (r *seasonRepository) GetByID(ctx context.Context, id uuid.UUID) (*ratings_entities.Season, error) {
+	season, err := r.findOne(ctx, bson.M{"_id": id})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	return season, err
+}
+
+// Search implements ratings_out.SeasonReader. Returns the seasons that start the latest first.
+func (r *seasonRepository) Search(ctx context.Context, gameID *uuid.UUID, gameModeID *uuid.UUID) ([]*ratings_entities.Season, error) {
+	filter := bson.M{}
+	if gameID != nil {
+		filter["game_id"] = *gameID
+	}
+
+	if gameModeID != nil {
+		filter["game_mode_id"] = *gameModeID
+	}
+
+	return r.findMany(ctx, filter, options.Find().SetSort(bson.D{{Key: "starts_at", Value: -1}}))
+}
+
+// FindEnded implements ratings_out.SeasonReader. Returns the seasons that ended the earliest first.
+func (r *seasonRepository) FindEnded(ctx context.Context, now time.Time) ([]*ratings_entities.Season, error) {
+	filter := bson.M{
+		"ends_at":     bson.M{"$lte": now},
+		"archived_at": bson.M{"$exists": false},
+	}
+
+	return r.findMany(ctx, filter, options.Find().SetSort(bson.D{{Key: "ends_at", Value: 1}}))
+}
@@ -0,0 +1,58 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
+	pairing_out "github.com/leet-gaming/match-making-api/pkg/domain/pairing/ports/out"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// UserNotificationPreferencesRepository combines all user notification preferences data operations
+type UserNotificationPreferencesRepository interface {
+	pairing_out.UserNotificationPreferencesWriter
+	pairing_out.UserNotificationPreferencesReader
+}
+
+type userNotificationPreferencesRepository struct {
+	MongoDBRepository[pairing_entities.UserNotificationPreferences]
+}
+
+// NewUserNotificationPreferencesRepository creates a new user notification preferences repository
+func NewUserNotificationPreferencesRepository(client *mongo.Client, dbName string, collectionName string) UserNotificationPreferencesRepository {
+	repo := MongoDBRepository[pairing_entities.UserNotificationPreferences]{
+		mongoClient:       client,
+		dbName:            dbName,
+		mappingCache:      make(map[string]CacheItem),
+		entityModel:       reflect.TypeOf(pairing_entities.UserNotificationPreferences{}),
+		BsonFieldMappings: make(map[string]string),
+		collectionName:    collectionName,
+		entityName:        reflect.TypeOf(pairing_entities.UserNotificationPreferences{}).Name(),
+		QueryableFields:   make(map[string]bool),
+	}
+
+	repo.InitQueryableFields(map[string]FieldInfo{
+		"ID":     {true, "_id"},
+		"UserID": {true, "user_id"},
+	})
+
+	return &userNotificationPreferencesRepository{repo}
+}
+
+// Save implements pairing_out.UserNotificationPreferencesWriter. Inserts the preferences or replaces the stored ones with the same ID.
+func (r *userNotificationPreferencesRepository) Save(ctx context.Context, preferences *pairing_entities.UserNotificationPreferences) (*pairing_entities.UserNotificationPreferences, error) {
+	if err := r.upsert(ctx, preferences); err != nil {
+		return nil, fmt.Errorf("userNotificationPreferencesRepository.Save: unable to save preferences for user %v: %w", preferences.UserID, err)
+	}
+
+	return preferences, nil
+}
+
+// GetByUserID implements pairing_out.UserNotificationPreferencesReader.
+func (r *userNotificationPreferencesRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*pairing_entities.UserNotificationPreferences, error) {
+	return r.findOne(ctx, bson.M{"user_id": userID})
+}
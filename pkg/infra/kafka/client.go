@@ -22,6 +22,7 @@ type Config struct {
 	SASLUsername     string
 	SASLPassword     string
 	Region           string
+	ConsumerGroupID  string
 }
 
 // Client provides Kafka producer and consumer capabilities
@@ -40,6 +41,7 @@ func NewConfigFromEnv() *Config {
 		SASLUsername:     getEnv("KAFKA_SASL_USERNAME", ""),
 		SASLPassword:     getEnv("KAFKA_SASL_PASSWORD", ""),
 		Region:           getEnv("REGION", "local"),
+		ConsumerGroupID:  getEnv("KAFKA_CONSUMER_GROUP_ID", "match-making-api"),
 	}
 }
 
@@ -117,6 +119,11 @@ func (c *Client) Brokers() []string {
 	return strings.Split(c.config.BootstrapServers, ",")
 }
 
+// ConsumerGroupID returns the prefix of the consumer groups this service reads with
+func (c *Client) ConsumerGroupID() string {
+	return c.config.ConsumerGroupID
+}
+
 // Dialer returns the configured dialer for creating readers
 func (c *Client) Dialer() *kafka.Dialer {
 	return c.dialer
@@ -222,6 +222,11 @@ func (mrc *MatchResultConsumer) handleMatchResult(ctx context.Context, msg *kafk
 	return mrc.processFunc(ctx, &event)
 }
 
+// Handle processes a single message of the match results topic as if it had just been fetched
+func (mrc *MatchResultConsumer) Handle(ctx context.Context, msg *kafka.Message) error {
+	return mrc.consumer.processMessage(ctx, msg)
+}
+
 // Start begins consuming match results
 func (mrc *MatchResultConsumer) Start(ctx context.Context) error {
 	return mrc.consumer.Start(ctx)
@@ -232,6 +237,86 @@ func (mrc *MatchResultConsumer) Close() error {
 	return mrc.consumer.Close()
 }
 
+// QueueEventConsumer processes queue events
+type QueueEventConsumer struct {
+	consumer    *Consumer
+	processFunc func(ctx context.Context, event *QueueEvent) error
+}
+
+// NewQueueEventConsumer creates a consumer for queue events
+func NewQueueEventConsumer(client *Client, groupID string, processFunc func(ctx context.Context, event *QueueEvent) error) *QueueEventConsumer {
+	config := DefaultConsumerConfig(groupID, []string{TopicQueueEvents})
+	consumer := NewConsumer(client, config)
+
+	qec := &QueueEventConsumer{
+		consumer:    consumer,
+		processFunc: processFunc,
+	}
+
+	consumer.RegisterHandler(TopicQueueEvents, qec.handleQueueEvent)
+
+	return qec
+}
+
+func (qec *QueueEventConsumer) handleQueueEvent(ctx context.Context, msg *kafka.Message) error {
+	var event QueueEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal queue event: %w", err)
+	}
+
+	return qec.processFunc(ctx, &event)
+}
+
+// Start begins consuming queue events
+func (qec *QueueEventConsumer) Start(ctx context.Context) error {
+	return qec.consumer.Start(ctx)
+}
+
+// Close closes the consumer
+func (qec *QueueEventConsumer) Close() error {
+	return qec.consumer.Close()
+}
+
+// LobbyEventConsumer processes lobby events
+type LobbyEventConsumer struct {
+	consumer    *Consumer
+	processFunc func(ctx context.Context, event *LobbyEvent) error
+}
+
+// NewLobbyEventConsumer creates a consumer for lobby events
+func NewLobbyEventConsumer(client *Client, groupID string, processFunc func(ctx context.Context, event *LobbyEvent) error) *LobbyEventConsumer {
+	config := DefaultConsumerConfig(groupID, []string{TopicLobbyEvents})
+	consumer := NewConsumer(client, config)
+
+	lec := &LobbyEventConsumer{
+		consumer:    consumer,
+		processFunc: processFunc,
+	}
+
+	consumer.RegisterHandler(TopicLobbyEvents, lec.handleLobbyEvent)
+
+	return lec
+}
+
+func (lec *LobbyEventConsumer) handleLobbyEvent(ctx context.Context, msg *kafka.Message) error {
+	var event LobbyEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal lobby event: %w", err)
+	}
+
+	return lec.processFunc(ctx, &event)
+}
+
+// Start begins consuming lobby events
+func (lec *LobbyEventConsumer) Start(ctx context.Context) error {
+	return lec.consumer.Start(ctx)
+}
+
+// Close closes the consumer
+func (lec *LobbyEventConsumer) Close() error {
+	return lec.consumer.Close()
+}
+
 // HealthCheck verifies Kafka connectivity
 func (c *Client) HealthCheck(ctx context.Context) error {
 	conn, err := c.dialer.DialContext(ctx, "tcp", strings.Split(c.config.BootstrapServers, ",")[0])
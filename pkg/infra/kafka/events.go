@@ -14,6 +14,7 @@ const (
 	TopicPrizePoolEvents   = "matchmaking.prizepool.events"
 	TopicMatchesCreated    = "matchmaking.matches.created"
 	TopicMatchesResults    = "matchmaking.matches.results"
+	TopicMatchesLifecycle  = "matchmaking.matches.lifecycle"
 	TopicPlayerStatus      = "matchmaking.player-status"
 	TopicWebSocketBroadcast = "websocket.broadcasts"
 	TopicDLQ               = "matchmaking.dlq"
@@ -50,14 +51,22 @@ func NewEventPublisher(client *Client) *EventPublisher {
 
 // QueueEvent represents a matchmaking queue event
 type QueueEvent struct {
-	EventID   uuid.UUID         `json:"event_id"`
-	PlayerID  uuid.UUID         `json:"player_id"`
-	GameType  string            `json:"game_type"`
-	Region    string            `json:"region"`
-	MMR       int               `json:"mmr"`
-	QueueTime int64             `json:"queue_time"`
-	EventType string            `json:"event_type"`
-	Metadata  map[string]string `json:"metadata,omitempty"`
+	EventID      uuid.UUID              `json:"event_id"`
+	PlayerID     uuid.UUID              `json:"player_id"`
+	PartyID      uuid.UUID              `json:"party_id,omitempty"`      // set when the player queues with a pre-made party
+	PartyMembers []uuid.UUID            `json:"party_members,omitempty"` // players of the pre-made party
+	Backfill     bool                   `json:"backfill,omitempty"`      // the party accepts to replace players who left a match in progress
+	GameType     string                 `json:"game_type"`
+	GameMode     string                 `json:"game_mode,omitempty"` // game mode ID, when the queue is mode specific
+	Region       string                 `json:"region"`
+	Pings        map[string]int         `json:"pings,omitempty"`    // latency in ms by region slug; when set, the party can be matched in any region under MaxPing and Region is ignored. QUEUE_LEFT must carry them too.
+	MaxPing      int                    `json:"max_ping,omitempty"` // highest ping accepted, 0 accepts any
+	Maps         []string               `json:"maps,omitempty"`     // preferred maps, in order of preference
+	Roles        map[uuid.UUID][]string `json:"roles,omitempty"`    // roles each player accepts to play, by player; players missing queue with the roles of their profile
+	MMR          int                    `json:"mmr"`
+	QueueTime    int64                  `json:"queue_time"`
+	EventType    string                 `json:"event_type"`
+	Metadata     map[string]string      `json:"metadata,omitempty"`
 }
 
 // PublishQueueEvent publishes a queue event
@@ -93,6 +102,14 @@ type LobbyEvent struct {
 	Metadata  map[string]string `json:"metadata,omitempty"`
 }
 
+// Lobby event metadata keys
+const (
+	MetadataReady               = "ready"                  // READY_STATUS_CHANGED: "true" when the players accept the match, "false" when they decline
+	MetadataReadyCheckExpiresAt = "ready_check_expires_at" // LOBBY_CREATED: unix milliseconds until which the players can accept the match
+	MetadataDroppedPartyIDs     = "dropped_party_ids"      // LOBBY_CANCELLED: comma separated parties removed from the queue
+	MetadataBackfilledPartyIDs  = "backfilled_party_ids"   // LOBBY_UPDATED: comma separated parties that took the seats of players who left the match
+)
+
 // PublishLobbyEvent publishes a lobby event
 func (p *EventPublisher) PublishLobbyEvent(ctx context.Context, event *LobbyEvent) error {
 	event.EventID = uuid.New()
@@ -154,6 +171,7 @@ type MatchEvent struct {
 	LobbyID   uuid.UUID         `json:"lobby_id"`
 	EventType string            `json:"event_type"`
 	GameType  string            `json:"game_type"`
+	GameMode  string            `json:"game_mode,omitempty"` // game mode ID, when the match was made in a mode specific queue; results must carry it too
 	Region    string            `json:"region"`
 	PlayerIDs []uuid.UUID       `json:"player_ids"`
 	Teams     []TeamInfo        `json:"teams,omitempty"`
@@ -162,12 +180,28 @@ type MatchEvent struct {
 	Metadata  map[string]string `json:"metadata,omitempty"`
 }
 
+// Match event metadata keys
+const (
+	MetadataAbandonedPlayerIDs = "abandoned_player_ids" // matches.results: comma separated players who left the match before it ended
+	MetadataWorstPing          = "worst_ping"           // MATCH_CREATED: ping in ms of the worst connected player in the region chosen
+	MetadataMap                = "map"                  // MATCH_CREATED: map the match is played on
+	MetadataCancelReason       = "cancel_reason"        // MATCH_CANCELLED: why the match was cancelled
+
+	MetadataQualityScore           = "quality_score"            // MATCH_CREATED: quality of the match, from 0 to 1
+	MetadataMMRSpread              = "mmr_spread"               // MATCH_CREATED: MMR between the lowest and highest rated party
+	MetadataTeamBalance            = "team_balance"             // MATCH_CREATED: MMR between the teams of highest and lowest average MMR
+	MetadataScheduleOverlapMinutes = "schedule_overlap_minutes" // MATCH_CREATED: shortest overlap between the schedules of two parties, when they have one
+	MetadataLongestWaitSeconds     = "longest_wait_seconds"     // MATCH_CREATED: comma separated waits of the longest-waiting parties, longest first
+	MetadataRelaxations            = "relaxations"              // MATCH_CREATED: comma separated rules the match was allowed to bend, e.g. skill_window
+)
+
 // TeamInfo contains team details in a match
 type TeamInfo struct {
-	TeamID    uuid.UUID   `json:"team_id"`
-	Name      string      `json:"name"`
-	PlayerIDs []uuid.UUID `json:"player_ids"`
-	Side      string      `json:"side,omitempty"` // e.g., "CT", "T" for CS2
+	TeamID    uuid.UUID            `json:"team_id"`
+	Name      string               `json:"name"`
+	PlayerIDs []uuid.UUID          `json:"player_ids"`
+	Side      string               `json:"side,omitempty"`  // e.g., "CT", "T" for CS2
+	Roles     map[uuid.UUID]string `json:"roles,omitempty"` // role each player plays, when the game mode has role quotas
 }
 
 // MatchResult contains match outcome details
@@ -231,6 +265,27 @@ func (p *EventPublisher) PublishMatchResult(ctx context.Context, event *MatchEve
 	return p.client.Publish(ctx, TopicMatchesResults, msg)
 }
 
+// PublishMatchLifecycle publishes a MATCH_STARTED, MATCH_COMPLETED or MATCH_CANCELLED event, as the match moves
+// through its lifecycle
+func (p *EventPublisher) PublishMatchLifecycle(ctx context.Context, event *MatchEvent) error {
+	event.EventID = uuid.New()
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().UnixMilli()
+	}
+
+	msg := &Message{
+		Key:       event.MatchID.String(),
+		Value:     event,
+		Timestamp: time.Now(),
+		Headers: map[string]string{
+			"event_type": event.EventType,
+			"match_id":   event.MatchID.String(),
+		},
+	}
+
+	return p.client.Publish(ctx, TopicMatchesLifecycle, msg)
+}
+
 // WebSocketBroadcastEvent represents an event to broadcast to WebSocket clients
 type WebSocketBroadcastEvent struct {
 	EventID   uuid.UUID   `json:"event_id"`
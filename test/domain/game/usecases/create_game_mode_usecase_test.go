@@ -13,10 +13,23 @@ import (
 	"github.com/leet-gaming/match-making-api/pkg/common"
 	game_entities "github.com/leet-gaming/match-making-api/pkg/domain/game/entities"
 	"github.com/leet-gaming/match-making-api/pkg/domain/game/usecases"
+	pairing_entities "github.com/leet-gaming/match-making-api/pkg/domain/pairing/entities"
 	"github.com/leet-gaming/match-making-api/test/mocks"
 )
 
+// newStrategies returns the registry of the matcher, knowing a "ranked" strategy on top of the built-in ones
+func newStrategies() *pairing_entities.StrategyRegistry {
+	strategies := pairing_entities.NewStrategyRegistry()
+	strategies.Register("ranked", func(_ *pairing_entities.StrategyRegistry, _ game_entities.MatchStrategySettings, base pairing_entities.StrategyBase) (pairing_entities.MatchStrategy, error) {
+		return pairing_entities.SkillStrategy(base), nil
+	})
+
+	return strategies
+}
+
 func TestCreateGameModeUseCase_Execute(t *testing.T) {
+	negativeSeconds := -1
+
 	tests := []struct {
 		name          string
 		gameMode      *game_entities.GameMode
@@ -46,6 +59,27 @@ func TestCreateGameModeUseCase_Execute(t *testing.T) {
 				assert.NotEqual(t, uuid.Nil, gameMode.GameID)
 			},
 		},
+		{
+			name: "successfully create game mode naming a strategy registered with the matcher",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{Name: "ranked"},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("Search", mock.Anything, mock.Anything).Return([]*game_entities.GameMode{}, nil)
+				writer.On("Create", mock.Anything, mock.AnythingOfType("*entities.GameMode")).Return(&game_entities.GameMode{
+					BaseEntity:  common.BaseEntity{ID: google_uuid.New()},
+					Name:        "Test Game Mode",
+					Matchmaking: game_entities.MatchmakingSettings{Strategy: &game_entities.MatchStrategySettings{Name: "ranked"}},
+				}, nil)
+			},
+			validate: func(t *testing.T, gameMode *game_entities.GameMode) {
+				assert.Equal(t, "ranked", gameMode.Matchmaking.Strategy.Name)
+			},
+		},
 		{
 			name: "fail when game mode name is empty",
 			gameMode: &game_entities.GameMode{
@@ -84,6 +118,313 @@ func TestCreateGameModeUseCase_Execute(t *testing.T) {
 			},
 			expectedError: "game mode with name 'Existing Game Mode' already exists for this game",
 		},
+		{
+			name: "fail when strategy is unknown",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{Name: "elo"},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "strategy elo is not known to the matcher",
+		},
+		{
+			name: "fail when strategy parameter is unknown",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{
+						Name: game_entities.MatchStrategyComposite,
+						Parts: []game_entities.WeightedMatchStrategy{
+							{MatchStrategySettings: game_entities.MatchStrategySettings{Name: game_entities.MatchStrategySkill, Params: map[string]float64{"spread": 200}}, Weight: 1},
+						},
+					},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "strategy parameter spread must be mmr_spread, wait_seconds or max_ping",
+		},
+		{
+			name: "fail when window expansion step is negative",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					WindowExpansion: []game_entities.WindowExpansionStep{{AfterSeconds: 30, MMRDelta: -50}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "window_expansion steps must not have negative values",
+		},
+		{
+			name: "fail when window expansion step waits a negative time",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					WindowExpansion: []game_entities.WindowExpansionStep{{AfterSeconds: -30, MaxPing: 100}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "window_expansion steps must not have negative values",
+		},
+		{
+			name: "fail when ready check seconds are negative",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					ReadyCheckSeconds: -1,
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "ready_check_seconds must not be negative",
+		},
+		{
+			name: "fail when priority boost seconds are negative",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					PriorityBoostSeconds: &negativeSeconds,
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "priority_boost_seconds must not be negative",
+		},
+		{
+			name: "fail when map patience seconds are negative",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					MapPool:            []string{"mirage", "dust"},
+					MapPatienceSeconds: -1,
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "map_patience_seconds must not be negative",
+		},
+		{
+			name: "fail when rating algorithm is unknown",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RatingAlgorithm: "openskill",
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "rating_algorithm must be one of elo, glicko2 or trueskill",
+		},
+		{
+			name: "fail when role quota does not name a role",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RoleQuotas: []game_entities.RoleQuota{{Role: " ", Count: 1}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "role_quotas must name a role and need at least one player",
+		},
+		{
+			name: "fail when role quota needs no player",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RoleQuotas: []game_entities.RoleQuota{{Role: "tank", Count: 0}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "role_quotas must name a role and need at least one player",
+		},
+		{
+			name: "fail when role quotas repeat a role",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RoleQuotas: []game_entities.RoleQuota{{Role: "tank", Count: 1}, {Role: "Tank ", Count: 1}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "role_quotas must not repeat the role Tank",
+		},
+		{
+			name: "fail when rematch avoidance mode is unknown",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RematchAvoidance: &game_entities.RematchAvoidance{Mode: "ignore", LastMatches: 3},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "rematch_avoidance mode must be exclude or penalty",
+		},
+		{
+			name: "fail when rematch avoidance has negative values",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RematchAvoidance: &game_entities.RematchAvoidance{Mode: game_entities.RematchPenalty, LastMatches: 3, PenaltyMMR: -100},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "rematch_avoidance must not have negative values",
+		},
+		{
+			name: "fail when rematch avoidance does not look back",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RematchAvoidance: &game_entities.RematchAvoidance{Mode: game_entities.RematchExclude},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "rematch_avoidance must look back on last_matches, within_minutes or both",
+		},
+		{
+			name: "fail when matcher is unknown",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Matcher: "eager",
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "matcher must be greedy or batch",
+		},
+		{
+			name: "fail when batch tick seconds are negative",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Matcher:          game_entities.MatcherBatch,
+					BatchTickSeconds: -1,
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "batch_tick_seconds must not be negative",
+		},
+		{
+			name: "fail when strategy has no name",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{Name: " "},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "strategy name is required",
+		},
+		{
+			name: "fail when strategy parameter is negative",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{Name: game_entities.MatchStrategySkill, Params: map[string]float64{game_entities.MatchStrategyParamMMRSpread: -1}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "strategy parameter mmr_spread must not be negative",
+		},
+		{
+			name: "fail when strategy other than composite has parts",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{
+						Name:  game_entities.MatchStrategySkill,
+						Parts: []game_entities.WeightedMatchStrategy{{MatchStrategySettings: game_entities.MatchStrategySettings{Name: game_entities.MatchStrategyFIFO}, Weight: 1}},
+					},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "only composite strategies have parts, not skill",
+		},
+		{
+			name: "fail when composite strategy has no parts",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{Name: game_entities.MatchStrategyComposite},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "composite strategies need at least one part",
+		},
+		{
+			name: "fail when strategy part weighs nothing",
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{
+						Name:  game_entities.MatchStrategyComposite,
+						Parts: []game_entities.WeightedMatchStrategy{{MatchStrategySettings: game_entities.MatchStrategySettings{Name: game_entities.MatchStrategyFIFO}, Weight: 0}},
+					},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				// No mocks needed as validation fails before repository calls
+			},
+			expectedError: "strategy parts must weigh more than zero",
+		},
 		{
 			name: "fail when repository returns error",
 			gameMode: &game_entities.GameMode{
@@ -104,7 +445,7 @@ func TestCreateGameModeUseCase_Execute(t *testing.T) {
 			mockReader := new(mocks.MockPortGameModeReader)
 			tt.setupMocks(mockWriter, mockReader)
 
-			useCase := usecases.NewCreateGameModeUseCase(mockWriter, mockReader)
+			useCase := usecases.NewCreateGameModeUseCase(mockWriter, mockReader, newStrategies())
 
 			ctx := context.Background()
 			ctx = context.WithValue(ctx, common.TenantIDKey, google_uuid.New())
@@ -17,6 +17,8 @@ import (
 )
 
 func TestUpdateGameModeUseCase_Execute(t *testing.T) {
+	negativeSeconds := -1
+
 	tests := []struct {
 		name          string
 		gameModeID    google_uuid.UUID
@@ -84,6 +86,172 @@ func TestUpdateGameModeUseCase_Execute(t *testing.T) {
 			},
 			expectedError: "game mode with name 'Duplicate Name' already exists for this game",
 		},
+		{
+			name:       "fail when strategy is unknown",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{Name: "elo"},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "strategy elo is not known to the matcher",
+		},
+		{
+			name:       "fail when strategy parameter is unknown",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{Name: game_entities.MatchStrategySkill, Params: map[string]float64{"spread": 200}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "strategy parameter spread must be mmr_spread, wait_seconds or max_ping",
+		},
+		{
+			name:       "fail when window expansion step is negative",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					WindowExpansion: []game_entities.WindowExpansionStep{{AfterSeconds: 30, MMRDelta: -50}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "window_expansion steps must not have negative values",
+		},
+		{
+			name:       "fail when ready check seconds are negative",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					ReadyCheckSeconds: -1,
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "ready_check_seconds must not be negative",
+		},
+		{
+			name:       "fail when priority boost seconds are negative",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					PriorityBoostSeconds: &negativeSeconds,
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "priority_boost_seconds must not be negative",
+		},
+		{
+			name:       "fail when map patience seconds are negative",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					MapPool:            []string{"mirage", "dust"},
+					MapPatienceSeconds: -1,
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "map_patience_seconds must not be negative",
+		},
+		{
+			name:       "fail when rating algorithm is unknown",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RatingAlgorithm: "openskill",
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "rating_algorithm must be one of elo, glicko2 or trueskill",
+		},
+		{
+			name:       "fail when role quota does not name a role",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RoleQuotas: []game_entities.RoleQuota{{Role: " ", Count: 1}},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "role_quotas must name a role and need at least one player",
+		},
+		{
+			name:       "fail when rematch avoidance mode is unknown",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					RematchAvoidance: &game_entities.RematchAvoidance{Mode: "ignore", LastMatches: 3},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "rematch_avoidance mode must be exclude or penalty",
+		},
+		{
+			name:       "fail when matcher is unknown",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Matcher: "eager",
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "matcher must be greedy or batch",
+		},
+		{
+			name:       "fail when strategy has no name",
+			gameModeID: google_uuid.New(),
+			gameMode: &game_entities.GameMode{
+				GameID: uuid.FromStringOrNil(google_uuid.New().String()),
+				Name:   "Test Game Mode",
+				Matchmaking: game_entities.MatchmakingSettings{
+					Strategy: &game_entities.MatchStrategySettings{Name: " "},
+				},
+			},
+			setupMocks: func(writer *mocks.MockPortGameModeWriter, reader *mocks.MockPortGameModeReader) {
+				reader.On("GetByID", mock.Anything, mock.Anything).Return(&game_entities.GameMode{}, nil)
+			},
+			expectedError: "strategy name is required",
+		},
 	}
 
 	for _, tt := range tests {
@@ -92,7 +260,7 @@ func TestUpdateGameModeUseCase_Execute(t *testing.T) {
 			mockReader := new(mocks.MockPortGameModeReader)
 			tt.setupMocks(mockWriter, mockReader)
 
-			useCase := usecases.NewUpdateGameModeUseCase(mockWriter, mockReader)
+			useCase := usecases.NewUpdateGameModeUseCase(mockWriter, mockReader, newStrategies())
 
 			ctx := context.Background()
 			ctx = context.WithValue(ctx, common.TenantIDKey, google_uuid.New())
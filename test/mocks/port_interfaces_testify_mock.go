@@ -7,6 +7,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -19,6 +20,8 @@ import (
 	pairing_value_objects "github.com/leet-gaming/match-making-api/pkg/domain/pairing/value-objects"
 	parties_entities "github.com/leet-gaming/match-making-api/pkg/domain/parties/entities"
 	parties_out "github.com/leet-gaming/match-making-api/pkg/domain/parties/ports/out"
+	ratings_entities "github.com/leet-gaming/match-making-api/pkg/domain/ratings/entities"
+	ratings_out "github.com/leet-gaming/match-making-api/pkg/domain/ratings/ports/out"
 )
 
 // MockPortGameWriter is a mock implementation of out.GameWriter using testify/mock
@@ -258,6 +261,70 @@ func (m *MockPortPairReader) GetByID(ctx context.Context, id uuid.UUID) (*pairin
 	return args.Get(0).(*pairing_entities.Pair), args.Error(1)
 }
 
+func (m *MockPortPairReader) FindExpiredReadyChecks(ctx context.Context, now time.Time) ([]*pairing_entities.Pair, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pairing_entities.Pair), args.Error(1)
+}
+
+func (m *MockPortPairReader) FindStartedBefore(ctx context.Context, gameID uuid.UUID, before time.Time) ([]*pairing_entities.Pair, error) {
+	args := m.Called(ctx, gameID, before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pairing_entities.Pair), args.Error(1)
+}
+
+func (m *MockPortPairReader) FindCreatedBetween(ctx context.Context, gameModeID *uuid.UUID, since, until time.Time) ([]*pairing_entities.Pair, error) {
+	args := m.Called(ctx, gameModeID, since, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pairing_entities.Pair), args.Error(1)
+}
+
+// MockPortPairWriter is a mock implementation of pairing_out.PairWriter using testify/mock
+type MockPortPairWriter struct {
+	mock.Mock
+}
+
+// Ensure MockPortPairWriter implements pairing_out.PairWriter
+var _ pairing_out.PairWriter = (*MockPortPairWriter)(nil)
+
+func (m *MockPortPairWriter) Save(p *pairing_entities.Pair) (*pairing_entities.Pair, error) {
+	args := m.Called(p)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pairing_entities.Pair), args.Error(1)
+}
+
+func (m *MockPortPairWriter) Update(p *pairing_entities.Pair) (*pairing_entities.Pair, error) {
+	args := m.Called(p)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pairing_entities.Pair), args.Error(1)
+}
+
+// MockPortPartyReader is a mock implementation of parties_out.PartyReader using testify/mock
+type MockPortPartyReader struct {
+	mock.Mock
+}
+
+// Ensure MockPortPartyReader implements parties_out.PartyReader
+var _ parties_out.PartyReader = (*MockPortPartyReader)(nil)
+
+func (m *MockPortPartyReader) GetByID(id uuid.UUID) (*parties_entities.Party, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*parties_entities.Party), args.Error(1)
+}
+
 // MockPortPeerReader is a mock implementation of parties_out.PeerReader using testify/mock
 type MockPortPeerReader struct {
 	mock.Mock
@@ -290,6 +357,14 @@ func (m *MockPoolReader) FindPool(criteria *pairing_value_objects.Criteria) (*pa
 	return args.Get(0).(*pairing_entities.Pool), args.Error(1)
 }
 
+func (m *MockPoolReader) ListPools() ([]*pairing_entities.Pool, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pairing_entities.Pool), args.Error(1)
+}
+
 // MockPoolWriter is a mock implementation of pairing_out.PoolWriter using testify/mock
 type MockPoolWriter struct {
 	mock.Mock
@@ -330,8 +405,8 @@ type MockPairCreator struct {
 // Ensure MockPairCreator implements pairing_in.PairCreator
 var _ pairing_in.PairCreator = (*MockPairCreator)(nil)
 
-func (m *MockPairCreator) Execute(ctx context.Context, pids []uuid.UUID) (*pairing_entities.Pair, error) {
-	args := m.Called(ctx, pids)
+func (m *MockPairCreator) Execute(ctx context.Context, pids []uuid.UUID, draft *pairing_entities.Pair) (*pairing_entities.Pair, error) {
+	args := m.Called(ctx, pids, draft)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -507,3 +582,223 @@ func (m *MockPortUserNotificationPreferencesReader) GetByUserID(ctx context.Cont
 	}
 	return args.Get(0).(*pairing_entities.UserNotificationPreferences), args.Error(1)
 }
+
+// MockPortPenaltyReader is a mock implementation of pairing_out.PenaltyReader using testify/mock
+type MockPortPenaltyReader struct {
+	mock.Mock
+}
+
+// Ensure MockPortPenaltyReader implements pairing_out.PenaltyReader
+var _ pairing_out.PenaltyReader = (*MockPortPenaltyReader)(nil)
+
+func (m *MockPortPenaltyReader) GetByPlayerID(ctx context.Context, playerID uuid.UUID) (*pairing_entities.PlayerPenalty, error) {
+	args := m.Called(ctx, playerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pairing_entities.PlayerPenalty), args.Error(1)
+}
+
+func (m *MockPortPenaltyReader) FindCoolingDown(ctx context.Context, now time.Time, playerIDs ...uuid.UUID) ([]*pairing_entities.PlayerPenalty, error) {
+	args := m.Called(ctx, now, playerIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pairing_entities.PlayerPenalty), args.Error(1)
+}
+
+func (m *MockPortPenaltyReader) FindAll(ctx context.Context) ([]*pairing_entities.PlayerPenalty, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pairing_entities.PlayerPenalty), args.Error(1)
+}
+
+// MockPortPenaltyWriter is a mock implementation of pairing_out.PenaltyWriter using testify/mock
+type MockPortPenaltyWriter struct {
+	mock.Mock
+}
+
+// Ensure MockPortPenaltyWriter implements pairing_out.PenaltyWriter
+var _ pairing_out.PenaltyWriter = (*MockPortPenaltyWriter)(nil)
+
+func (m *MockPortPenaltyWriter) Save(ctx context.Context, penalty *pairing_entities.PlayerPenalty) (*pairing_entities.PlayerPenalty, error) {
+	args := m.Called(ctx, penalty)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pairing_entities.PlayerPenalty), args.Error(1)
+}
+
+func (m *MockPortPenaltyWriter) Delete(ctx context.Context, playerID uuid.UUID) error {
+	args := m.Called(ctx, playerID)
+	return args.Error(0)
+}
+
+// MockPortPlayerBlockReader is a mock implementation of pairing_out.PlayerBlockReader using testify/mock
+type MockPortPlayerBlockReader struct {
+	mock.Mock
+}
+
+// Ensure MockPortPlayerBlockReader implements pairing_out.PlayerBlockReader
+var _ pairing_out.PlayerBlockReader = (*MockPortPlayerBlockReader)(nil)
+
+func (m *MockPortPlayerBlockReader) FindByPlayerIDs(ctx context.Context, playerIDs ...uuid.UUID) ([]*pairing_entities.PlayerBlock, error) {
+	args := m.Called(ctx, playerIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pairing_entities.PlayerBlock), args.Error(1)
+}
+
+// MockPortPlayerBlockWriter is a mock implementation of pairing_out.PlayerBlockWriter using testify/mock
+type MockPortPlayerBlockWriter struct {
+	mock.Mock
+}
+
+// Ensure MockPortPlayerBlockWriter implements pairing_out.PlayerBlockWriter
+var _ pairing_out.PlayerBlockWriter = (*MockPortPlayerBlockWriter)(nil)
+
+func (m *MockPortPlayerBlockWriter) Save(ctx context.Context, block *pairing_entities.PlayerBlock) (*pairing_entities.PlayerBlock, error) {
+	args := m.Called(ctx, block)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pairing_entities.PlayerBlock), args.Error(1)
+}
+
+func (m *MockPortPlayerBlockWriter) Delete(ctx context.Context, playerID, blockedID uuid.UUID) error {
+	args := m.Called(ctx, playerID, blockedID)
+	return args.Error(0)
+}
+
+// MockPortRatingReader is a mock implementation of ratings_out.RatingReader using testify/mock
+type MockPortRatingReader struct {
+	mock.Mock
+}
+
+// Ensure MockPortRatingReader implements ratings_out.RatingReader
+var _ ratings_out.RatingReader = (*MockPortRatingReader)(nil)
+
+func (m *MockPortRatingReader) FindByPlayers(ctx context.Context, gameID uuid.UUID, gameModeID *uuid.UUID, playerIDs ...uuid.UUID) ([]*ratings_entities.PlayerRating, error) {
+	args := m.Called(ctx, gameID, gameModeID, playerIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ratings_entities.PlayerRating), args.Error(1)
+}
+
+func (m *MockPortRatingReader) FindRanked(ctx context.Context, scope ratings_entities.LeaderboardScope, offset, limit int) ([]*ratings_entities.PlayerRating, error) {
+	args := m.Called(ctx, scope, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ratings_entities.PlayerRating), args.Error(1)
+}
+
+func (m *MockPortRatingReader) Count(ctx context.Context, scope ratings_entities.LeaderboardScope) (int, error) {
+	args := m.Called(ctx, scope)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPortRatingReader) CountAhead(ctx context.Context, scope ratings_entities.LeaderboardScope, rating *ratings_entities.PlayerRating) (int, error) {
+	args := m.Called(ctx, scope, rating)
+	return args.Int(0), args.Error(1)
+}
+
+// MockPortRatingWriter is a mock implementation of ratings_out.RatingWriter using testify/mock
+type MockPortRatingWriter struct {
+	mock.Mock
+}
+
+// Ensure MockPortRatingWriter implements ratings_out.RatingWriter
+var _ ratings_out.RatingWriter = (*MockPortRatingWriter)(nil)
+
+func (m *MockPortRatingWriter) Save(ctx context.Context, rating *ratings_entities.PlayerRating) (*ratings_entities.PlayerRating, error) {
+	args := m.Called(ctx, rating)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ratings_entities.PlayerRating), args.Error(1)
+}
+
+// MockPortSeasonReader is a mock implementation of ratings_out.SeasonReader using testify/mock
+type MockPortSeasonReader struct {
+	mock.Mock
+}
+
+// Ensure MockPortSeasonReader implements ratings_out.SeasonReader
+var _ ratings_out.SeasonReader = (*MockPortSeasonReader)(nil)
+
+func (m *MockPortSeasonReader) GetByID(ctx context.Context, id uuid.UUID) (*ratings_entities.Season, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ratings_entities.Season), args.Error(1)
+}
+
+func (m *MockPortSeasonReader) Search(ctx context.Context, gameID *uuid.UUID, gameModeID *uuid.UUID) ([]*ratings_entities.Season, error) {
+	args := m.Called(ctx, gameID, gameModeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ratings_entities.Season), args.Error(1)
+}
+
+func (m *MockPortSeasonReader) FindEnded(ctx context.Context, now time.Time) ([]*ratings_entities.Season, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ratings_entities.Season), args.Error(1)
+}
+
+// MockPortSeasonWriter is a mock implementation of ratings_out.SeasonWriter using testify/mock
+type MockPortSeasonWriter struct {
+	mock.Mock
+}
+
+// Ensure MockPortSeasonWriter implements ratings_out.SeasonWriter
+var _ ratings_out.SeasonWriter = (*MockPortSeasonWriter)(nil)
+
+func (m *MockPortSeasonWriter) Save(ctx context.Context, season *ratings_entities.Season) (*ratings_entities.Season, error) {
+	args := m.Called(ctx, season)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ratings_entities.Season), args.Error(1)
+}
+
+// MockPortLeaderboardSnapshotReader is a mock implementation of ratings_out.LeaderboardSnapshotReader using testify/mock
+type MockPortLeaderboardSnapshotReader struct {
+	mock.Mock
+}
+
+// Ensure MockPortLeaderboardSnapshotReader implements ratings_out.LeaderboardSnapshotReader
+var _ ratings_out.LeaderboardSnapshotReader = (*MockPortLeaderboardSnapshotReader)(nil)
+
+func (m *MockPortLeaderboardSnapshotReader) GetBySeasonID(ctx context.Context, seasonID uuid.UUID) (*ratings_entities.LeaderboardSnapshot, error) {
+	args := m.Called(ctx, seasonID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ratings_entities.LeaderboardSnapshot), args.Error(1)
+}
+
+// MockPortLeaderboardSnapshotWriter is a mock implementation of ratings_out.LeaderboardSnapshotWriter using testify/mock
+type MockPortLeaderboardSnapshotWriter struct {
+	mock.Mock
+}
+
+// Ensure MockPortLeaderboardSnapshotWriter implements ratings_out.LeaderboardSnapshotWriter
+var _ ratings_out.LeaderboardSnapshotWriter = (*MockPortLeaderboardSnapshotWriter)(nil)
+
+func (m *MockPortLeaderboardSnapshotWriter) Save(ctx context.Context, snapshot *ratings_entities.LeaderboardSnapshot) (*ratings_entities.LeaderboardSnapshot, error) {
+	args := m.Called(ctx, snapshot)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ratings_entities.LeaderboardSnapshot), args.Error(1)
+}